@@ -68,6 +68,7 @@ func run() error {
 
 	// Create and run TUI
 	app := tui.NewApp(cfg)
+	defer app.Close()
 	p := tea.NewProgram(app, tea.WithAltScreen())
 
 	logger.Info("Starting TUI")
@@ -51,6 +51,7 @@ func (l *Loader) Load() (*domain.Config, error) {
 		return nil, fmt.Errorf("parse config: %w", err)
 	}
 
+	importSSHConfigHosts(&cfg)
 	return &cfg, nil
 }
 
@@ -96,13 +97,21 @@ func (l *Loader) createDefault() (*domain.Config, error) {
 		return nil, fmt.Errorf("save default config: %w", err)
 	}
 
+	importSSHConfigHosts(cfg)
 	return cfg, nil
 }
 
 // Save writes the configuration to disk
 func (l *Loader) Save(cfg *domain.Config) error {
 	l.viper.Set("kubeconfigs", cfg.KubeConfigs)
-	l.viper.Set("ssh_hosts", cfg.SSHHosts)
+	// Hosts imported from ~/.ssh/config stay there
+	var hosts []domain.SSHHost
+	for _, host := range cfg.SSHHosts {
+		if !host.Imported {
+			hosts = append(hosts, host)
+		}
+	}
+	l.viper.Set("ssh_hosts", hosts)
 
 	configFilePath := filepath.Join(l.configPath, configFile)
 	if err := l.viper.WriteConfigAs(configFilePath); err != nil {
@@ -0,0 +1,277 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+	"github.com/LywwKkA-aD/k4s/internal/pathutil"
+)
+
+const (
+	defaultSSHConfig = "~/.ssh/config"
+	// sshConfigDisabled turns off the import when set as ssh_config
+	sshConfigDisabled = "none"
+	// maxIncludeDepth limits nested Include directives
+	maxIncludeDepth = 8
+)
+
+// sshConfigBlock is one Host section of an OpenSSH client config
+type sshConfigBlock struct {
+	patterns []string
+	options  map[string]string // lower-cased keyword -> first value
+}
+
+// LoadSSHConfigHosts reads the hosts of an OpenSSH client config. Every
+// concrete alias of a Host line becomes a host; wildcard sections only
+// provide defaults. As in ssh, the first value found for an option wins.
+func LoadSSHConfigHosts(path string) ([]domain.SSHHost, error) {
+	path, err := pathutil.ExpandHome(path)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks, err := readSSHConfig(path, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	localUser := ""
+	if u, err := user.Current(); err == nil {
+		localUser = u.Username
+	}
+
+	var hosts []domain.SSHHost
+	seen := make(map[string]bool)
+	for _, block := range blocks {
+		for _, alias := range block.patterns {
+			if seen[alias] || strings.ContainsAny(alias, "*?!") {
+				continue
+			}
+			seen[alias] = true
+			hosts = append(hosts, resolveSSHConfigHost(alias, blocks, localUser))
+		}
+	}
+	return hosts, nil
+}
+
+// resolveSSHConfigHost applies every matching section to alias
+func resolveSSHConfigHost(alias string, blocks []sshConfigBlock, localUser string) domain.SSHHost {
+	options := make(map[string]string)
+	for _, block := range blocks {
+		if !matchSSHHost(alias, block.patterns) {
+			continue
+		}
+		for k, v := range block.options {
+			if _, ok := options[k]; !ok {
+				options[k] = v
+			}
+		}
+	}
+
+	host := domain.SSHHost{
+		Name:      alias,
+		Host:      alias,
+		User:      localUser,
+		ProxyJump: options["proxyjump"],
+		Imported:  true,
+	}
+	if v := options["hostname"]; v != "" {
+		host.Host = strings.ReplaceAll(v, "%h", alias)
+	}
+	if v := options["user"]; v != "" {
+		host.User = v
+	}
+	if v := options["port"]; v != "" {
+		if port, err := strconv.Atoi(v); err == nil {
+			host.Port = port
+		}
+	}
+	if v := options["identityfile"]; v != "" {
+		host.KeyPath = expandSSHTokens(v, host)
+	}
+	if v := options["userknownhostsfile"]; v != "" && !strings.EqualFold(v, "none") {
+		// Only the first of several files is used
+		host.KnownHosts = expandSSHTokens(strings.Fields(v)[0], host)
+	}
+	return host
+}
+
+// readSSHConfig parses a config file into Host sections, following Include.
+// Options before the first Host line apply to every host.
+func readSSHConfig(path string, depth int) ([]sshConfigBlock, error) {
+	if depth > maxIncludeDepth {
+		return nil, fmt.Errorf("read ssh config %s: too many nested includes", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("read ssh config: %w", err)
+	}
+	defer f.Close()
+
+	blocks := []sshConfigBlock{{patterns: []string{"*"}, options: map[string]string{}}}
+	current := 0  // index of the section options are added to
+	skip := false // inside a Match section, which is not supported
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		keyword, value := splitSSHConfigLine(scanner.Text())
+		if keyword == "" {
+			continue
+		}
+
+		switch keyword {
+		case "host":
+			blocks = append(blocks, sshConfigBlock{patterns: strings.Fields(value), options: map[string]string{}})
+			current = len(blocks) - 1
+			skip = false
+		case "match":
+			skip = true
+		case "include":
+			if skip {
+				continue
+			}
+			for _, pattern := range strings.Fields(value) {
+				included, err := readSSHConfigInclude(pattern, depth)
+				if err != nil {
+					return nil, err
+				}
+				// Included sections keep applying to the current Host afterwards
+				patterns := blocks[current].patterns
+				blocks = append(blocks, included...)
+				blocks = append(blocks, sshConfigBlock{patterns: patterns, options: map[string]string{}})
+				current = len(blocks) - 1
+			}
+		default:
+			if skip {
+				continue
+			}
+			if _, ok := blocks[current].options[keyword]; !ok {
+				blocks[current].options[keyword] = value
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ssh config %s: %w", path, err)
+	}
+	return blocks, nil
+}
+
+// readSSHConfigInclude reads the files of an Include pattern, relative to ~/.ssh
+func readSSHConfigInclude(pattern string, depth int) ([]sshConfigBlock, error) {
+	pattern, err := pathutil.ExpandHome(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if !filepath.IsAbs(pattern) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("get home directory: %w", err)
+		}
+		pattern = filepath.Join(home, ".ssh", pattern)
+	}
+
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("include %s: %w", pattern, err)
+	}
+
+	var blocks []sshConfigBlock
+	for _, file := range files {
+		included, err := readSSHConfig(file, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, included...)
+	}
+	return blocks, nil
+}
+
+// splitSSHConfigLine returns the lower-cased keyword and value of a line,
+// accepting both "Keyword value" and "Keyword=value"
+func splitSSHConfigLine(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", ""
+	}
+
+	i := strings.IndexAny(line, " \t=")
+	if i < 0 {
+		return strings.ToLower(line), ""
+	}
+	keyword := strings.ToLower(line[:i])
+	value := strings.TrimSpace(line[i:])
+	value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+	value = strings.Trim(value, `"`)
+	return keyword, value
+}
+
+// matchSSHHost reports whether alias matches a Host line. A negated pattern
+// that matches excludes the alias even if another pattern matches.
+func matchSSHHost(alias string, patterns []string) bool {
+	matched := false
+	for _, pattern := range patterns {
+		negated := strings.HasPrefix(pattern, "!")
+		ok, err := filepath.Match(strings.TrimPrefix(pattern, "!"), alias)
+		if err != nil || !ok {
+			continue
+		}
+		if negated {
+			return false
+		}
+		matched = true
+	}
+	return matched
+}
+
+// expandSSHTokens expands the ssh_config tokens k4s can resolve
+func expandSSHTokens(value string, host domain.SSHHost) string {
+	home, _ := os.UserHomeDir()
+	r := strings.NewReplacer(
+		"%%", "%",
+		"%d", home,
+		"%h", host.Host,
+		"%n", host.Name,
+		"%r", host.User,
+	)
+	return r.Replace(value)
+}
+
+// importSSHConfigHosts appends the hosts of the user's ssh config that are
+// not already defined in ssh_hosts
+func importSSHConfigHosts(cfg *domain.Config) {
+	path := cfg.SSHConfig
+	if path == "" {
+		path = defaultSSHConfig
+	}
+	if strings.EqualFold(path, sshConfigDisabled) {
+		return
+	}
+
+	imported, err := LoadSSHConfigHosts(path)
+	if err != nil {
+		// A missing ~/.ssh/config is normal
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Warn("Failed to import ssh config", "path", path, "err", err)
+		}
+		return
+	}
+
+	count := 0
+	for _, host := range imported {
+		if cfg.FindSSHHost(host.Name) != nil {
+			continue
+		}
+		cfg.SSHHosts = append(cfg.SSHHosts, host)
+		count++
+	}
+	logger.Debug("Imported hosts from ssh config", "path", path, "count", count)
+}
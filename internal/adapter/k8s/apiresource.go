@@ -0,0 +1,222 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// crdGVR is the resource of CustomResourceDefinitions
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// GetAPIResources discovers the resource types the cluster serves, each in
+// the preferred version of its group. Groups that fail discovery, e.g. an
+// unavailable metrics-server, are left out.
+func (c *Client) GetAPIResources(ctx context.Context) ([]domain.APIResource, error) {
+	lists, err := c.clientset.Discovery().ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("discover API resources: %w", err)
+	}
+
+	var resources []domain.APIResource
+	for _, l := range lists {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range l.APIResources {
+			// Subresources such as pods/log cannot be listed
+			if strings.Contains(r.Name, "/") || !slices.Contains(r.Verbs, "list") {
+				continue
+			}
+			resources = append(resources, domain.APIResource{
+				Name:       r.Name,
+				Kind:       r.Kind,
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Namespaced: r.Namespaced,
+				ShortNames: r.ShortNames,
+				Verbs:      r.Verbs,
+			})
+		}
+	}
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Kind != resources[j].Kind {
+			return resources[i].Kind < resources[j].Kind
+		}
+		return resources[i].Group < resources[j].Group
+	})
+
+	c.apiMu.Lock()
+	c.apiResources = resources
+	c.apiMu.Unlock()
+
+	return resources, nil
+}
+
+// discoveredResource returns the discovered resource of a group-qualified
+// kind, e.g. HelmChart.helm.cattle.io. Discovery runs on first use.
+func (c *Client) discoveredResource(kind string) (domain.APIResource, error) {
+	c.apiMu.Lock()
+	resources := c.apiResources
+	c.apiMu.Unlock()
+
+	if resources == nil {
+		var err error
+		if resources, err = c.GetAPIResources(context.Background()); err != nil {
+			return domain.APIResource{}, err
+		}
+	}
+	for _, r := range resources {
+		if r.QualifiedKind() == kind {
+			return r, nil
+		}
+	}
+	return domain.APIResource{}, fmt.Errorf("unsupported kind %q", kind)
+}
+
+// ListResources lists the objects of any resource type in the specified
+// namespace, or in every namespace for AllNamespaces. Custom resources get
+// the columns their CRD declares.
+func (c *Client) ListResources(ctx context.Context, res domain.APIResource, namespace string) (*domain.ResourceList, error) {
+	gvr := schema.GroupVersionResource{Group: res.Group, Version: res.Version, Resource: res.Name}
+	var resources dynamic.ResourceInterface = c.dynamic.Resource(gvr)
+	if res.Namespaced {
+		resources = c.dynamic.Resource(gvr).Namespace(c.listNamespace(namespace))
+	}
+
+	list, err := resources.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list %s: %w", res.FullName(), err)
+	}
+
+	columns, paths := c.printerColumns(ctx, res)
+	result := &domain.ResourceList{
+		Resource: res,
+		Columns:  columns,
+		Objects:  make([]domain.ResourceObject, 0, len(list.Items)),
+	}
+	for i := range list.Items {
+		obj := &list.Items[i]
+		cells := make([]string, len(paths))
+		for j, path := range paths {
+			cells[j] = printerCell(path, columns[j].Type, obj.Object)
+		}
+		result.Objects = append(result.Objects, domain.ResourceObject{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Age:       formatAge(obj.GetCreationTimestamp().Time),
+			Cells:     cells,
+		})
+	}
+	return result, nil
+}
+
+// DeleteResource deletes an object of a kind shown in k4s or of a
+// group-qualified discovered kind
+func (c *Client) DeleteResource(ctx context.Context, kind, namespace, name string) error {
+	resources, _, err := c.resourceClient(kind, namespace)
+	if err != nil {
+		return err
+	}
+
+	if err := resources.Delete(ctx, name, metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("delete %s %s: %w", kind, name, err)
+	}
+	return nil
+}
+
+// printerColumns returns the additionalPrinterColumns the CRD of a resource
+// declares for the listed version, with their parsed JSONPaths. Built-in
+// resources have no CRD and get no extra columns; so do CRDs that cannot be
+// read. Columns kubectl only shows with -o wide and the creation timestamp,
+// which every list shows as AGE, are left out.
+func (c *Client) printerColumns(ctx context.Context, res domain.APIResource) ([]domain.ResourceColumn, []*jsonpath.JSONPath) {
+	if res.Group == "" {
+		return nil, nil
+	}
+	crd, err := c.dynamic.Resource(crdGVR).Get(ctx, res.FullName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil
+	}
+
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok || version["name"] != res.Version {
+			continue
+		}
+
+		var columns []domain.ResourceColumn
+		var paths []*jsonpath.JSONPath
+		specs, _, _ := unstructured.NestedSlice(version, "additionalPrinterColumns")
+		for _, s := range specs {
+			spec, ok := s.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _ := spec["name"].(string)
+			typ, _ := spec["type"].(string)
+			path, _ := spec["jsonPath"].(string)
+			priority, _ := spec["priority"].(int64)
+			if priority > 0 || path == ".metadata.creationTimestamp" {
+				continue
+			}
+
+			jp := jsonpath.New(name).AllowMissingKeys(true)
+			if err := jp.Parse("{" + path + "}"); err != nil {
+				continue
+			}
+			columns = append(columns, domain.ResourceColumn{Name: name, Type: typ})
+			paths = append(paths, jp)
+		}
+		return columns, paths
+	}
+	return nil, nil
+}
+
+// printerCell evaluates a printer column against an object. Dates are shown
+// as ages; lists and objects as JSON.
+func printerCell(path *jsonpath.JSONPath, typ string, obj map[string]any) string {
+	results, err := path.FindResults(obj)
+	if err != nil || len(results) == 0 {
+		return ""
+	}
+
+	values := make([]string, 0, len(results[0]))
+	for _, r := range results[0] {
+		if !r.IsValid() || !r.CanInterface() {
+			continue
+		}
+		switch v := r.Interface().(type) {
+		case nil:
+			// null values print nothing
+		case string:
+			if typ == "date" {
+				if t, err := time.Parse(time.RFC3339, v); err == nil {
+					v = formatAge(t)
+				}
+			}
+			values = append(values, v)
+		case map[string]any, []any:
+			data, _ := json.Marshal(v)
+			values = append(values, string(data))
+		default:
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+	return strings.Join(values, ",")
+}
@@ -3,32 +3,51 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/LywwKkA-aD/k4s/internal/domain"
 )
 
+// AllNamespaces can be passed as the namespace of list calls and Watch to
+// span every namespace the user can see
+const AllNamespaces = "*"
+
 // Client wraps the Kubernetes clientset
 type Client struct {
 	clientset  *kubernetes.Clientset
+	dynamic    dynamic.Interface
+	restConfig *rest.Config
 	config     *clientcmd.ClientConfig
 	rawConfig  clientcmd.ClientConfig
 	kubeconfig string
 	context    string
 	namespace  string
+
+	// API group of the Traefik CRDs, found on first use
+	traefikMu    sync.Mutex
+	traefikGroup string
+
+	// API resources found by discovery, for kinds k4s has no view of its own
+	apiMu        sync.Mutex
+	apiResources []domain.APIResource
 }
 
-// NewClient creates a new Kubernetes client from a kubeconfig path
-func NewClient(kubeconfigPath string) (*Client, error) {
+// NewClient creates a new Kubernetes client from a kubeconfig path.
+// An empty contextName uses the kubeconfig's current-context.
+func NewClient(kubeconfigPath, contextName string) (*Client, error) {
 	loadingRules := &clientcmd.ClientConfigLoadingRules{
 		ExplicitPath: kubeconfigPath,
 	}
 
-	configOverrides := &clientcmd.ConfigOverrides{}
+	configOverrides := &clientcmd.ConfigOverrides{CurrentContext: contextName}
 	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		loadingRules,
 		configOverrides,
@@ -47,6 +66,11 @@ func NewClient(kubeconfigPath string) (*Client, error) {
 		return nil, fmt.Errorf("create clientset: %w", err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("create dynamic client: %w", err)
+	}
+
 	// Get current context and namespace
 	rawConfig, err := kubeConfig.RawConfig()
 	if err != nil {
@@ -54,6 +78,9 @@ func NewClient(kubeconfigPath string) (*Client, error) {
 	}
 
 	currentContext := rawConfig.CurrentContext
+	if contextName != "" {
+		currentContext = contextName
+	}
 	namespace := "default"
 	if ctx, ok := rawConfig.Contexts[currentContext]; ok && ctx.Namespace != "" {
 		namespace = ctx.Namespace
@@ -61,12 +88,37 @@ func NewClient(kubeconfigPath string) (*Client, error) {
 
 	return &Client{
 		clientset:  clientset,
+		dynamic:    dynamicClient,
+		restConfig: config,
 		kubeconfig: kubeconfigPath,
 		context:    currentContext,
 		namespace:  namespace,
 	}, nil
 }
 
+// ListContexts returns the contexts defined in a kubeconfig file sorted by name
+func ListContexts(kubeconfigPath string) ([]domain.KubeContext, error) {
+	rawConfig, err := clientcmd.LoadFromFile(kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("load kubeconfig: %w", err)
+	}
+
+	contexts := make([]domain.KubeContext, 0, len(rawConfig.Contexts))
+	for name, ctx := range rawConfig.Contexts {
+		contexts = append(contexts, domain.KubeContext{
+			Name:      name,
+			Cluster:   ctx.Cluster,
+			User:      ctx.AuthInfo,
+			Namespace: ctx.Namespace,
+			Current:   name == rawConfig.CurrentContext,
+		})
+	}
+	sort.Slice(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+	return contexts, nil
+}
+
 // CheckConnection verifies the connection to the cluster
 func (c *Client) CheckConnection(ctx context.Context) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -104,6 +156,18 @@ func (c *Client) CurrentNamespace() string {
 	return c.namespace
 }
 
+// listNamespace resolves the namespace argument of a list call: empty means
+// the current namespace and AllNamespaces means every namespace
+func (c *Client) listNamespace(namespace string) string {
+	switch namespace {
+	case "":
+		return c.namespace
+	case AllNamespaces:
+		return metav1.NamespaceAll
+	}
+	return namespace
+}
+
 // SetNamespace sets the current namespace
 func (c *Client) SetNamespace(ns string) {
 	c.namespace = ns
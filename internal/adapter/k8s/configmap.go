@@ -0,0 +1,123 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"unicode/utf8"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetConfigMaps returns all configmaps in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetConfigMaps(ctx context.Context, namespace string) ([]domain.ConfigMap, error) {
+	namespace = c.listNamespace(namespace)
+
+	cmList, err := c.clientset.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list configmaps: %w", err)
+	}
+
+	configMaps := make([]domain.ConfigMap, 0, len(cmList.Items))
+	for _, cm := range cmList.Items {
+		configMaps = append(configMaps, convertConfigMap(&cm))
+	}
+	return configMaps, nil
+}
+
+// GetConfigMap returns a single configmap with its data
+func (c *Client) GetConfigMap(ctx context.Context, namespace, name string) (*domain.ConfigMap, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	cm, err := c.clientset.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get configmap %s: %w", name, err)
+	}
+
+	configMap := convertConfigMap(cm)
+	configMap.Labels = make(map[string]string, len(cm.Labels))
+	maps.Copy(configMap.Labels, cm.Labels)
+	for k, v := range cm.Data {
+		configMap.Data = append(configMap.Data, domain.DataItem{Key: k, Value: []byte(v)})
+	}
+	for k, v := range cm.BinaryData {
+		configMap.Data = append(configMap.Data, domain.DataItem{Key: k, Value: v, Binary: true})
+	}
+	sortDataItems(configMap.Data)
+
+	return &configMap, nil
+}
+
+// SetConfigMapKey replaces the value of one key of a configmap. It fails if
+// the configmap changed since resourceVersion. With dryRun the API server
+// only validates the change.
+func (c *Client) SetConfigMapKey(ctx context.Context, namespace, name, key, value, resourceVersion string, dryRun bool) error {
+	configMaps := c.clientset.CoreV1().ConfigMaps(namespace)
+	cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get configmap %s: %w", name, err)
+	}
+	if cm.ResourceVersion != resourceVersion {
+		return fmt.Errorf("configmap %s was changed since the edit started", name)
+	}
+	if _, ok := cm.BinaryData[key]; ok {
+		return fmt.Errorf("key %q holds binary data", key)
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[key] = value
+
+	opts := metav1.UpdateOptions{FieldManager: "k4s"}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if _, err := configMaps.Update(ctx, cm, opts); err != nil {
+		return fmt.Errorf("update configmap %s: %w", name, err)
+	}
+	return nil
+}
+
+func convertConfigMap(cm *corev1.ConfigMap) domain.ConfigMap {
+	size := 0
+	for _, v := range cm.Data {
+		size += len(v)
+	}
+	for _, v := range cm.BinaryData {
+		size += len(v)
+	}
+
+	return domain.ConfigMap{
+		Name:            cm.Name,
+		Namespace:       cm.Namespace,
+		Keys:            len(cm.Data) + len(cm.BinaryData),
+		Size:            size,
+		Age:             formatAge(cm.CreationTimestamp.Time),
+		ResourceVersion: cm.ResourceVersion,
+	}
+}
+
+// sortDataItems sorts the keys of a configmap or secret by name
+func sortDataItems(items []domain.DataItem) {
+	slices.SortFunc(items, func(a, b domain.DataItem) int {
+		if a.Key < b.Key {
+			return -1
+		}
+		if a.Key > b.Key {
+			return 1
+		}
+		return 0
+	})
+}
+
+// isText reports whether a value can be shown and edited as text
+func isText(value []byte) bool {
+	return utf8.Valid(value) && !slices.Contains(value, 0)
+}
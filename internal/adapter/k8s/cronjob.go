@@ -0,0 +1,173 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetCronJobs returns all cronjobs in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetCronJobs(ctx context.Context, namespace string) ([]domain.CronJob, error) {
+	namespace = c.listNamespace(namespace)
+
+	cjList, err := c.clientset.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list cronjobs: %w", err)
+	}
+
+	cronJobs := make([]domain.CronJob, 0, len(cjList.Items))
+	for _, cj := range cjList.Items {
+		cronJobs = append(cronJobs, convertCronJob(&cj))
+	}
+	return cronJobs, nil
+}
+
+// GetCronJob returns a single cronjob with full details, including the jobs it owns
+func (c *Client) GetCronJob(ctx context.Context, namespace, name string) (*domain.CronJob, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	cj, err := c.clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get cronjob %s: %w", name, err)
+	}
+
+	cronJob := convertCronJobDetailed(cj)
+
+	jobList, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list cronjob jobs: %w", err)
+	}
+
+	// Newest run first
+	slices.SortFunc(jobList.Items, func(a, b batchv1.Job) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	for _, j := range jobList.Items {
+		if metav1.IsControlledBy(&j, cj) {
+			cronJob.Jobs = append(cronJob.Jobs, convertJob(&j))
+		}
+	}
+
+	return &cronJob, nil
+}
+
+// SetCronJobSuspend suspends or resumes a cronjob
+func (c *Client) SetCronJobSuspend(ctx context.Context, namespace, name string, suspend bool) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspend)
+
+	_, err := c.clientset.BatchV1().CronJobs(namespace).Patch(
+		ctx,
+		name,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		return fmt.Errorf("patch cronjob %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// TriggerCronJob creates a job from the cronjob's job template right away,
+// like kubectl create job --from=cronjob/<name>. It returns the job name.
+func (c *Client) TriggerCronJob(ctx context.Context, namespace, name string) (string, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	cj, err := c.clientset.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("get cronjob %s: %w", name, err)
+	}
+
+	// Job names are limited to 63 characters
+	suffix := fmt.Sprintf("-manual-%d", metav1.Now().Unix())
+	jobName := name
+	if len(jobName)+len(suffix) > 63 {
+		jobName = jobName[:63-len(suffix)]
+	}
+	jobName += suffix
+
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	maps.Copy(annotations, cj.Spec.JobTemplate.Annotations)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   namespace,
+			Labels:      cj.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cj, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: cj.Spec.JobTemplate.Spec,
+	}
+
+	created, err := c.clientset.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("create job from cronjob %s: %w", name, err)
+	}
+
+	return created.Name, nil
+}
+
+func convertCronJob(cj *batchv1.CronJob) domain.CronJob {
+	images := make([]string, 0, len(cj.Spec.JobTemplate.Spec.Template.Spec.Containers))
+	for _, c := range cj.Spec.JobTemplate.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	cronJob := domain.CronJob{
+		Name:      cj.Name,
+		Namespace: cj.Namespace,
+		Schedule:  cj.Spec.Schedule,
+		Suspend:   cj.Spec.Suspend != nil && *cj.Spec.Suspend,
+		Active:    len(cj.Status.Active),
+		Age:       formatAge(cj.CreationTimestamp.Time),
+		Images:    images,
+	}
+	if cj.Spec.TimeZone != nil {
+		cronJob.TimeZone = *cj.Spec.TimeZone
+	}
+	if cj.Status.LastScheduleTime != nil {
+		cronJob.LastSchedule = formatAge(cj.Status.LastScheduleTime.Time)
+	}
+	if cj.Status.LastSuccessfulTime != nil {
+		cronJob.LastSuccessful = formatAge(cj.Status.LastSuccessfulTime.Time)
+	}
+	return cronJob
+}
+
+func convertCronJobDetailed(cj *batchv1.CronJob) domain.CronJob {
+	cronJob := convertCronJob(cj)
+
+	cronJob.Labels = make(map[string]string, len(cj.Labels))
+	maps.Copy(cronJob.Labels, cj.Labels)
+
+	cronJob.ConcurrencyPolicy = string(cj.Spec.ConcurrencyPolicy)
+	cronJob.SuccessfulHistory = 3
+	if cj.Spec.SuccessfulJobsHistoryLimit != nil {
+		cronJob.SuccessfulHistory = *cj.Spec.SuccessfulJobsHistoryLimit
+	}
+	cronJob.FailedHistory = 1
+	if cj.Spec.FailedJobsHistoryLimit != nil {
+		cronJob.FailedHistory = *cj.Spec.FailedJobsHistoryLimit
+	}
+
+	return cronJob
+}
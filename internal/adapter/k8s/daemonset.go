@@ -0,0 +1,177 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetDaemonSets returns all daemonsets in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetDaemonSets(ctx context.Context, namespace string) ([]domain.DaemonSet, error) {
+	namespace = c.listNamespace(namespace)
+
+	dsList, err := c.clientset.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list daemonsets: %w", err)
+	}
+
+	daemonSets := make([]domain.DaemonSet, 0, len(dsList.Items))
+	for _, d := range dsList.Items {
+		daemonSets = append(daemonSets, convertDaemonSet(&d))
+	}
+	return daemonSets, nil
+}
+
+// GetDaemonSet returns a single daemonset with full details, including its
+// pods and whether each of them runs the current revision
+func (c *Client) GetDaemonSet(ctx context.Context, namespace, name string) (*domain.DaemonSet, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	d, err := c.clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get daemonset %s: %w", name, err)
+	}
+
+	daemonSet := convertDaemonSetDetailed(d)
+
+	pods, err := c.getDaemonSetPods(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	daemonSet.Pods = pods
+
+	return &daemonSet, nil
+}
+
+// RestartDaemonSet triggers a rolling restart by patching the daemonset's annotations
+func (c *Client) RestartDaemonSet(ctx context.Context, namespace, name string) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	_, err := c.clientset.AppsV1().DaemonSets(namespace).Patch(
+		ctx,
+		name,
+		types.StrategicMergePatchType,
+		restartPatch(),
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		return fmt.Errorf("restart daemonset %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// getDaemonSetPods lists the pods controlled by a daemonset and compares
+// their revision hash with the newest ControllerRevision of the daemonset
+func (c *Client) getDaemonSetPods(ctx context.Context, d *appsv1.DaemonSet) ([]domain.DaemonSetPod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("daemonset %s selector: %w", d.Name, err)
+	}
+	listOpts := metav1.ListOptions{LabelSelector: selector.String()}
+
+	revList, err := c.clientset.AppsV1().ControllerRevisions(d.Namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("list controller revisions: %w", err)
+	}
+
+	var currentHash string
+	var currentRevision int64 = -1
+	for _, rev := range revList.Items {
+		if metav1.IsControlledBy(&rev, d) && rev.Revision > currentRevision {
+			currentRevision = rev.Revision
+			currentHash = rev.Labels[appsv1.DefaultDaemonSetUniqueLabelKey]
+		}
+	}
+
+	podList, err := c.clientset.CoreV1().Pods(d.Namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("list daemonset pods: %w", err)
+	}
+
+	pods := make([]domain.DaemonSetPod, 0, len(podList.Items))
+	for _, p := range podList.Items {
+		if !metav1.IsControlledBy(&p, d) {
+			continue
+		}
+		pod := convertPod(&p)
+		revision := p.Labels[appsv1.DefaultDaemonSetUniqueLabelKey]
+		pods = append(pods, domain.DaemonSetPod{
+			Name:     pod.Name,
+			Node:     pod.Node,
+			Ready:    pod.Ready,
+			Status:   pod.Status,
+			Restarts: pod.Restarts,
+			Age:      pod.Age,
+			Revision: revision,
+			UpToDate: currentHash != "" && revision == currentHash,
+		})
+	}
+
+	slices.SortFunc(pods, func(a, b domain.DaemonSetPod) int {
+		return strings.Compare(a.Node, b.Node)
+	})
+	return pods, nil
+}
+
+func convertDaemonSet(d *appsv1.DaemonSet) domain.DaemonSet {
+	images := make([]string, 0, len(d.Spec.Template.Spec.Containers))
+	for _, c := range d.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	return domain.DaemonSet{
+		Name:         d.Name,
+		Namespace:    d.Namespace,
+		Desired:      d.Status.DesiredNumberScheduled,
+		Current:      d.Status.CurrentNumberScheduled,
+		Ready:        d.Status.NumberReady,
+		UpToDate:     d.Status.UpdatedNumberScheduled,
+		Available:    d.Status.NumberAvailable,
+		Misscheduled: d.Status.NumberMisscheduled,
+		NodeSelector: formatSelector(d.Spec.Template.Spec.NodeSelector),
+		Age:          formatAge(d.CreationTimestamp.Time),
+		Images:       images,
+	}
+}
+
+func convertDaemonSetDetailed(d *appsv1.DaemonSet) domain.DaemonSet {
+	daemonSet := convertDaemonSet(d)
+
+	daemonSet.Labels = make(map[string]string, len(d.Labels))
+	maps.Copy(daemonSet.Labels, d.Labels)
+
+	daemonSet.Selector = make(map[string]string)
+	if d.Spec.Selector != nil {
+		maps.Copy(daemonSet.Selector, d.Spec.Selector.MatchLabels)
+	}
+
+	daemonSet.UpdateStrategy = string(d.Spec.UpdateStrategy.Type)
+
+	return daemonSet
+}
+
+// formatSelector renders a label map as sorted key=value pairs, or <none>
+func formatSelector(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "<none>"
+	}
+	keys := slices.Sorted(maps.Keys(labels))
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
@@ -11,13 +11,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
 )
 
-// GetDeployments returns all deployments in the specified namespace
+// GetDeployments returns all deployments in the specified namespace, or in every namespace for AllNamespaces
 func (c *Client) GetDeployments(ctx context.Context, namespace string) ([]domain.Deployment, error) {
-	if namespace == "" {
-		namespace = c.namespace
-	}
+	namespace = c.listNamespace(namespace)
 
 	depList, err := c.clientset.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -43,6 +42,14 @@ func (c *Client) GetDeployment(ctx context.Context, namespace, name string) (*do
 	}
 
 	deployment := convertDeploymentDetailed(d)
+
+	// History is an add-on; users who may not list replicasets still get details
+	history, err := c.deploymentHistory(ctx, d)
+	if err != nil {
+		logger.Warn("GetDeployment: rollout history unavailable", "deployment", name, "namespace", namespace, "err", err)
+	}
+	deployment.History = history
+
 	return &deployment, nil
 }
 
@@ -72,15 +79,11 @@ func (c *Client) RestartDeployment(ctx context.Context, namespace, name string)
 		namespace = c.namespace
 	}
 
-	// Use strategic merge patch to update the restartedAt annotation
-	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`,
-		time.Now().Format(time.RFC3339))
-
 	_, err := c.clientset.AppsV1().Deployments(namespace).Patch(
 		ctx,
 		name,
 		types.StrategicMergePatchType,
-		[]byte(patch),
+		restartPatch(),
 		metav1.PatchOptions{},
 	)
 	if err != nil {
@@ -104,6 +107,13 @@ func (c *Client) DeleteDeployment(ctx context.Context, namespace, name string) e
 	return nil
 }
 
+// restartPatch returns a strategic merge patch that updates the restartedAt
+// annotation of a pod template, which makes the controller roll its pods
+func restartPatch() []byte {
+	return []byte(fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{"kubectl.kubernetes.io/restartedAt":"%s"}}}}}`,
+		time.Now().Format(time.RFC3339)))
+}
+
 func convertDeployment(d *appsv1.Deployment) domain.Deployment {
 	replicas := int32(1)
 	if d.Spec.Replicas != nil {
@@ -126,6 +136,9 @@ func convertDeployment(d *appsv1.Deployment) domain.Deployment {
 		Replicas:      replicas,
 		ReadyReplicas: d.Status.ReadyReplicas,
 		Images:        images,
+		Paused:        d.Spec.Paused,
+		Revision:      d.Annotations[revisionAnnotation],
+		Rollout:       rolloutStatus(d),
 	}
 }
 
@@ -180,5 +193,8 @@ func convertDeploymentDetailed(d *appsv1.Deployment) domain.Deployment {
 		Selector:      selector,
 		Conditions:    conditions,
 		Images:        images,
+		Paused:        d.Spec.Paused,
+		Revision:      d.Annotations[revisionAnnotation],
+		Rollout:       rolloutStatus(d),
 	}
 }
@@ -25,9 +25,7 @@ func (c *Client) GetEvents(ctx context.Context, namespace string) ([]domain.Even
 
 // GetEventsFiltered returns events with optional filtering
 func (c *Client) GetEventsFiltered(ctx context.Context, namespace string, opts EventFilterOptions) ([]domain.Event, error) {
-	if namespace == "" {
-		namespace = c.namespace
-	}
+	namespace = c.listNamespace(namespace)
 
 	listOpts := metav1.ListOptions{}
 	if opts.Limit > 0 {
@@ -0,0 +1,123 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+)
+
+// shellCommand starts bash when the image ships it and falls back to sh otherwise
+var shellCommand = []string{"/bin/sh", "-c", "[ -x /bin/bash ] && exec /bin/bash || exec /bin/sh"}
+
+// ExecOptions configures an interactive exec session
+type ExecOptions struct {
+	Container string
+	Command   []string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
+	TTY       bool
+	// Resize delivers terminal size changes; the first value should be the initial size
+	Resize <-chan domain.TerminalSize
+}
+
+// Exec runs a command inside a pod container and streams stdio until it exits
+func (c *Client) Exec(ctx context.Context, namespace, podName string, opts ExecOptions) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	command := opts.Command
+	if len(command) == 0 {
+		command = shellCommand
+	}
+
+	logger.Debug("Exec", "pod", podName, "container", opts.Container, "namespace", namespace, "command", command)
+
+	req := c.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(podName).
+		Namespace(namespace).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: opts.Container,
+			Command:   command,
+			Stdin:     opts.Stdin != nil,
+			Stdout:    opts.Stdout != nil,
+			Stderr:    opts.Stderr != nil && !opts.TTY,
+			TTY:       opts.TTY,
+		}, scheme.ParameterCodec)
+
+	// Interactive sessions must not be cut off by the client request timeout
+	config := *c.restConfig
+	config.Timeout = 0
+
+	spdyExec, err := remotecommand.NewSPDYExecutor(&config, http.MethodPost, req.URL())
+	if err != nil {
+		return fmt.Errorf("create spdy executor: %w", err)
+	}
+	wsExec, err := remotecommand.NewWebSocketExecutor(&config, http.MethodGet, req.URL().String())
+	if err != nil {
+		return fmt.Errorf("create websocket executor: %w", err)
+	}
+	executor, err := remotecommand.NewFallbackExecutor(wsExec, spdyExec, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	})
+	if err != nil {
+		return fmt.Errorf("create executor: %w", err)
+	}
+
+	streamOpts := remotecommand.StreamOptions{
+		Stdin:  opts.Stdin,
+		Stdout: opts.Stdout,
+		Tty:    opts.TTY,
+	}
+	if !opts.TTY {
+		streamOpts.Stderr = opts.Stderr
+	}
+	if opts.Resize != nil {
+		streamOpts.TerminalSizeQueue = sizeQueue(opts.Resize)
+	}
+
+	if err := executor.StreamWithContext(ctx, streamOpts); err != nil {
+		return fmt.Errorf("exec in pod %s: %w", podName, err)
+	}
+	return nil
+}
+
+// ExecShell opens an interactive shell in a pod container.
+// A non-zero exit status of the shell is not treated as an error.
+func (c *Client) ExecShell(ctx context.Context, namespace, podName string, opts ExecOptions) error {
+	opts.Command = shellCommand
+	opts.TTY = true
+
+	err := c.Exec(ctx, namespace, podName, opts)
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) {
+		return nil
+	}
+	return err
+}
+
+// sizeQueue adapts a channel of terminal sizes to remotecommand.TerminalSizeQueue
+type sizeQueue <-chan domain.TerminalSize
+
+// Next blocks until the terminal is resized and returns nil once the channel is closed
+func (q sizeQueue) Next() *remotecommand.TerminalSize {
+	size, ok := <-q
+	if !ok {
+		return nil
+	}
+	return &remotecommand.TerminalSize{Width: size.Width, Height: size.Height}
+}
@@ -0,0 +1,216 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// addonLabel is set on the objects k3s applies from its manifests directory
+const addonLabel = "objectset.rio.cattle.io/hash"
+
+// GetHelmCharts returns the k3s HelmCharts in the specified namespace, or in
+// every namespace for AllNamespaces, with their HelmChartConfigs and the
+// status of their install jobs
+func (c *Client) GetHelmCharts(ctx context.Context, namespace string) ([]domain.HelmChart, error) {
+	namespace = c.listNamespace(namespace)
+
+	list, err := c.dynamic.Resource(resourceGVRs["HelmChart"]).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("helm.cattle.io CRDs are not installed (is the k3s helm-controller disabled?)")
+		}
+		return nil, fmt.Errorf("list helmcharts: %w", err)
+	}
+
+	configList, err := c.dynamic.Resource(resourceGVRs["HelmChartConfig"]).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list helmchartconfigs: %w", err)
+	}
+	configs := make(map[string]*unstructured.Unstructured, len(configList.Items))
+	for i := range configList.Items {
+		cfg := &configList.Items[i]
+		configs[cfg.GetNamespace()+"/"+cfg.GetName()] = cfg
+	}
+
+	jobList, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+	jobs := make(map[string]*batchv1.Job, len(jobList.Items))
+	for i := range jobList.Items {
+		j := &jobList.Items[i]
+		jobs[j.Namespace+"/"+j.Name] = j
+	}
+
+	charts := make([]domain.HelmChart, 0, len(list.Items))
+	for i := range list.Items {
+		chart := convertHelmChart(&list.Items[i])
+		if cfg, ok := configs[chart.Namespace+"/"+chart.Name]; ok {
+			chart.Config = convertHelmChartConfig(cfg)
+		}
+		if j, ok := jobs[chart.Namespace+"/"+chart.JobName]; ok {
+			chart.JobStatus = getJobStatus(j)
+			chart.JobFailures = j.Status.Failed
+		}
+		charts = append(charts, chart)
+	}
+	return charts, nil
+}
+
+// GetHelmChart returns a single HelmChart with its HelmChartConfig and its
+// install job, including the job's pods
+func (c *Client) GetHelmChart(ctx context.Context, namespace, name string) (*domain.HelmChart, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	obj, err := c.dynamic.Resource(resourceGVRs["HelmChart"]).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get helmchart %s: %w", name, err)
+	}
+	chart := convertHelmChart(obj)
+
+	cfg, err := c.dynamic.Resource(resourceGVRs["HelmChartConfig"]).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		chart.Config = convertHelmChartConfig(cfg)
+	case !apierrors.IsNotFound(err):
+		return nil, fmt.Errorf("get helmchartconfig %s: %w", name, err)
+	}
+
+	job, err := c.GetJob(ctx, namespace, chart.JobName)
+	switch {
+	case err == nil:
+		chart.Job = job
+		chart.JobStatus = job.Status
+		chart.JobFailures = job.Failed
+	case !apierrors.IsNotFound(err):
+		return nil, err
+	}
+
+	return &chart, nil
+}
+
+// SetHelmChartValues writes the valuesContent of a HelmChart or a
+// HelmChartConfig, which makes helm-controller upgrade the release. An empty
+// resourceVersion creates the HelmChartConfig; otherwise the write fails if
+// the object changed since it was read at resourceVersion.
+func (c *Client) SetHelmChartValues(ctx context.Context, kind, namespace, name, values, resourceVersion string, dryRun bool) error {
+	resources, namespace, err := c.resourceClient(kind, namespace)
+	if err != nil {
+		return err
+	}
+	var dryRunOpt []string
+	if dryRun {
+		dryRunOpt = []string{metav1.DryRunAll}
+	}
+
+	if kind == "HelmChartConfig" && resourceVersion == "" {
+		cfg := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": resourceGVRs[kind].GroupVersion().String(),
+			"kind":       kind,
+			"metadata":   map[string]any{"name": name, "namespace": namespace},
+			"spec":       map[string]any{"valuesContent": values},
+		}}
+		opts := metav1.CreateOptions{FieldManager: "k4s", DryRun: dryRunOpt}
+		if _, err := resources.Create(ctx, cfg, opts); err != nil {
+			return fmt.Errorf("create helmchartconfig %s: %w", name, err)
+		}
+		return nil
+	}
+
+	obj, err := resources.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get %s %s: %w", strings.ToLower(kind), name, err)
+	}
+	if obj.GetResourceVersion() != resourceVersion {
+		return fmt.Errorf("%s %s was changed since the edit started", strings.ToLower(kind), name)
+	}
+	if err := unstructured.SetNestedField(obj.Object, values, "spec", "valuesContent"); err != nil {
+		return fmt.Errorf("set valuesContent: %w", err)
+	}
+
+	opts := metav1.UpdateOptions{FieldManager: "k4s", DryRun: dryRunOpt}
+	if _, err := resources.Update(ctx, obj, opts); err != nil {
+		return fmt.Errorf("update %s %s: %w", strings.ToLower(kind), name, err)
+	}
+	return nil
+}
+
+func convertHelmChart(obj *unstructured.Unstructured) domain.HelmChart {
+	chartRef, _, _ := unstructured.NestedString(obj.Object, "spec", "chart")
+	repo, _, _ := unstructured.NestedString(obj.Object, "spec", "repo")
+	version, _, _ := unstructured.NestedString(obj.Object, "spec", "version")
+	target, _, _ := unstructured.NestedString(obj.Object, "spec", "targetNamespace")
+	values, _, _ := unstructured.NestedString(obj.Object, "spec", "valuesContent")
+	bootstrap, _, _ := unstructured.NestedBool(obj.Object, "spec", "bootstrap")
+	content, _, _ := unstructured.NestedString(obj.Object, "spec", "chartContent")
+	jobName, _, _ := unstructured.NestedString(obj.Object, "status", "jobName")
+
+	chart := domain.HelmChart{
+		Name:            obj.GetName(),
+		Namespace:       obj.GetNamespace(),
+		Chart:           chartRef,
+		Repo:            repo,
+		Version:         version,
+		TargetNamespace: target,
+		ValuesContent:   values,
+		Bootstrap:       bootstrap,
+		ResourceVersion: obj.GetResourceVersion(),
+		Age:             formatAge(obj.GetCreationTimestamp().Time),
+		JobName:         jobName,
+	}
+	if _, ok := obj.GetLabels()[addonLabel]; ok {
+		chart.Addon = true
+	}
+	if chart.TargetNamespace == "" {
+		chart.TargetNamespace = chart.Namespace
+	}
+	if chart.JobName == "" {
+		// Older helm-controller releases do not report the job
+		chart.JobName = "helm-install-" + chart.Name
+	}
+
+	// Charts given as a .tgz URL, like the ones k3s bundles, carry their
+	// version in the file name
+	if strings.HasSuffix(chartRef, ".tgz") {
+		name, fileVersion := chartFileVersion(strings.TrimSuffix(path.Base(chartRef), ".tgz"))
+		chart.Chart = name
+		if chart.Version == "" {
+			chart.Version = fileVersion
+		}
+	} else if chartRef == "" && content != "" {
+		chart.Chart = "(inline)"
+	}
+
+	return chart
+}
+
+// chartFileVersion splits a chart file name such as traefik-27.0.201+up27.0.2
+// at the first dash followed by a digit
+func chartFileVersion(file string) (string, string) {
+	for i := 0; i < len(file)-1; i++ {
+		if file[i] == '-' && file[i+1] >= '0' && file[i+1] <= '9' {
+			return file[:i], file[i+1:]
+		}
+	}
+	return file, ""
+}
+
+func convertHelmChartConfig(obj *unstructured.Unstructured) *domain.HelmChartConfig {
+	values, _, _ := unstructured.NestedString(obj.Object, "spec", "valuesContent")
+	return &domain.HelmChartConfig{
+		ValuesContent:   values,
+		ResourceVersion: obj.GetResourceVersion(),
+		Age:             formatAge(obj.GetCreationTimestamp().Time),
+	}
+}
@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// legacyIngressClassAnnotation selected the controller before ingressClassName
+const legacyIngressClassAnnotation = "kubernetes.io/ingress.class"
+
+// GetIngresses returns all ingresses in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetIngresses(ctx context.Context, namespace string) ([]domain.Ingress, error) {
+	namespace = c.listNamespace(namespace)
+
+	ingList, err := c.clientset.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list ingresses: %w", err)
+	}
+
+	ingresses := make([]domain.Ingress, 0, len(ingList.Items))
+	for _, ing := range ingList.Items {
+		ingresses = append(ingresses, convertIngress(&ing))
+	}
+	return ingresses, nil
+}
+
+// GetIngress returns a single ingress with its rules and TLS sections
+func (c *Client) GetIngress(ctx context.Context, namespace, name string) (*domain.Ingress, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	ing, err := c.clientset.NetworkingV1().Ingresses(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get ingress %s: %w", name, err)
+	}
+
+	ingress := convertIngress(ing)
+	ingress.Labels = make(map[string]string, len(ing.Labels))
+	maps.Copy(ingress.Labels, ing.Labels)
+
+	if b := ing.Spec.DefaultBackend; b != nil {
+		ingress.Rules = append(ingress.Rules, domain.IngressRule{Path: "(default)", Backend: convertIngressBackend(b)})
+	}
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, p := range rule.HTTP.Paths {
+			r := domain.IngressRule{
+				Host:    rule.Host,
+				Path:    p.Path,
+				Backend: convertIngressBackend(&p.Backend),
+			}
+			if r.Path == "" {
+				r.Path = "/"
+			}
+			if p.PathType != nil {
+				r.PathType = string(*p.PathType)
+			}
+			ingress.Rules = append(ingress.Rules, r)
+		}
+	}
+	for _, t := range ing.Spec.TLS {
+		ingress.TLS = append(ingress.TLS, domain.IngressTLS{Hosts: t.Hosts, SecretName: t.SecretName})
+	}
+
+	return &ingress, nil
+}
+
+func convertIngress(ing *networkingv1.Ingress) domain.Ingress {
+	class := ing.Annotations[legacyIngressClassAnnotation]
+	if ing.Spec.IngressClassName != nil {
+		class = *ing.Spec.IngressClassName
+	}
+
+	var hosts []string
+	seen := make(map[string]bool)
+	for _, rule := range ing.Spec.Rules {
+		host := rule.Host
+		if host == "" {
+			host = "*"
+		}
+		if !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+
+	var addresses []string
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		if lb.IP != "" {
+			addresses = append(addresses, lb.IP)
+		} else if lb.Hostname != "" {
+			addresses = append(addresses, lb.Hostname)
+		}
+	}
+
+	return domain.Ingress{
+		Name:      ing.Name,
+		Namespace: ing.Namespace,
+		Class:     class,
+		Hosts:     hosts,
+		Address:   strings.Join(addresses, ","),
+		Age:       formatAge(ing.CreationTimestamp.Time),
+	}
+}
+
+func convertIngressBackend(b *networkingv1.IngressBackend) domain.IngressBackend {
+	if b.Resource != nil {
+		return domain.IngressBackend{Resource: b.Resource.Kind + "/" + b.Resource.Name}
+	}
+	if b.Service == nil {
+		return domain.IngressBackend{}
+	}
+
+	backend := domain.IngressBackend{Service: b.Service.Name, Port: b.Service.Port.Name}
+	if backend.Port == "" {
+		backend.Port = strconv.Itoa(int(b.Service.Port.Number))
+	}
+	return backend
+}
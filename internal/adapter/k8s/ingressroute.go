@@ -0,0 +1,217 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// traefikGroups are the API groups of Traefik's CRDs, newest first. k3s
+// releases shipping Traefik before 2.10 only serve traefik.containo.us.
+var traefikGroups = []string{"traefik.io", "traefik.containo.us"}
+
+// traefikResources maps the Traefik kinds shown in k4s to their resources
+var traefikResources = map[string]string{
+	"IngressRoute": "ingressroutes",
+	"Middleware":   "middlewares",
+}
+
+// traefikGVR returns the resource of a Traefik kind in the API group the
+// cluster serves. The group is looked up once per client.
+func (c *Client) traefikGVR(kind string) (schema.GroupVersionResource, error) {
+	resource, ok := traefikResources[kind]
+	if !ok {
+		return schema.GroupVersionResource{}, fmt.Errorf("unsupported kind %q", kind)
+	}
+
+	c.traefikMu.Lock()
+	defer c.traefikMu.Unlock()
+	if c.traefikGroup == "" {
+		for _, group := range traefikGroups {
+			_, err := c.clientset.Discovery().ServerResourcesForGroupVersion(group + "/v1alpha1")
+			if err == nil {
+				c.traefikGroup = group
+				break
+			}
+			if !apierrors.IsNotFound(err) {
+				return schema.GroupVersionResource{}, fmt.Errorf("discover traefik API: %w", err)
+			}
+		}
+		if c.traefikGroup == "" {
+			return schema.GroupVersionResource{}, fmt.Errorf("traefik CRDs are not installed")
+		}
+	}
+	return schema.GroupVersionResource{Group: c.traefikGroup, Version: "v1alpha1", Resource: resource}, nil
+}
+
+// GetIngressRoutes returns all Traefik IngressRoutes in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetIngressRoutes(ctx context.Context, namespace string) ([]domain.IngressRoute, error) {
+	namespace = c.listNamespace(namespace)
+
+	gvr, err := c.traefikGVR("IngressRoute")
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := c.dynamic.Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list ingressroutes: %w", err)
+	}
+
+	routes := make([]domain.IngressRoute, 0, len(list.Items))
+	for i := range list.Items {
+		routes = append(routes, convertIngressRoute(&list.Items[i]))
+	}
+	return routes, nil
+}
+
+// GetIngressRoute returns a single IngressRoute together with the middlewares
+// its routes reference
+func (c *Client) GetIngressRoute(ctx context.Context, namespace, name string) (*domain.IngressRoute, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	gvr, err := c.traefikGVR("IngressRoute")
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := c.dynamic.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get ingressroute %s: %w", name, err)
+	}
+
+	route := convertIngressRoute(obj)
+	route.Labels = make(map[string]string, len(obj.GetLabels()))
+	maps.Copy(route.Labels, obj.GetLabels())
+
+	middlewares, err := c.getMiddlewares(ctx, route.Routes)
+	if err != nil {
+		return nil, err
+	}
+	route.Middlewares = middlewares
+
+	return &route, nil
+}
+
+// getMiddlewares looks up the middlewares referenced by routes. References
+// to other providers ("name@file") cannot be looked up and keep no type.
+func (c *Client) getMiddlewares(ctx context.Context, routes []domain.IngressRouteRule) ([]domain.Middleware, error) {
+	gvr, err := c.traefikGVR("Middleware")
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[domain.MiddlewareRef]bool)
+	var middlewares []domain.Middleware
+	for _, r := range routes {
+		for _, ref := range r.Middlewares {
+			if seen[ref] {
+				continue
+			}
+			seen[ref] = true
+
+			mw := domain.Middleware{Name: ref.Name, Namespace: ref.Namespace}
+			if strings.Contains(ref.Name, "@") {
+				middlewares = append(middlewares, mw)
+				continue
+			}
+
+			obj, err := c.dynamic.Resource(gvr).Namespace(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+			switch {
+			case apierrors.IsNotFound(err):
+				mw.Missing = true
+			case err != nil:
+				return nil, fmt.Errorf("get middleware %s: %w", ref.Name, err)
+			default:
+				mw.Type = middlewareType(obj)
+			}
+			middlewares = append(middlewares, mw)
+		}
+	}
+	return middlewares, nil
+}
+
+func convertIngressRoute(obj *unstructured.Unstructured) domain.IngressRoute {
+	route := domain.IngressRoute{
+		Name:      obj.GetName(),
+		Namespace: obj.GetNamespace(),
+		Age:       formatAge(obj.GetCreationTimestamp().Time),
+	}
+	route.EntryPoints, _, _ = unstructured.NestedStringSlice(obj.Object, "spec", "entryPoints")
+	route.TLSSecret, _, _ = unstructured.NestedString(obj.Object, "spec", "tls", "secretName")
+	route.CertResolver, _, _ = unstructured.NestedString(obj.Object, "spec", "tls", "certResolver")
+
+	rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "routes")
+	for _, r := range rules {
+		rule, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var rr domain.IngressRouteRule
+		rr.Match, _, _ = unstructured.NestedString(rule, "match")
+		rr.Priority, _, _ = unstructured.NestedInt64(rule, "priority")
+
+		services, _, _ := unstructured.NestedSlice(rule, "services")
+		for _, s := range services {
+			svc, ok := s.(map[string]any)
+			if !ok {
+				continue
+			}
+			rs := domain.IngressRouteService{Kind: domain.IngressRouteServiceKind, Namespace: route.Namespace}
+			if kind, _, _ := unstructured.NestedString(svc, "kind"); kind != "" {
+				rs.Kind = kind
+			}
+			rs.Name, _, _ = unstructured.NestedString(svc, "name")
+			if ns, _, _ := unstructured.NestedString(svc, "namespace"); ns != "" {
+				rs.Namespace = ns
+			}
+			// The port is a number or a port name
+			if port, ok := svc["port"]; ok && port != nil {
+				rs.Port = fmt.Sprint(port)
+			}
+			rs.Weight, _, _ = unstructured.NestedInt64(svc, "weight")
+			rr.Services = append(rr.Services, rs)
+		}
+
+		middlewares, _, _ := unstructured.NestedSlice(rule, "middlewares")
+		for _, m := range middlewares {
+			mw, ok := m.(map[string]any)
+			if !ok {
+				continue
+			}
+			ref := domain.MiddlewareRef{Namespace: route.Namespace}
+			ref.Name, _, _ = unstructured.NestedString(mw, "name")
+			if ns, _, _ := unstructured.NestedString(mw, "namespace"); ns != "" {
+				ref.Namespace = ns
+			}
+			rr.Middlewares = append(rr.Middlewares, ref)
+		}
+
+		route.Routes = append(route.Routes, rr)
+	}
+	return route
+}
+
+// middlewareType returns the kind of a Middleware, which is the key of its
+// spec, e.g. "stripPrefix"
+func middlewareType(obj *unstructured.Unstructured) string {
+	spec, _, _ := unstructured.NestedMap(obj.Object, "spec")
+	keys := make([]string, 0, len(spec))
+	for k := range spec {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
@@ -0,0 +1,191 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetJobs returns all jobs in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetJobs(ctx context.Context, namespace string) ([]domain.Job, error) {
+	namespace = c.listNamespace(namespace)
+
+	jobList, err := c.clientset.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list jobs: %w", err)
+	}
+
+	jobs := make([]domain.Job, 0, len(jobList.Items))
+	for _, j := range jobList.Items {
+		jobs = append(jobs, convertJob(&j))
+	}
+	return jobs, nil
+}
+
+// GetJob returns a single job with full details, including the pods it owns
+func (c *Client) GetJob(ctx context.Context, namespace, name string) (*domain.Job, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	j, err := c.clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get job %s: %w", name, err)
+	}
+
+	job := convertJobDetailed(j)
+
+	selector, err := metav1.LabelSelectorAsSelector(j.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("job %s selector: %w", name, err)
+	}
+	podList, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("list job pods: %w", err)
+	}
+
+	// Newest attempt first
+	slices.SortFunc(podList.Items, func(a, b corev1.Pod) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+	for _, p := range podList.Items {
+		if metav1.IsControlledBy(&p, j) {
+			job.Pods = append(job.Pods, convertPod(&p))
+		}
+	}
+
+	return &job, nil
+}
+
+func convertJob(j *batchv1.Job) domain.Job {
+	completions := int32(1)
+	if j.Spec.Completions != nil {
+		completions = *j.Spec.Completions
+	}
+
+	images := make([]string, 0, len(j.Spec.Template.Spec.Containers))
+	for _, c := range j.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	owner := ""
+	if ref := metav1.GetControllerOf(j); ref != nil {
+		owner = ref.Kind + "/" + ref.Name
+	}
+
+	selector := make(map[string]string)
+	if j.Spec.Selector != nil {
+		maps.Copy(selector, j.Spec.Selector.MatchLabels)
+	}
+
+	return domain.Job{
+		Name:        j.Name,
+		Namespace:   j.Namespace,
+		Completions: fmt.Sprintf("%d/%d", j.Status.Succeeded, completions),
+		Succeeded:   j.Status.Succeeded,
+		Failed:      j.Status.Failed,
+		Active:      j.Status.Active,
+		Status:      getJobStatus(j),
+		Duration:    getJobDuration(j),
+		Owner:       owner,
+		Age:         formatAge(j.CreationTimestamp.Time),
+		Selector:    selector,
+		Images:      images,
+	}
+}
+
+func convertJobDetailed(j *batchv1.Job) domain.Job {
+	job := convertJob(j)
+
+	job.Labels = make(map[string]string, len(j.Labels))
+	maps.Copy(job.Labels, j.Labels)
+
+	job.Parallelism = 1
+	if j.Spec.Parallelism != nil {
+		job.Parallelism = *j.Spec.Parallelism
+	}
+	job.BackoffLimit = 6
+	if j.Spec.BackoffLimit != nil {
+		job.BackoffLimit = *j.Spec.BackoffLimit
+	}
+	if j.Status.StartTime != nil {
+		job.StartTime = j.Status.StartTime.Format(time.RFC3339)
+	}
+	if j.Status.CompletionTime != nil {
+		job.CompletionTime = j.Status.CompletionTime.Format(time.RFC3339)
+	}
+
+	for _, cond := range j.Status.Conditions {
+		job.Conditions = append(job.Conditions, domain.JobCondition{
+			Type:           string(cond.Type),
+			Status:         string(cond.Status),
+			Reason:         cond.Reason,
+			Message:        cond.Message,
+			LastTransition: formatAge(cond.LastTransitionTime.Time),
+		})
+	}
+
+	return job
+}
+
+// getJobStatus derives a single status word from the job's conditions
+func getJobStatus(j *batchv1.Job) string {
+	for _, cond := range j.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return domain.JobStatusComplete
+		case batchv1.JobFailed:
+			return domain.JobStatusFailed
+		}
+	}
+	if j.Spec.Suspend != nil && *j.Spec.Suspend {
+		return domain.JobStatusSuspended
+	}
+	return domain.JobStatusRunning
+}
+
+// getJobDuration returns how long the job ran, or has been running so far.
+// Failed jobs have no completion time; their clock stops when they failed.
+func getJobDuration(j *batchv1.Job) string {
+	if j.Status.StartTime == nil {
+		return ""
+	}
+	end := time.Now()
+	if j.Status.CompletionTime != nil {
+		end = j.Status.CompletionTime.Time
+	} else {
+		for _, cond := range j.Status.Conditions {
+			if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+				end = cond.LastTransitionTime.Time
+				break
+			}
+		}
+	}
+	return formatDuration(end.Sub(j.Status.StartTime.Time))
+}
+
+// formatDuration formats a duration like kubectl does for job durations
+func formatDuration(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		if secs := int(d.Seconds()) % 60; secs != 0 {
+			return fmt.Sprintf("%dm%ds", int(d.Minutes()), secs)
+		}
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/tools/clientcmd"
 	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	metricsclient "k8s.io/metrics/pkg/client/clientset/versioned"
 
@@ -17,14 +16,10 @@ type MetricsClient struct {
 	client *metricsclient.Clientset
 }
 
-// NewMetricsClient creates a new metrics client from the kubeconfig path
-func NewMetricsClient(kubeconfigPath string) (*MetricsClient, error) {
-	config, err := clientcmd.BuildConfigFromFlags("", kubeconfigPath)
-	if err != nil {
-		return nil, fmt.Errorf("build config for metrics: %w", err)
-	}
-
-	client, err := metricsclient.NewForConfig(config)
+// NewMetricsClient creates a new metrics client that shares the cluster
+// connection (kubeconfig and context) of the given client
+func NewMetricsClient(c *Client) (*MetricsClient, error) {
+	client, err := metricsclient.NewForConfig(c.restConfig)
 	if err != nil {
 		return nil, fmt.Errorf("create metrics client: %w", err)
 	}
@@ -43,11 +38,15 @@ func (m *MetricsClient) CheckMetricsAvailable(ctx context.Context) bool {
 	return err == nil
 }
 
-// GetPodMetrics returns metrics for all pods in the namespace
+// GetPodMetrics returns metrics for all pods in the namespace (or in every
+// namespace for AllNamespaces), keyed by domain.PodMetricsKey
 func (m *MetricsClient) GetPodMetrics(ctx context.Context, namespace string) (map[string]domain.PodMetrics, error) {
 	if m == nil || m.client == nil {
 		return nil, fmt.Errorf("metrics client not available")
 	}
+	if namespace == AllNamespaces {
+		namespace = metav1.NamespaceAll
+	}
 
 	podMetricsList, err := m.client.MetricsV1beta1().PodMetricses(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -56,7 +55,7 @@ func (m *MetricsClient) GetPodMetrics(ctx context.Context, namespace string) (ma
 
 	result := make(map[string]domain.PodMetrics, len(podMetricsList.Items))
 	for _, pm := range podMetricsList.Items {
-		result[pm.Name] = convertPodMetrics(&pm)
+		result[domain.PodMetricsKey(pm.Namespace, pm.Name)] = convertPodMetrics(&pm)
 	}
 	return result, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/LywwKkA-aD/k4s/internal/domain"
@@ -19,15 +20,19 @@ func (c *Client) GetNamespaces(ctx context.Context) ([]domain.Namespace, error)
 
 	namespaces := make([]domain.Namespace, 0, len(nsList.Items))
 	for _, ns := range nsList.Items {
-		namespaces = append(namespaces, domain.Namespace{
-			Name:   ns.Name,
-			Status: string(ns.Status.Phase),
-			Age:    formatAge(ns.CreationTimestamp.Time),
-		})
+		namespaces = append(namespaces, convertNamespace(&ns))
 	}
 	return namespaces, nil
 }
 
+func convertNamespace(ns *corev1.Namespace) domain.Namespace {
+	return domain.Namespace{
+		Name:   ns.Name,
+		Status: string(ns.Status.Phase),
+		Age:    formatAge(ns.CreationTimestamp.Time),
+	}
+}
+
 // formatAge returns a human-readable age string
 func formatAge(t time.Time) string {
 	d := time.Since(t)
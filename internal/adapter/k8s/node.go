@@ -0,0 +1,460 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+const (
+	// nodeRoleLabelPrefix marks node roles, e.g. node-role.kubernetes.io/control-plane
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	// mirrorPodAnnotation is set on the API copies of static pods
+	mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+	// evictionRetryInterval is how long a drain waits before retrying an
+	// eviction refused by a PodDisruptionBudget
+	evictionRetryInterval = 5 * time.Second
+	// evictionPollInterval is how often a drain checks whether an evicted pod is gone
+	evictionPollInterval = time.Second
+)
+
+// GetNodes returns all nodes with the resources requested by the pods scheduled on them
+func (c *Client) GetNodes(ctx context.Context) ([]domain.Node, error) {
+	nodeList, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list nodes: %w", err)
+	}
+
+	// Requests are summed over all non-terminated pods, like kubectl describe node
+	podList, err := c.clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+
+	podsByNode := make(map[string][]*corev1.Pod)
+	for i := range podList.Items {
+		p := &podList.Items[i]
+		if p.Spec.NodeName != "" {
+			podsByNode[p.Spec.NodeName] = append(podsByNode[p.Spec.NodeName], p)
+		}
+	}
+
+	nodes := make([]domain.Node, 0, len(nodeList.Items))
+	for i := range nodeList.Items {
+		n := &nodeList.Items[i]
+		node := convertNode(n)
+		addPodRequests(&node, podsByNode[n.Name])
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// GetNode returns a single node with full details, including its pods
+func (c *Client) GetNode(ctx context.Context, name string) (*domain.Node, error) {
+	n, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get node %s: %w", name, err)
+	}
+
+	pods, err := c.nodePods(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	node := convertNodeDetailed(n)
+	var running []*corev1.Pod
+	for _, p := range pods {
+		if p.Status.Phase != corev1.PodSucceeded && p.Status.Phase != corev1.PodFailed {
+			running = append(running, p)
+		}
+	}
+	addPodRequests(&node, running)
+
+	node.Pods = make([]domain.NodePod, 0, len(pods))
+	for _, p := range pods {
+		node.Pods = append(node.Pods, convertNodePod(p))
+	}
+	sort.Slice(node.Pods, func(i, j int) bool {
+		if node.Pods[i].Namespace != node.Pods[j].Namespace {
+			return node.Pods[i].Namespace < node.Pods[j].Namespace
+		}
+		return node.Pods[i].Name < node.Pods[j].Name
+	})
+
+	return &node, nil
+}
+
+// SetNodeUnschedulable cordons (true) or uncordons (false) a node
+func (c *Client) SetNodeUnschedulable(ctx context.Context, name string, unschedulable bool) error {
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	_, err := c.clientset.CoreV1().Nodes().Patch(
+		ctx,
+		name,
+		types.StrategicMergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		action := "cordon"
+		if !unschedulable {
+			action = "uncordon"
+		}
+		return fmt.Errorf("%s node %s: %w", action, name, err)
+	}
+	return nil
+}
+
+// DrainNode cordons a node and evicts its pods through the eviction API, so
+// PodDisruptionBudgets are respected. Evictions refused by a budget are
+// retried until they succeed or ctx is cancelled. Progress for every pod is
+// sent to events; the caller owns the channel.
+func (c *Client) DrainNode(ctx context.Context, name string, opts domain.DrainOptions, events chan<- domain.DrainEvent) error {
+	send := func(ev domain.DrainEvent) {
+		select {
+		case events <- ev:
+		case <-ctx.Done():
+		}
+	}
+
+	if err := c.SetNodeUnschedulable(ctx, name, true); err != nil {
+		return err
+	}
+
+	pods, err := c.nodePods(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// Check every pod before evicting anything, like kubectl drain
+	var evict []*corev1.Pod
+	var blockers []string
+	for _, p := range pods {
+		skip, reason := drainFilter(p, opts)
+		switch {
+		case reason != "" && !skip:
+			blockers = append(blockers, fmt.Sprintf("%s/%s (%s)", p.Namespace, p.Name, reason))
+			send(domain.DrainEvent{Namespace: p.Namespace, Pod: p.Name, Status: domain.DrainStatusFailed, Message: reason})
+		case skip:
+			send(domain.DrainEvent{Namespace: p.Namespace, Pod: p.Name, Status: domain.DrainStatusSkipped, Message: reason})
+		default:
+			evict = append(evict, p)
+		}
+	}
+	if len(blockers) > 0 {
+		return fmt.Errorf("cannot drain node %s: %s", name, strings.Join(blockers, ", "))
+	}
+
+	for _, p := range evict {
+		send(domain.DrainEvent{Namespace: p.Namespace, Pod: p.Name, Status: domain.DrainStatusPending})
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed []string
+	)
+	for _, p := range evict {
+		wg.Add(1)
+		go func(p *corev1.Pod) {
+			defer wg.Done()
+			if err := c.evictPod(ctx, p, send); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				send(domain.DrainEvent{Namespace: p.Namespace, Pod: p.Name, Status: domain.DrainStatusFailed, Message: err.Error()})
+				mu.Lock()
+				failed = append(failed, p.Namespace+"/"+p.Name)
+				mu.Unlock()
+				return
+			}
+			send(domain.DrainEvent{Namespace: p.Namespace, Pod: p.Name, Status: domain.DrainStatusEvicted})
+		}(p)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("drain node %s: failed to evict %s", name, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// evictPod evicts a pod, retrying while a PodDisruptionBudget refuses it, and
+// waits until the pod is gone
+func (c *Client) evictPod(ctx context.Context, p *corev1.Pod, send func(domain.DrainEvent)) error {
+	eviction := &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: p.Name, Namespace: p.Namespace},
+	}
+
+	for {
+		send(domain.DrainEvent{Namespace: p.Namespace, Pod: p.Name, Status: domain.DrainStatusEvicting})
+
+		err := c.clientset.PolicyV1().Evictions(p.Namespace).Evict(ctx, eviction)
+		if err == nil || apierrors.IsNotFound(err) {
+			break
+		}
+		if !apierrors.IsTooManyRequests(err) {
+			return fmt.Errorf("evict: %w", err)
+		}
+
+		// 429: the eviction would violate a PodDisruptionBudget
+		send(domain.DrainEvent{
+			Namespace: p.Namespace,
+			Pod:       p.Name,
+			Status:    domain.DrainStatusBlocked,
+			Message:   fmt.Sprintf("%v; retrying in %s", err, evictionRetryInterval),
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(evictionRetryInterval):
+		}
+	}
+
+	// Wait for the pod to be deleted, or replaced by a pod of the same name
+	for {
+		current, err := c.clientset.CoreV1().Pods(p.Namespace).Get(ctx, p.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) || (err == nil && current.UID != p.UID) {
+			return nil
+		}
+		if err != nil && ctx.Err() == nil {
+			return fmt.Errorf("wait for deletion: %w", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(evictionPollInterval):
+		}
+	}
+}
+
+// drainFilter decides what a drain does with a pod. A pod is skipped when
+// skip is true; a reason without skip means the pod blocks the drain.
+func drainFilter(p *corev1.Pod, opts domain.DrainOptions) (skip bool, reason string) {
+	if _, ok := p.Annotations[mirrorPodAnnotation]; ok {
+		return true, "static pod"
+	}
+
+	// Finished pods can always go
+	if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+		return false, ""
+	}
+
+	controller := metav1.GetControllerOf(p)
+	if controller != nil && controller.Kind == "DaemonSet" {
+		if opts.IgnoreDaemonSets {
+			return true, "DaemonSet-managed"
+		}
+		return false, "managed by DaemonSet, enable ignore DaemonSets"
+	}
+
+	if controller == nil && !opts.Force {
+		return false, "not managed by a controller, enable force"
+	}
+
+	if hasEmptyDir(p) && !opts.DeleteEmptyDirData {
+		return false, "uses emptyDir, enable delete emptyDir data"
+	}
+
+	return false, ""
+}
+
+// nodePods lists all pods scheduled on a node
+func (c *Client) nodePods(ctx context.Context, nodeName string) ([]*corev1.Pod, error) {
+	podList, err := c.clientset.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + nodeName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list pods on node %s: %w", nodeName, err)
+	}
+
+	pods := make([]*corev1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		pods = append(pods, &podList.Items[i])
+	}
+	return pods, nil
+}
+
+func convertNode(n *corev1.Node) domain.Node {
+	node := domain.Node{
+		Name:             n.Name,
+		Roles:            nodeRoles(n),
+		Status:           nodeStatus(n),
+		Unschedulable:    n.Spec.Unschedulable,
+		KubeletVersion:   n.Status.NodeInfo.KubeletVersion,
+		OSImage:          n.Status.NodeInfo.OSImage,
+		KernelVersion:    n.Status.NodeInfo.KernelVersion,
+		ContainerRuntime: n.Status.NodeInfo.ContainerRuntimeVersion,
+		Age:              formatAge(n.CreationTimestamp.Time),
+		CPU:              domain.NodeResource{Allocatable: n.Status.Allocatable.Cpu().MilliValue()},
+		Memory:           domain.NodeResource{Allocatable: n.Status.Allocatable.Memory().Value()},
+		PodCapacity:      n.Status.Allocatable.Pods().Value(),
+	}
+
+	for _, addr := range n.Status.Addresses {
+		switch addr.Type {
+		case corev1.NodeInternalIP:
+			if node.InternalIP == "" {
+				node.InternalIP = addr.Address
+			}
+		case corev1.NodeExternalIP:
+			if node.ExternalIP == "" {
+				node.ExternalIP = addr.Address
+			}
+		case corev1.NodeHostName:
+			node.Hostname = addr.Address
+		}
+	}
+
+	for _, t := range n.Spec.Taints {
+		node.Taints = append(node.Taints, domain.NodeTaint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: string(t.Effect),
+		})
+	}
+
+	for _, cond := range n.Status.Conditions {
+		node.Conditions = append(node.Conditions, domain.NodeCondition{
+			Type:           string(cond.Type),
+			Status:         string(cond.Status),
+			Reason:         cond.Reason,
+			Message:        cond.Message,
+			LastTransition: formatAge(cond.LastTransitionTime.Time),
+		})
+	}
+
+	return node
+}
+
+func convertNodeDetailed(n *corev1.Node) domain.Node {
+	node := convertNode(n)
+	node.Labels = maps.Clone(n.Labels)
+	return node
+}
+
+func convertNodePod(p *corev1.Pod) domain.NodePod {
+	cpu, memory := podRequests(p)
+	pod := domain.NodePod{
+		Name:      p.Name,
+		Namespace: p.Namespace,
+		Status:    getPodStatus(p),
+		CPU:       cpu.MilliValue(),
+		Memory:    memory.Value(),
+		Age:       formatAge(p.CreationTimestamp.Time),
+		LocalData: hasEmptyDir(p),
+	}
+	if _, ok := p.Annotations[mirrorPodAnnotation]; ok {
+		pod.MirrorPod = true
+	}
+	if controller := metav1.GetControllerOf(p); controller != nil {
+		pod.Controller = controller.Kind
+		pod.DaemonSet = controller.Kind == "DaemonSet"
+	}
+	return pod
+}
+
+// addPodRequests adds the requests and limits of pods to a node
+func addPodRequests(node *domain.Node, pods []*corev1.Pod) {
+	node.PodCount = len(pods)
+	for _, p := range pods {
+		cpu, memory := podRequests(p)
+		node.CPU.Requested += cpu.MilliValue()
+		node.Memory.Requested += memory.Value()
+
+		cpuLimit, memoryLimit := podLimits(p)
+		node.CPU.Limits += cpuLimit.MilliValue()
+		node.Memory.Limits += memoryLimit.Value()
+	}
+}
+
+// podRequests returns the effective CPU and memory requests of a pod: the sum
+// over its containers or the largest init container, whichever is higher,
+// plus the pod overhead
+func podRequests(p *corev1.Pod) (cpu, memory resource.Quantity) {
+	return podResources(p, func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Requests })
+}
+
+// podLimits returns the effective CPU and memory limits of a pod
+func podLimits(p *corev1.Pod) (cpu, memory resource.Quantity) {
+	return podResources(p, func(r corev1.ResourceRequirements) corev1.ResourceList { return r.Limits })
+}
+
+func podResources(p *corev1.Pod, list func(corev1.ResourceRequirements) corev1.ResourceList) (cpu, memory resource.Quantity) {
+	for _, c := range p.Spec.Containers {
+		res := list(c.Resources)
+		cpu.Add(*res.Cpu())
+		memory.Add(*res.Memory())
+	}
+	for _, c := range p.Spec.InitContainers {
+		res := list(c.Resources)
+		if res.Cpu().Cmp(cpu) > 0 {
+			cpu = res.Cpu().DeepCopy()
+		}
+		if res.Memory().Cmp(memory) > 0 {
+			memory = res.Memory().DeepCopy()
+		}
+	}
+	if p.Spec.Overhead != nil {
+		cpu.Add(*p.Spec.Overhead.Cpu())
+		memory.Add(*p.Spec.Overhead.Memory())
+	}
+	return cpu, memory
+}
+
+// nodeRoles returns the roles from node-role.kubernetes.io/* labels
+func nodeRoles(n *corev1.Node) []string {
+	var roles []string
+	for k := range n.Labels {
+		if role, ok := strings.CutPrefix(k, nodeRoleLabelPrefix); ok && role != "" {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// nodeStatus returns the status of the Ready condition
+func nodeStatus(n *corev1.Node) string {
+	for _, cond := range n.Status.Conditions {
+		if cond.Type != corev1.NodeReady {
+			continue
+		}
+		switch cond.Status {
+		case corev1.ConditionTrue:
+			return "Ready"
+		case corev1.ConditionFalse:
+			return "NotReady"
+		}
+	}
+	return "Unknown"
+}
+
+// hasEmptyDir reports whether a pod uses emptyDir volumes
+func hasEmptyDir(p *corev1.Pod) bool {
+	for _, v := range p.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetPersistentVolumes returns all persistent volumes. Volumes whose claim is
+// gone are marked as orphaned.
+func (c *Client) GetPersistentVolumes(ctx context.Context) ([]domain.PersistentVolume, error) {
+	pvList, err := c.clientset.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list persistentvolumes: %w", err)
+	}
+	claims := c.claimUIDs(ctx)
+
+	volumes := make([]domain.PersistentVolume, 0, len(pvList.Items))
+	for _, pv := range pvList.Items {
+		volume := convertPersistentVolume(&pv)
+		volume.Orphaned = orphanReason(&pv, claims)
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// GetPersistentVolume returns a single persistent volume
+func (c *Client) GetPersistentVolume(ctx context.Context, name string) (*domain.PersistentVolume, error) {
+	pv, err := c.clientset.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get persistentvolume %s: %w", name, err)
+	}
+
+	volume := convertPersistentVolume(pv)
+	volume.Labels = make(map[string]string, len(pv.Labels))
+	maps.Copy(volume.Labels, pv.Labels)
+	volume.Orphaned = orphanReason(pv, c.claimUIDs(ctx))
+	return &volume, nil
+}
+
+// claimUIDs maps namespace/name of every claim in the cluster to its UID. It
+// returns nil when claims cannot be listed, e.g. without cluster-wide access.
+func (c *Client) claimUIDs(ctx context.Context) map[string]types.UID {
+	pvcList, err := c.clientset.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil
+	}
+	claims := make(map[string]types.UID, len(pvcList.Items))
+	for _, p := range pvcList.Items {
+		claims[p.Namespace+"/"+p.Name] = p.UID
+	}
+	return claims
+}
+
+// orphanReason says why a volume is no longer used by a claim, or returns an
+// empty string. Unclaimed Available volumes are not orphaned; they wait for a
+// claim. claims may be nil when they could not be listed.
+func orphanReason(pv *corev1.PersistentVolume, claims map[string]types.UID) string {
+	switch pv.Status.Phase {
+	case corev1.VolumeReleased:
+		return "claim was deleted"
+	case corev1.VolumeFailed:
+		return "reclamation failed"
+	}
+	ref := pv.Spec.ClaimRef
+	if ref == nil || claims == nil || pv.Status.Phase != corev1.VolumeBound {
+		return ""
+	}
+	uid, ok := claims[ref.Namespace+"/"+ref.Name]
+	if !ok {
+		return "claim not found"
+	}
+	if ref.UID != "" && uid != ref.UID {
+		return "claim was recreated"
+	}
+	return ""
+}
+
+func convertPersistentVolume(pv *corev1.PersistentVolume) domain.PersistentVolume {
+	volume := domain.PersistentVolume{
+		Name:          pv.Name,
+		AccessModes:   accessModes(pv.Spec.AccessModes),
+		ReclaimPolicy: string(pv.Spec.PersistentVolumeReclaimPolicy),
+		Phase:         string(pv.Status.Phase),
+		StorageClass:  pv.Spec.StorageClassName,
+		Reason:        pv.Status.Reason,
+		Message:       pv.Status.Message,
+		Age:           formatAge(pv.CreationTimestamp.Time),
+		Source:        volumeSource(pv),
+		NodeAffinity:  nodeAffinityTerms(pv),
+	}
+	if pv.DeletionTimestamp != nil {
+		volume.Phase = "Terminating"
+	}
+	if q, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+		volume.Capacity = q.String()
+	}
+	if pv.Spec.ClaimRef != nil {
+		volume.Claim = pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+	}
+	if pv.Spec.VolumeMode != nil {
+		volume.VolumeMode = string(*pv.Spec.VolumeMode)
+	}
+	return volume
+}
+
+// volumeSource names the volume plugin of a PV and where the data lives
+func volumeSource(pv *corev1.PersistentVolume) string {
+	s := pv.Spec.PersistentVolumeSource
+	switch {
+	case s.Local != nil:
+		return "local " + s.Local.Path
+	case s.HostPath != nil:
+		return "hostPath " + s.HostPath.Path
+	case s.CSI != nil:
+		return "csi " + s.CSI.Driver + " " + s.CSI.VolumeHandle
+	case s.NFS != nil:
+		return "nfs " + s.NFS.Server + ":" + s.NFS.Path
+	}
+	return ""
+}
+
+// nodeAffinityTerms formats the required node selector terms of a PV, one
+// string per term, e.g. "kubernetes.io/hostname in (node-1)"
+func nodeAffinityTerms(pv *corev1.PersistentVolume) []string {
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return nil
+	}
+
+	var terms []string
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		exprs := make([]string, 0, len(term.MatchExpressions))
+		for _, e := range term.MatchExpressions {
+			expr := fmt.Sprintf("%s %s", e.Key, strings.ToLower(string(e.Operator)))
+			if len(e.Values) > 0 {
+				expr += " (" + strings.Join(e.Values, ",") + ")"
+			}
+			exprs = append(exprs, expr)
+		}
+		if len(exprs) > 0 {
+			terms = append(terms, strings.Join(exprs, ", "))
+		}
+	}
+	return terms
+}
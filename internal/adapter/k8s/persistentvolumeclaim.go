@@ -0,0 +1,208 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetPersistentVolumeClaims returns all claims in the specified namespace, or
+// in every namespace for AllNamespaces, with the pods that mount them
+func (c *Client) GetPersistentVolumeClaims(ctx context.Context, namespace string) ([]domain.PersistentVolumeClaim, error) {
+	namespace = c.listNamespace(namespace)
+
+	pvcList, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list persistentvolumeclaims: %w", err)
+	}
+	podList, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+	mounts := claimPods(podList.Items)
+
+	claims := make([]domain.PersistentVolumeClaim, 0, len(pvcList.Items))
+	for _, p := range pvcList.Items {
+		claim := convertPersistentVolumeClaim(&p)
+		claim.Pods = mounts[p.Namespace+"/"+p.Name]
+		claims = append(claims, claim)
+	}
+	return claims, nil
+}
+
+// GetPersistentVolumeClaim returns a single claim with the pods that mount
+// it, the node affinity of its volume and its events
+func (c *Client) GetPersistentVolumeClaim(ctx context.Context, namespace, name string) (*domain.PersistentVolumeClaim, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	p, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get persistentvolumeclaim %s: %w", name, err)
+	}
+
+	claim := convertPersistentVolumeClaim(p)
+	claim.Labels = make(map[string]string, len(p.Labels))
+	maps.Copy(claim.Labels, p.Labels)
+
+	podList, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list pods: %w", err)
+	}
+	claim.Pods = claimPods(podList.Items)[namespace+"/"+name]
+
+	if p.Spec.VolumeName != "" {
+		if pv, err := c.clientset.CoreV1().PersistentVolumes().Get(ctx, p.Spec.VolumeName, metav1.GetOptions{}); err == nil {
+			claim.VolumeNodeAffinity = nodeAffinityTerms(pv)
+		}
+	}
+
+	// Provisioning problems are only reported as events
+	if events, err := c.objectEvents(ctx, namespace, "PersistentVolumeClaim", name); err == nil {
+		claim.Events = events
+	}
+
+	return &claim, nil
+}
+
+// ResizePersistentVolumeClaim patches the storage requested by a claim.
+// Volumes can only grow, and only when their storage class allows expansion;
+// the k3s local-path provisioner does not.
+func (c *Client) ResizePersistentVolumeClaim(ctx context.Context, namespace, name, size string) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	claims := c.clientset.CoreV1().PersistentVolumeClaims(namespace)
+	pvc, err := claims.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get persistentvolumeclaim %s: %w", name, err)
+	}
+	if err := ValidateClaimSize(pvc.Spec.Resources.Requests.Storage().String(), size); err != nil {
+		return err
+	}
+	quantity := resource.MustParse(strings.TrimSpace(size))
+
+	if class := claimStorageClass(pvc); class != "" {
+		sc, err := c.clientset.StorageV1().StorageClasses().Get(ctx, class, metav1.GetOptions{})
+		if err == nil && (sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion) {
+			return fmt.Errorf("storage class %s does not allow volume expansion", class)
+		}
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"resources":{"requests":{"storage":%q}}}}`, quantity.String())
+
+	_, err = claims.Patch(
+		ctx,
+		name,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{FieldManager: "k4s"},
+	)
+	if err != nil {
+		return fmt.Errorf("patch persistentvolumeclaim %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// ValidateClaimSize checks a new size for a claim that currently requests
+// current. Claims can only grow.
+func ValidateClaimSize(current, size string) error {
+	q, err := resource.ParseQuantity(strings.TrimSpace(size))
+	if err != nil {
+		return fmt.Errorf("invalid size, use e.g. 10Gi")
+	}
+	if cur, err := resource.ParseQuantity(current); err == nil && q.Cmp(cur) <= 0 {
+		return fmt.Errorf("must be larger than %s", current)
+	}
+	return nil
+}
+
+func convertPersistentVolumeClaim(p *corev1.PersistentVolumeClaim) domain.PersistentVolumeClaim {
+	claim := domain.PersistentVolumeClaim{
+		Name:         p.Name,
+		Namespace:    p.Namespace,
+		Phase:        string(p.Status.Phase),
+		AccessModes:  accessModes(p.Spec.AccessModes),
+		StorageClass: claimStorageClass(p),
+		VolumeName:   p.Spec.VolumeName,
+		Age:          formatAge(p.CreationTimestamp.Time),
+	}
+	if p.DeletionTimestamp != nil {
+		claim.Phase = "Terminating"
+	}
+	if q, ok := p.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		claim.Requested = q.String()
+	}
+	if q, ok := p.Status.Capacity[corev1.ResourceStorage]; ok {
+		claim.Capacity = q.String()
+	}
+	if p.Spec.VolumeMode != nil {
+		claim.VolumeMode = string(*p.Spec.VolumeMode)
+	}
+	for _, cond := range p.Status.Conditions {
+		if cond.Status == corev1.ConditionTrue {
+			claim.Resizing = string(cond.Type)
+		}
+	}
+	return claim
+}
+
+// claimStorageClass returns the storage class of a claim, including the
+// annotation used before storageClassName existed
+func claimStorageClass(p *corev1.PersistentVolumeClaim) string {
+	if p.Spec.StorageClassName != nil {
+		return *p.Spec.StorageClassName
+	}
+	return p.Annotations["volume.beta.kubernetes.io/storage-class"]
+}
+
+// claimPods maps namespace/claim to the pods mounting the claim
+func claimPods(pods []corev1.Pod) map[string][]domain.VolumePod {
+	mounts := make(map[string][]domain.VolumePod)
+	for i := range pods {
+		p := &pods[i]
+		for _, v := range p.Spec.Volumes {
+			if v.PersistentVolumeClaim == nil {
+				continue
+			}
+			key := p.Namespace + "/" + v.PersistentVolumeClaim.ClaimName
+			mounts[key] = append(mounts[key], domain.VolumePod{
+				Name:   p.Name,
+				Status: getPodStatus(p),
+				Node:   p.Spec.NodeName,
+			})
+		}
+	}
+	return mounts
+}
+
+// accessModes returns the short names kubectl prints for access modes
+func accessModes(modes []corev1.PersistentVolumeAccessMode) []string {
+	result := make([]string, 0, len(modes))
+	for _, m := range modes {
+		switch m {
+		case corev1.ReadWriteOnce:
+			result = append(result, "RWO")
+		case corev1.ReadOnlyMany:
+			result = append(result, "ROX")
+		case corev1.ReadWriteMany:
+			result = append(result, "RWX")
+		case corev1.ReadWriteOncePod:
+			result = append(result, "RWOP")
+		default:
+			result = append(result, string(m))
+		}
+	}
+	return result
+}
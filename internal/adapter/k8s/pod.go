@@ -3,18 +3,18 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/LywwKkA-aD/k4s/internal/domain"
 )
 
-// GetPods returns all pods in the specified namespace
+// GetPods returns all pods in the specified namespace, or in every namespace for AllNamespaces
 func (c *Client) GetPods(ctx context.Context, namespace string) ([]domain.Pod, error) {
-	if namespace == "" {
-		namespace = c.namespace
-	}
+	namespace = c.listNamespace(namespace)
 
 	podList, err := c.clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -40,16 +40,35 @@ func (c *Client) GetPod(ctx context.Context, namespace, name string) (*domain.Po
 	}
 
 	pod := convertPodDetailed(p)
+
+	// A missing or pending claim is a common reason for a pod to stay Pending
+	for i, v := range pod.Volumes {
+		if v.Type != "persistentVolumeClaim" {
+			continue
+		}
+		pvc, err := c.clientset.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, v.Source, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			pod.Volumes[i].ClaimPhase = "NotFound"
+		case err == nil:
+			pod.Volumes[i].ClaimPhase = string(pvc.Status.Phase)
+		}
+	}
 	return &pod, nil
 }
 
 // GetPodEvents returns events for a specific pod
 func (c *Client) GetPodEvents(ctx context.Context, namespace, podName string) ([]domain.PodEvent, error) {
+	return c.objectEvents(ctx, namespace, "Pod", podName)
+}
+
+// objectEvents returns the events of one object
+func (c *Client) objectEvents(ctx context.Context, namespace, kind, name string) ([]domain.PodEvent, error) {
 	if namespace == "" {
 		namespace = c.namespace
 	}
 
-	fieldSelector := fmt.Sprintf("involvedObject.name=%s,involvedObject.kind=Pod", podName)
+	fieldSelector := fmt.Sprintf("involvedObject.name=%s,involvedObject.kind=%s", name, kind)
 	eventList, err := c.clientset.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
 		FieldSelector: fieldSelector,
 	})
@@ -82,6 +101,7 @@ func convertPod(p *corev1.Pod) domain.Pod {
 		container := domain.Container{
 			Name:  c.Name,
 			Image: c.Image,
+			Ports: convertContainerPorts(c.Ports),
 		}
 
 		// Find container status
@@ -109,6 +129,7 @@ func convertPod(p *corev1.Pod) domain.Pod {
 		Restarts:   totalRestarts,
 		Age:        formatAge(p.CreationTimestamp.Time),
 		Node:       p.Spec.NodeName,
+		Labels:     p.Labels,
 		Containers: containers,
 	}
 }
@@ -130,6 +151,7 @@ func convertPodDetailed(p *corev1.Pod) domain.Pod {
 				MemoryRequest: c.Resources.Requests.Memory().String(),
 				MemoryLimit:   c.Resources.Limits.Memory().String(),
 			},
+			Ports: convertContainerPorts(c.Ports),
 		}
 
 		// Find container status
@@ -188,7 +210,79 @@ func convertPodDetailed(p *corev1.Pod) domain.Pod {
 		Annotations: annotations,
 		Containers:  containers,
 		Conditions:  conditions,
+		Volumes:     convertPodVolumes(p.Spec.Volumes),
+	}
+}
+
+func convertPodVolumes(volumes []corev1.Volume) []domain.PodVolume {
+	result := make([]domain.PodVolume, 0, len(volumes))
+	for _, v := range volumes {
+		vol := domain.PodVolume{Name: v.Name}
+		switch {
+		case v.PersistentVolumeClaim != nil:
+			vol.Type = "persistentVolumeClaim"
+			vol.Source = v.PersistentVolumeClaim.ClaimName
+		case v.ConfigMap != nil:
+			vol.Type = "configMap"
+			vol.Source = v.ConfigMap.Name
+		case v.Secret != nil:
+			vol.Type = "secret"
+			vol.Source = v.Secret.SecretName
+		case v.EmptyDir != nil:
+			vol.Type = "emptyDir"
+			vol.Source = string(v.EmptyDir.Medium)
+		case v.HostPath != nil:
+			vol.Type = "hostPath"
+			vol.Source = v.HostPath.Path
+		case v.Projected != nil:
+			vol.Type = "projected"
+			vol.Source = projectedSources(v.Projected)
+		case v.DownwardAPI != nil:
+			vol.Type = "downwardAPI"
+		case v.NFS != nil:
+			vol.Type = "nfs"
+			vol.Source = v.NFS.Server + ":" + v.NFS.Path
+		case v.CSI != nil:
+			vol.Type = "csi"
+			vol.Source = v.CSI.Driver
+		case v.Ephemeral != nil:
+			vol.Type = "ephemeral"
+		default:
+			vol.Type = "other"
+		}
+		result = append(result, vol)
+	}
+	return result
+}
+
+// projectedSources names the kinds of sources of a projected volume
+func projectedSources(p *corev1.ProjectedVolumeSource) string {
+	kinds := make([]string, 0, len(p.Sources))
+	for _, s := range p.Sources {
+		switch {
+		case s.ServiceAccountToken != nil:
+			kinds = append(kinds, "serviceAccountToken")
+		case s.ConfigMap != nil:
+			kinds = append(kinds, "configMap:"+s.ConfigMap.Name)
+		case s.Secret != nil:
+			kinds = append(kinds, "secret:"+s.Secret.Name)
+		case s.DownwardAPI != nil:
+			kinds = append(kinds, "downwardAPI")
+		}
+	}
+	return strings.Join(kinds, ",")
+}
+
+func convertContainerPorts(ports []corev1.ContainerPort) []domain.ContainerPort {
+	result := make([]domain.ContainerPort, 0, len(ports))
+	for _, p := range ports {
+		result = append(result, domain.ContainerPort{
+			Name:          p.Name,
+			ContainerPort: p.ContainerPort,
+			Protocol:      string(p.Protocol),
+		})
 	}
+	return result
 }
 
 func getPodStatus(p *corev1.Pod) string {
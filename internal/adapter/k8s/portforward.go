@@ -0,0 +1,426 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+)
+
+// portForwardRetryDelay is how long a broken forward waits before reconnecting
+const portForwardRetryDelay = 3 * time.Second
+
+var portForwardIDs atomic.Int64
+
+// PortForwardRequest describes a port-forward to start
+type PortForwardRequest struct {
+	Kind      string // domain.PortForwardKindPod or domain.PortForwardKindService
+	Namespace string
+	Name      string
+	Port      int32 // container port for pods, service port for services
+	LocalPort int   // 0 picks a free local port
+}
+
+// PortForward is a running port-forward. It reconnects on its own when the
+// connection to the pod is lost (e.g. the pod was replaced) until stopped.
+type PortForward struct {
+	id      int
+	client  *Client
+	req     PortForwardRequest
+	started time.Time
+
+	bytesIn  atomic.Int64
+	bytesOut atomic.Int64
+	conns    atomic.Int64
+
+	mu         sync.Mutex
+	pod        string
+	localPort  int
+	remotePort int
+	status     string
+	lastErr    string
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// StartPortForward starts forwarding a local port to a pod or service and
+// returns once the local listener is ready
+func (c *Client) StartPortForward(req PortForwardRequest) (*PortForward, error) {
+	if req.Namespace == "" {
+		req.Namespace = c.namespace
+	}
+
+	pf := &PortForward{
+		id:        int(portForwardIDs.Add(1)),
+		client:    c,
+		req:       req,
+		started:   time.Now(),
+		localPort: req.LocalPort,
+		status:    domain.PortForwardStatusActive,
+		stopCh:    make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	started := make(chan error, 1)
+	go pf.run(started)
+
+	if err := <-started; err != nil {
+		return nil, fmt.Errorf("port-forward %s/%s: %w", req.Namespace, req.Name, err)
+	}
+	return pf, nil
+}
+
+// Stop stops the port-forward and closes the local listener
+func (pf *PortForward) Stop() {
+	pf.stopOnce.Do(func() {
+		close(pf.stopCh)
+	})
+}
+
+// Done returns a channel that is closed once the port-forward has ended
+func (pf *PortForward) Done() <-chan struct{} {
+	return pf.done
+}
+
+// ID returns the unique id of the port-forward
+func (pf *PortForward) ID() int {
+	return pf.id
+}
+
+// Info returns a snapshot of the port-forward state
+func (pf *PortForward) Info() domain.PortForward {
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+
+	return domain.PortForward{
+		ID:         pf.id,
+		Namespace:  pf.req.Namespace,
+		Kind:       pf.req.Kind,
+		Target:     pf.req.Name,
+		Pod:        pf.pod,
+		LocalPort:  pf.localPort,
+		RemotePort: pf.remotePort,
+		BytesIn:    pf.bytesIn.Load(),
+		BytesOut:   pf.bytesOut.Load(),
+		Conns:      int(pf.conns.Load()),
+		Status:     pf.status,
+		Error:      pf.lastErr,
+		Started:    pf.started,
+	}
+}
+
+func (pf *PortForward) stopped() bool {
+	select {
+	case <-pf.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+func (pf *PortForward) setError(err error) {
+	pf.mu.Lock()
+	defer pf.mu.Unlock()
+	pf.status = domain.PortForwardStatusError
+	pf.lastErr = err.Error()
+}
+
+// run keeps the forward alive until it is stopped. The first error before the
+// listener became ready is reported through started and ends the forward.
+func (pf *PortForward) run(started chan<- error) {
+	defer close(pf.done)
+
+	var startOnce sync.Once
+	signal := func(err error) {
+		startOnce.Do(func() { started <- err })
+	}
+	var ready atomic.Bool
+
+	for {
+		err := pf.forward(func() {
+			ready.Store(true)
+			signal(nil)
+		})
+
+		if pf.stopped() {
+			pf.mu.Lock()
+			pf.status = domain.PortForwardStatusStopped
+			pf.mu.Unlock()
+			signal(fmt.Errorf("stopped"))
+			return
+		}
+
+		if err == nil {
+			err = fmt.Errorf("connection closed")
+		}
+		if !ready.Load() {
+			signal(err)
+			return
+		}
+
+		logger.Debug("Port-forward interrupted, reconnecting", "id", pf.id, "target", pf.req.Name, "err", err)
+		pf.setError(err)
+
+		select {
+		case <-pf.stopCh:
+		case <-time.After(portForwardRetryDelay):
+		}
+	}
+}
+
+// forward runs a single forwarding session until the connection to the pod breaks
+func (pf *PortForward) forward(onReady func()) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	podName, podPort, err := pf.client.resolvePortForwardTarget(ctx, pf.req)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	dialer, err := pf.client.portForwardDialer(pf.req.Namespace, podName)
+	if err != nil {
+		return err
+	}
+
+	pf.mu.Lock()
+	localPort := pf.localPort
+	pf.mu.Unlock()
+
+	readyCh := make(chan struct{})
+	fw, err := portforward.NewOnAddresses(
+		&countingDialer{Dialer: dialer, pf: pf},
+		[]string{"127.0.0.1"},
+		[]string{fmt.Sprintf("%d:%d", localPort, podPort)},
+		pf.stopCh,
+		readyCh,
+		io.Discard,
+		io.Discard,
+	)
+	if err != nil {
+		return err
+	}
+
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-readyCh:
+		case <-finished:
+			return
+		}
+
+		pf.mu.Lock()
+		if ports, err := fw.GetPorts(); err == nil && len(ports) > 0 {
+			pf.localPort = int(ports[0].Local)
+		}
+		pf.pod = podName
+		pf.remotePort = int(podPort)
+		pf.status = domain.PortForwardStatusActive
+		pf.lastErr = ""
+		pf.mu.Unlock()
+
+		onReady()
+	}()
+
+	return fw.ForwardPorts()
+}
+
+// portForwardDialer creates a dialer for the pod portforward subresource,
+// preferring websockets and falling back to SPDY
+func (c *Client) portForwardDialer(namespace, podName string) (httpstream.Dialer, error) {
+	config := *c.restConfig
+	config.Timeout = 0
+
+	req := c.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(podName).
+		SubResource("portforward")
+
+	transport, upgrader, err := spdy.RoundTripperFor(&config)
+	if err != nil {
+		return nil, fmt.Errorf("create spdy round tripper: %w", err)
+	}
+	spdyDialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
+
+	tunnelingDialer, err := portforward.NewSPDYOverWebsocketDialer(req.URL(), &config)
+	if err != nil {
+		return nil, fmt.Errorf("create websocket dialer: %w", err)
+	}
+
+	return portforward.NewFallbackDialer(tunnelingDialer, spdyDialer, func(err error) bool {
+		return httpstream.IsUpgradeFailure(err) || httpstream.IsHTTPSProxyError(err)
+	}), nil
+}
+
+// resolvePortForwardTarget picks the pod and pod port that traffic should go to
+func (c *Client) resolvePortForwardTarget(ctx context.Context, req PortForwardRequest) (string, int32, error) {
+	if req.Kind != domain.PortForwardKindService {
+		pod, err := c.clientset.CoreV1().Pods(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+		if err != nil {
+			return "", 0, fmt.Errorf("get pod %s: %w", req.Name, err)
+		}
+		if pod.Status.Phase != corev1.PodRunning {
+			return "", 0, fmt.Errorf("pod %s is not running (%s)", pod.Name, pod.Status.Phase)
+		}
+		return pod.Name, req.Port, nil
+	}
+
+	svc, err := c.clientset.CoreV1().Services(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("get service %s: %w", req.Name, err)
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s has no selector", svc.Name)
+	}
+
+	var svcPort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Port == req.Port {
+			svcPort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if svcPort == nil {
+		return "", 0, fmt.Errorf("service %s has no port %d", svc.Name, req.Port)
+	}
+
+	podList, err := c.clientset.CoreV1().Pods(req.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+	})
+	if err != nil {
+		return "", 0, fmt.Errorf("list pods for service %s: %w", svc.Name, err)
+	}
+
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil || !isPodReady(pod) {
+			continue
+		}
+		port, err := containerPortFor(pod, svcPort)
+		if err != nil {
+			return "", 0, err
+		}
+		return pod.Name, port, nil
+	}
+
+	return "", 0, fmt.Errorf("no running pods behind service %s", svc.Name)
+}
+
+// containerPortFor resolves a service target port (numeric or named) on a pod
+func containerPortFor(pod *corev1.Pod, svcPort *corev1.ServicePort) (int32, error) {
+	target := svcPort.TargetPort
+	if target.String() == "" || target.String() == "0" {
+		return svcPort.Port, nil
+	}
+	if port, err := strconv.Atoi(target.String()); err == nil {
+		return int32(port), nil
+	}
+
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == target.String() {
+				return p.ContainerPort, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("pod %s has no port named %q", pod.Name, target.String())
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// countingDialer wraps connections so the forward can account traffic and
+// remember errors reported by the kubelet
+type countingDialer struct {
+	httpstream.Dialer
+	pf *PortForward
+}
+
+func (d *countingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, protocol, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, "", err
+	}
+	return &countingConnection{Connection: conn, pf: d.pf}, protocol, nil
+}
+
+type countingConnection struct {
+	httpstream.Connection
+	pf *PortForward
+}
+
+func (c *countingConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	stream, err := c.Connection.CreateStream(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	switch headers.Get(corev1.StreamType) {
+	case corev1.StreamTypeData:
+		c.pf.conns.Add(1)
+		return &countingStream{Stream: stream, pf: c.pf}, nil
+	case corev1.StreamTypeError:
+		return &errorStream{Stream: stream, pf: c.pf}, nil
+	}
+	return stream, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+	pf *PortForward
+}
+
+func (s *countingStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	s.pf.bytesIn.Add(int64(n))
+	return n, err
+}
+
+func (s *countingStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	s.pf.bytesOut.Add(int64(n))
+	return n, err
+}
+
+// errorStream records messages the kubelet sends on the error stream, such as
+// a refused connection inside the pod
+type errorStream struct {
+	httpstream.Stream
+	pf *PortForward
+}
+
+func (s *errorStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	if n > 0 {
+		msg := strings.TrimSpace(string(p[:n]))
+		s.pf.mu.Lock()
+		s.pf.lastErr = msg
+		s.pf.mu.Unlock()
+		logger.Debug("Port-forward error", "id", s.pf.id, "err", msg)
+	}
+	return n, err
+}
@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// revisionAnnotation holds the rollout revision of a Deployment's ReplicaSet
+const revisionAnnotation = "deployment.kubernetes.io/revision"
+
+// GetReplicaSets returns all replicasets in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetReplicaSets(ctx context.Context, namespace string) ([]domain.ReplicaSet, error) {
+	namespace = c.listNamespace(namespace)
+
+	rsList, err := c.clientset.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list replicasets: %w", err)
+	}
+
+	replicaSets := make([]domain.ReplicaSet, 0, len(rsList.Items))
+	for _, r := range rsList.Items {
+		replicaSets = append(replicaSets, convertReplicaSet(&r))
+	}
+	return replicaSets, nil
+}
+
+// GetReplicaSet returns a single replicaset with full details
+func (c *Client) GetReplicaSet(ctx context.Context, namespace, name string) (*domain.ReplicaSet, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	r, err := c.clientset.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get replicaset %s: %w", name, err)
+	}
+
+	replicaSet := convertReplicaSetDetailed(r)
+	return &replicaSet, nil
+}
+
+func convertReplicaSet(r *appsv1.ReplicaSet) domain.ReplicaSet {
+	replicas := int32(1)
+	if r.Spec.Replicas != nil {
+		replicas = *r.Spec.Replicas
+	}
+
+	images := make([]string, 0, len(r.Spec.Template.Spec.Containers))
+	for _, c := range r.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	owner := ""
+	if ref := metav1.GetControllerOf(r); ref != nil {
+		owner = ref.Kind + "/" + ref.Name
+	}
+
+	return domain.ReplicaSet{
+		Name:      r.Name,
+		Namespace: r.Namespace,
+		Desired:   replicas,
+		Current:   r.Status.Replicas,
+		Ready:     r.Status.ReadyReplicas,
+		Owner:     owner,
+		Revision:  r.Annotations[revisionAnnotation],
+		Age:       formatAge(r.CreationTimestamp.Time),
+		Images:    images,
+	}
+}
+
+func convertReplicaSetDetailed(r *appsv1.ReplicaSet) domain.ReplicaSet {
+	replicaSet := convertReplicaSet(r)
+
+	replicaSet.Labels = make(map[string]string, len(r.Labels))
+	maps.Copy(replicaSet.Labels, r.Labels)
+
+	replicaSet.Selector = make(map[string]string)
+	if r.Spec.Selector != nil {
+		maps.Copy(replicaSet.Selector, r.Spec.Selector.MatchLabels)
+	}
+
+	return replicaSet
+}
@@ -0,0 +1,215 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// changeCauseAnnotation records why a revision was rolled out
+const changeCauseAnnotation = "kubernetes.io/change-cause"
+
+// SetDeploymentPaused pauses or resumes the rollout of a deployment
+func (c *Client) SetDeploymentPaused(ctx context.Context, namespace, name string, paused bool) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"paused":%t}}`, paused))
+	_, err := c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		if paused {
+			return fmt.Errorf("pause deployment %s: %w", name, err)
+		}
+		return fmt.Errorf("resume deployment %s: %w", name, err)
+	}
+	return nil
+}
+
+// RollbackDeployment rolls a deployment back to the pod template of an
+// earlier revision, like kubectl rollout undo --to-revision
+func (c *Client) RollbackDeployment(ctx context.Context, namespace, name string, revision int64) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	d, err := c.clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get deployment %s: %w", name, err)
+	}
+	if d.Spec.Paused {
+		return fmt.Errorf("deployment %s is paused, resume it before rolling back", name)
+	}
+
+	replicaSets, err := c.ownedReplicaSets(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	var target *appsv1.ReplicaSet
+	for i := range replicaSets {
+		if replicaSetRevision(&replicaSets[i]) == revision {
+			target = &replicaSets[i]
+			break
+		}
+	}
+	if target == nil {
+		return fmt.Errorf("revision %d of deployment %s not found", revision, name)
+	}
+
+	template := rolloutTemplate(target)
+	patch, err := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/spec/template", "value": template},
+	})
+	if err != nil {
+		return fmt.Errorf("build rollback patch: %w", err)
+	}
+
+	_, err = c.clientset.AppsV1().Deployments(namespace).Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("roll back deployment %s to revision %d: %w", name, revision, err)
+	}
+	return nil
+}
+
+// ownedReplicaSets returns the ReplicaSets controlled by a deployment
+func (c *Client) ownedReplicaSets(ctx context.Context, d *appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("parse selector of deployment %s: %w", d.Name, err)
+	}
+	if selector.Empty() {
+		selector = labels.Nothing()
+	}
+
+	rsList, err := c.clientset.AppsV1().ReplicaSets(d.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list replicasets of deployment %s: %w", d.Name, err)
+	}
+
+	owned := make([]appsv1.ReplicaSet, 0, len(rsList.Items))
+	for _, rs := range rsList.Items {
+		if metav1.IsControlledBy(&rs, d) {
+			owned = append(owned, rs)
+		}
+	}
+	return owned, nil
+}
+
+// deploymentHistory returns the rollout history of a deployment, newest first
+func (c *Client) deploymentHistory(ctx context.Context, d *appsv1.Deployment) ([]domain.DeploymentRevision, error) {
+	replicaSets, err := c.ownedReplicaSets(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	current := d.Annotations[revisionAnnotation]
+	history := make([]domain.DeploymentRevision, 0, len(replicaSets))
+	for i := range replicaSets {
+		rs := &replicaSets[i]
+
+		replicas := int32(0)
+		if rs.Spec.Replicas != nil {
+			replicas = *rs.Spec.Replicas
+		}
+
+		images := make([]string, 0, len(rs.Spec.Template.Spec.Containers))
+		for _, ctr := range rs.Spec.Template.Spec.Containers {
+			images = append(images, ctr.Image)
+		}
+
+		template, err := yaml.Marshal(rolloutTemplate(rs))
+		if err != nil {
+			return nil, fmt.Errorf("marshal template of replicaset %s: %w", rs.Name, err)
+		}
+
+		revision := replicaSetRevision(rs)
+		history = append(history, domain.DeploymentRevision{
+			Revision:    revision,
+			ReplicaSet:  rs.Name,
+			Replicas:    replicas,
+			Ready:       rs.Status.ReadyReplicas,
+			Images:      images,
+			ChangeCause: rs.Annotations[changeCauseAnnotation],
+			Age:         formatAge(rs.CreationTimestamp.Time),
+			Current:     current != "" && strconv.FormatInt(revision, 10) == current,
+			Template:    string(template),
+		})
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Revision > history[j].Revision
+	})
+	return history, nil
+}
+
+// replicaSetRevision returns the deployment revision of a ReplicaSet, 0 if unknown
+func replicaSetRevision(rs *appsv1.ReplicaSet) int64 {
+	revision, err := strconv.ParseInt(rs.Annotations[revisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// rolloutTemplate returns the pod template of a ReplicaSet without the
+// pod-template-hash label the deployment controller adds to it
+func rolloutTemplate(rs *appsv1.ReplicaSet) *corev1.PodTemplateSpec {
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	return template
+}
+
+// rolloutStatus mirrors the messages of kubectl rollout status
+func rolloutStatus(d *appsv1.Deployment) domain.RolloutStatus {
+	desired := int32(1)
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
+
+	status := domain.RolloutStatus{
+		Updated:   d.Status.UpdatedReplicas,
+		Total:     d.Status.Replicas,
+		Desired:   desired,
+		Available: d.Status.AvailableReplicas,
+	}
+
+	if d.Generation > d.Status.ObservedGeneration {
+		status.Message = "Waiting for deployment spec update to be observed..."
+		return status
+	}
+
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == "ProgressDeadlineExceeded" {
+			status.Failed = true
+			status.Message = fmt.Sprintf("Deployment %q exceeded its progress deadline", d.Name)
+			return status
+		}
+	}
+
+	switch {
+	case d.Status.UpdatedReplicas < desired:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d out of %d new replicas have been updated...", d.Status.UpdatedReplicas, desired)
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d old replicas are pending termination...", d.Status.Replicas-d.Status.UpdatedReplicas)
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		status.Message = fmt.Sprintf("Waiting for rollout to finish: %d of %d updated replicas are available...", d.Status.AvailableReplicas, d.Status.UpdatedReplicas)
+	default:
+		status.Complete = true
+		status.Message = fmt.Sprintf("Deployment %q successfully rolled out", d.Name)
+	}
+	return status
+}
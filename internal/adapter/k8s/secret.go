@@ -0,0 +1,143 @@
+package k8s
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetSecrets returns all secrets in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetSecrets(ctx context.Context, namespace string) ([]domain.Secret, error) {
+	namespace = c.listNamespace(namespace)
+
+	secretList, err := c.clientset.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list secrets: %w", err)
+	}
+
+	secrets := make([]domain.Secret, 0, len(secretList.Items))
+	for _, s := range secretList.Items {
+		secrets = append(secrets, convertSecret(&s))
+	}
+	return secrets, nil
+}
+
+// GetSecret returns a single secret with its decoded data. The certificate
+// of kubernetes.io/tls secrets is parsed.
+func (c *Client) GetSecret(ctx context.Context, namespace, name string) (*domain.Secret, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	s, err := c.clientset.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get secret %s: %w", name, err)
+	}
+
+	secret := convertSecret(s)
+	secret.Labels = make(map[string]string, len(s.Labels))
+	maps.Copy(secret.Labels, s.Labels)
+	for k, v := range s.Data {
+		secret.Data = append(secret.Data, domain.DataItem{Key: k, Value: v, Binary: !isText(v)})
+	}
+	sortDataItems(secret.Data)
+
+	if s.Type == corev1.SecretTypeTLS {
+		certs, err := parseCertificates(s.Data[corev1.TLSCertKey])
+		if err != nil {
+			secret.CertError = err.Error()
+		}
+		secret.Certificates = certs
+	}
+
+	return &secret, nil
+}
+
+// SetSecretKey replaces the value of one key of a secret. It fails if the
+// secret changed since resourceVersion. With dryRun the API server only
+// validates the change.
+func (c *Client) SetSecretKey(ctx context.Context, namespace, name, key, value, resourceVersion string, dryRun bool) error {
+	secrets := c.clientset.CoreV1().Secrets(namespace)
+	s, err := secrets.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get secret %s: %w", name, err)
+	}
+	if s.ResourceVersion != resourceVersion {
+		return fmt.Errorf("secret %s was changed since the edit started", name)
+	}
+
+	if s.Data == nil {
+		s.Data = make(map[string][]byte)
+	}
+	s.Data[key] = []byte(value)
+
+	opts := metav1.UpdateOptions{FieldManager: "k4s"}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if _, err := secrets.Update(ctx, s, opts); err != nil {
+		return fmt.Errorf("update secret %s: %w", name, err)
+	}
+	return nil
+}
+
+func convertSecret(s *corev1.Secret) domain.Secret {
+	size := 0
+	for _, v := range s.Data {
+		size += len(v)
+	}
+
+	return domain.Secret{
+		Name:            s.Name,
+		Namespace:       s.Namespace,
+		Type:            string(s.Type),
+		Keys:            len(s.Data),
+		Size:            size,
+		Age:             formatAge(s.CreationTimestamp.Time),
+		ResourceVersion: s.ResourceVersion,
+	}
+}
+
+// parseCertificates parses the PEM certificate chain of a TLS secret, leaf first
+func parseCertificates(data []byte) ([]domain.Certificate, error) {
+	var certs []domain.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return certs, fmt.Errorf("parse certificate: %w", err)
+		}
+		ips := make([]string, 0, len(cert.IPAddresses))
+		for _, ip := range cert.IPAddresses {
+			ips = append(ips, ip.String())
+		}
+		certs = append(certs, domain.Certificate{
+			Subject:     cert.Subject.String(),
+			Issuer:      cert.Issuer.String(),
+			DNSNames:    cert.DNSNames,
+			IPAddresses: ips,
+			NotBefore:   cert.NotBefore,
+			NotAfter:    cert.NotAfter,
+			IsCA:        cert.IsCA,
+		})
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM certificate in %s", corev1.TLSCertKey)
+	}
+	return certs, nil
+}
@@ -12,11 +12,9 @@ import (
 	"github.com/LywwKkA-aD/k4s/internal/domain"
 )
 
-// GetServices returns all services in the specified namespace
+// GetServices returns all services in the specified namespace, or in every namespace for AllNamespaces
 func (c *Client) GetServices(ctx context.Context, namespace string) ([]domain.Service, error) {
-	if namespace == "" {
-		namespace = c.namespace
-	}
+	namespace = c.listNamespace(namespace)
 
 	svcList, err := c.clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -54,6 +52,8 @@ func convertService(s *corev1.Service) domain.Service {
 		ExternalIP: formatExternalIP(s),
 		Ports:      formatPorts(s.Spec.Ports),
 		Age:        formatAge(s.CreationTimestamp.Time),
+		// Port details are cheap and needed to port-forward from the list
+		PortDetails: convertServicePorts(s.Spec.Ports),
 	}
 }
 
@@ -66,18 +66,6 @@ func convertServiceDetailed(s *corev1.Service) domain.Service {
 	labels := make(map[string]string, len(s.Labels))
 	maps.Copy(labels, s.Labels)
 
-	// Convert port details
-	portDetails := make([]domain.ServicePort, 0, len(s.Spec.Ports))
-	for _, p := range s.Spec.Ports {
-		portDetails = append(portDetails, domain.ServicePort{
-			Name:       p.Name,
-			Port:       p.Port,
-			TargetPort: p.TargetPort.String(),
-			NodePort:   p.NodePort,
-			Protocol:   string(p.Protocol),
-		})
-	}
-
 	return domain.Service{
 		Name:        s.Name,
 		Namespace:   s.Namespace,
@@ -88,8 +76,22 @@ func convertServiceDetailed(s *corev1.Service) domain.Service {
 		Age:         formatAge(s.CreationTimestamp.Time),
 		Selector:    selector,
 		Labels:      labels,
-		PortDetails: portDetails,
+		PortDetails: convertServicePorts(s.Spec.Ports),
+	}
+}
+
+func convertServicePorts(ports []corev1.ServicePort) []domain.ServicePort {
+	portDetails := make([]domain.ServicePort, 0, len(ports))
+	for _, p := range ports {
+		portDetails = append(portDetails, domain.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: p.TargetPort.String(),
+			NodePort:   p.NodePort,
+			Protocol:   string(p.Protocol),
+		})
 	}
+	return portDetails
 }
 
 // formatPorts formats service ports for display
@@ -0,0 +1,130 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// GetStatefulSets returns all statefulsets in the specified namespace, or in every namespace for AllNamespaces
+func (c *Client) GetStatefulSets(ctx context.Context, namespace string) ([]domain.StatefulSet, error) {
+	namespace = c.listNamespace(namespace)
+
+	stsList, err := c.clientset.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list statefulsets: %w", err)
+	}
+
+	statefulSets := make([]domain.StatefulSet, 0, len(stsList.Items))
+	for _, s := range stsList.Items {
+		statefulSets = append(statefulSets, convertStatefulSet(&s))
+	}
+	return statefulSets, nil
+}
+
+// GetStatefulSet returns a single statefulset with full details
+func (c *Client) GetStatefulSet(ctx context.Context, namespace, name string) (*domain.StatefulSet, error) {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	s, err := c.clientset.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get statefulset %s: %w", name, err)
+	}
+
+	statefulSet := convertStatefulSetDetailed(s)
+	return &statefulSet, nil
+}
+
+// ScaleStatefulSet scales a statefulset to the specified number of replicas
+func (c *Client) ScaleStatefulSet(ctx context.Context, namespace, name string, replicas int32) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	scale, err := c.clientset.AppsV1().StatefulSets(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get statefulset scale %s: %w", name, err)
+	}
+
+	scale.Spec.Replicas = replicas
+	_, err = c.clientset.AppsV1().StatefulSets(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("scale statefulset %s to %d: %w", name, replicas, err)
+	}
+
+	return nil
+}
+
+// RestartStatefulSet triggers a rolling restart by patching the statefulset's annotations
+func (c *Client) RestartStatefulSet(ctx context.Context, namespace, name string) error {
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	_, err := c.clientset.AppsV1().StatefulSets(namespace).Patch(
+		ctx,
+		name,
+		types.StrategicMergePatchType,
+		restartPatch(),
+		metav1.PatchOptions{},
+	)
+	if err != nil {
+		return fmt.Errorf("restart statefulset %s: %w", name, err)
+	}
+
+	return nil
+}
+
+func convertStatefulSet(s *appsv1.StatefulSet) domain.StatefulSet {
+	replicas := int32(1)
+	if s.Spec.Replicas != nil {
+		replicas = *s.Spec.Replicas
+	}
+
+	images := make([]string, 0, len(s.Spec.Template.Spec.Containers))
+	for _, c := range s.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	return domain.StatefulSet{
+		Name:            s.Name,
+		Namespace:       s.Namespace,
+		Ready:           fmt.Sprintf("%d/%d", s.Status.ReadyReplicas, replicas),
+		Replicas:        replicas,
+		ReadyReplicas:   s.Status.ReadyReplicas,
+		CurrentReplicas: s.Status.CurrentReplicas,
+		UpdatedReplicas: s.Status.UpdatedReplicas,
+		ServiceName:     s.Spec.ServiceName,
+		Age:             formatAge(s.CreationTimestamp.Time),
+		Images:          images,
+	}
+}
+
+func convertStatefulSetDetailed(s *appsv1.StatefulSet) domain.StatefulSet {
+	statefulSet := convertStatefulSet(s)
+
+	statefulSet.Labels = make(map[string]string, len(s.Labels))
+	maps.Copy(statefulSet.Labels, s.Labels)
+
+	statefulSet.Selector = make(map[string]string)
+	if s.Spec.Selector != nil {
+		maps.Copy(statefulSet.Selector, s.Spec.Selector.MatchLabels)
+	}
+
+	statefulSet.UpdateStrategy = string(s.Spec.UpdateStrategy.Type)
+	if ru := s.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil && *ru.Partition > 0 {
+		statefulSet.UpdateStrategy += fmt.Sprintf(" (partition %d)", *ru.Partition)
+	}
+	statefulSet.CurrentRevision = s.Status.CurrentRevision
+	statefulSet.UpdateRevision = s.Status.UpdateRevision
+
+	return statefulSet
+}
@@ -0,0 +1,47 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// defaultStorageClassAnnotation marks the default storage class
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// GetStorageClasses returns all storage classes
+func (c *Client) GetStorageClasses(ctx context.Context) ([]domain.StorageClass, error) {
+	scList, err := c.clientset.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list storageclasses: %w", err)
+	}
+
+	classes := make([]domain.StorageClass, 0, len(scList.Items))
+	for _, sc := range scList.Items {
+		classes = append(classes, convertStorageClass(&sc))
+	}
+	return classes, nil
+}
+
+func convertStorageClass(sc *storagev1.StorageClass) domain.StorageClass {
+	class := domain.StorageClass{
+		Name:        sc.Name,
+		Provisioner: sc.Provisioner,
+		Default:     sc.Annotations[defaultStorageClassAnnotation] == "true",
+		Age:         formatAge(sc.CreationTimestamp.Time),
+	}
+	if sc.ReclaimPolicy != nil {
+		class.ReclaimPolicy = string(*sc.ReclaimPolicy)
+	}
+	if sc.VolumeBindingMode != nil {
+		class.VolumeBindingMode = string(*sc.VolumeBindingMode)
+	}
+	if sc.AllowVolumeExpansion != nil {
+		class.AllowExpansion = *sc.AllowVolumeExpansion
+	}
+	return class
+}
@@ -0,0 +1,460 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+)
+
+// Resource identifies a kind of object kept in the watch cache
+type Resource string
+
+// Resources kept in the watch cache
+const (
+	ResourcePods         Resource = "pods"
+	ResourceDeployments  Resource = "deployments"
+	ResourceStatefulSets Resource = "statefulsets"
+	ResourceDaemonSets   Resource = "daemonsets"
+	ResourceReplicaSets  Resource = "replicasets"
+	ResourceJobs         Resource = "jobs"
+	ResourceCronJobs     Resource = "cronjobs"
+	ResourceServices     Resource = "services"
+	ResourceEvents       Resource = "events"
+	ResourceNamespaces   Resource = "namespaces"
+)
+
+// DeltaType describes a change reported by a Watcher
+type DeltaType string
+
+// Delta types
+const (
+	DeltaAdded   DeltaType = "Added"
+	DeltaUpdated DeltaType = "Updated"
+	DeltaDeleted DeltaType = "Deleted"
+	// DeltaSynced means the cache holds a complete list; read it with the snapshot methods
+	DeltaSynced DeltaType = "Synced"
+	// DeltaError means the watch broke; the cache relists and reports DeltaSynced once recovered
+	DeltaError DeltaType = "Error"
+	// DeltaDenied means the user may not watch the resource; its informer is
+	// stopped and the resource must be listed from the API server instead
+	DeltaDenied DeltaType = "Denied"
+)
+
+// watchBufferSize is how many deltas may queue up before informer handlers block
+const watchBufferSize = 1024
+
+// WatchEvent is a single change to the watch cache.
+// Object holds a domain.Pod, domain.Deployment, domain.StatefulSet,
+// domain.DaemonSet, domain.ReplicaSet, domain.Job, domain.CronJob,
+// domain.Service, domain.Event or domain.Namespace depending on Resource.
+type WatchEvent struct {
+	Resource Resource
+	Type     DeltaType
+	Object   any
+	Err      error
+}
+
+// Watcher keeps a shared-informer cache of the resources shown by k4s and
+// reports every change as a WatchEvent
+type Watcher struct {
+	namespace string
+	informers map[Resource]cache.SharedIndexInformer
+
+	deltas   chan WatchEvent
+	stopCh   chan struct{}
+	stopOnce sync.Once
+
+	mu        sync.Mutex
+	resyncing map[Resource]bool
+	stops     map[Resource]chan struct{} // per informer; closed on Stop or when access is denied
+}
+
+// Watch starts informers for pods, workloads, services and events in the
+// given namespace (or in every namespace for AllNamespaces) and for
+// namespaces cluster-wide
+func (c *Client) Watch(namespace string) (*Watcher, error) {
+	namespace = c.listNamespace(namespace)
+
+	// Watches are long-running requests and must not inherit the client timeout
+	config := *c.restConfig
+	config.Timeout = 0
+	clientset, err := kubernetes.NewForConfig(&config)
+	if err != nil {
+		return nil, fmt.Errorf("create watch clientset: %w", err)
+	}
+
+	return newWatcher(clientset, namespace)
+}
+
+// newWatcher wires the informers to a Watcher and starts them
+func newWatcher(clientset kubernetes.Interface, namespace string) (*Watcher, error) {
+	factory := informers.NewSharedInformerFactoryWithOptions(clientset, 0, informers.WithNamespace(namespace))
+	clusterFactory := informers.NewSharedInformerFactory(clientset, 0)
+
+	w := &Watcher{
+		namespace: namespace,
+		informers: map[Resource]cache.SharedIndexInformer{
+			ResourcePods:         factory.Core().V1().Pods().Informer(),
+			ResourceDeployments:  factory.Apps().V1().Deployments().Informer(),
+			ResourceStatefulSets: factory.Apps().V1().StatefulSets().Informer(),
+			ResourceDaemonSets:   factory.Apps().V1().DaemonSets().Informer(),
+			ResourceReplicaSets:  factory.Apps().V1().ReplicaSets().Informer(),
+			ResourceJobs:         factory.Batch().V1().Jobs().Informer(),
+			ResourceCronJobs:     factory.Batch().V1().CronJobs().Informer(),
+			ResourceServices:     factory.Core().V1().Services().Informer(),
+			ResourceEvents:       factory.Core().V1().Events().Informer(),
+			ResourceNamespaces:   clusterFactory.Core().V1().Namespaces().Informer(),
+		},
+		deltas:    make(chan WatchEvent, watchBufferSize),
+		stopCh:    make(chan struct{}),
+		resyncing: make(map[Resource]bool),
+		stops:     make(map[Resource]chan struct{}),
+	}
+
+	// Informers run with their own stop channel so a denied one can be
+	// stopped without the others
+	for resource, informer := range w.informers {
+		if err := informer.SetWatchErrorHandler(w.watchErrorHandler(resource)); err != nil {
+			return nil, fmt.Errorf("watch %s: %w", resource, err)
+		}
+		reg, err := informer.AddEventHandler(w.eventHandler(resource))
+		if err != nil {
+			return nil, fmt.Errorf("watch %s: %w", resource, err)
+		}
+		stop := make(chan struct{})
+		w.stops[resource] = stop
+		go informer.Run(stop)
+		go w.waitForSync(resource, stop, reg.HasSynced)
+	}
+
+	logger.Debug("Watch started", "namespace", namespace)
+	return w, nil
+}
+
+// Namespace returns the namespace the watcher is scoped to; empty means all namespaces
+func (w *Watcher) Namespace() string {
+	return w.namespace
+}
+
+// Deltas returns the channel on which changes are delivered
+func (w *Watcher) Deltas() <-chan WatchEvent {
+	return w.deltas
+}
+
+// Done returns a channel that is closed once the watcher is stopped
+func (w *Watcher) Done() <-chan struct{} {
+	return w.stopCh
+}
+
+// Stop shuts down all informers
+func (w *Watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+
+		w.mu.Lock()
+		for resource, stop := range w.stops {
+			close(stop)
+			delete(w.stops, resource)
+		}
+		w.mu.Unlock()
+
+		logger.Debug("Watch stopped", "namespace", w.namespace)
+	})
+}
+
+// HasSynced reports whether the initial list of a resource has been loaded
+func (w *Watcher) HasSynced(resource Resource) bool {
+	informer, ok := w.informers[resource]
+	return ok && informer.HasSynced()
+}
+
+// Pods returns the cached pods sorted by name
+func (w *Watcher) Pods() []domain.Pod {
+	objs := w.list(ResourcePods)
+	pods := make([]domain.Pod, 0, len(objs))
+	for _, obj := range objs {
+		if p, ok := obj.(*corev1.Pod); ok {
+			pods = append(pods, convertPod(p))
+		}
+	}
+	return pods
+}
+
+// Deployments returns the cached deployments sorted by name
+func (w *Watcher) Deployments() []domain.Deployment {
+	objs := w.list(ResourceDeployments)
+	deployments := make([]domain.Deployment, 0, len(objs))
+	for _, obj := range objs {
+		if d, ok := obj.(*appsv1.Deployment); ok {
+			deployments = append(deployments, convertDeployment(d))
+		}
+	}
+	return deployments
+}
+
+// StatefulSets returns the cached statefulsets sorted by name
+func (w *Watcher) StatefulSets() []domain.StatefulSet {
+	objs := w.list(ResourceStatefulSets)
+	statefulSets := make([]domain.StatefulSet, 0, len(objs))
+	for _, obj := range objs {
+		if s, ok := obj.(*appsv1.StatefulSet); ok {
+			statefulSets = append(statefulSets, convertStatefulSet(s))
+		}
+	}
+	return statefulSets
+}
+
+// DaemonSets returns the cached daemonsets sorted by name
+func (w *Watcher) DaemonSets() []domain.DaemonSet {
+	objs := w.list(ResourceDaemonSets)
+	daemonSets := make([]domain.DaemonSet, 0, len(objs))
+	for _, obj := range objs {
+		if d, ok := obj.(*appsv1.DaemonSet); ok {
+			daemonSets = append(daemonSets, convertDaemonSet(d))
+		}
+	}
+	return daemonSets
+}
+
+// ReplicaSets returns the cached replicasets sorted by name
+func (w *Watcher) ReplicaSets() []domain.ReplicaSet {
+	objs := w.list(ResourceReplicaSets)
+	replicaSets := make([]domain.ReplicaSet, 0, len(objs))
+	for _, obj := range objs {
+		if r, ok := obj.(*appsv1.ReplicaSet); ok {
+			replicaSets = append(replicaSets, convertReplicaSet(r))
+		}
+	}
+	return replicaSets
+}
+
+// Jobs returns the cached jobs sorted by name
+func (w *Watcher) Jobs() []domain.Job {
+	objs := w.list(ResourceJobs)
+	jobs := make([]domain.Job, 0, len(objs))
+	for _, obj := range objs {
+		if j, ok := obj.(*batchv1.Job); ok {
+			jobs = append(jobs, convertJob(j))
+		}
+	}
+	return jobs
+}
+
+// CronJobs returns the cached cronjobs sorted by name
+func (w *Watcher) CronJobs() []domain.CronJob {
+	objs := w.list(ResourceCronJobs)
+	cronJobs := make([]domain.CronJob, 0, len(objs))
+	for _, obj := range objs {
+		if cj, ok := obj.(*batchv1.CronJob); ok {
+			cronJobs = append(cronJobs, convertCronJob(cj))
+		}
+	}
+	return cronJobs
+}
+
+// Services returns the cached services sorted by name
+func (w *Watcher) Services() []domain.Service {
+	objs := w.list(ResourceServices)
+	services := make([]domain.Service, 0, len(objs))
+	for _, obj := range objs {
+		if s, ok := obj.(*corev1.Service); ok {
+			services = append(services, convertService(s))
+		}
+	}
+	return services
+}
+
+// Events returns the cached events, oldest first
+func (w *Watcher) Events() []domain.Event {
+	objs := w.list(ResourceEvents)
+	events := make([]domain.Event, 0, len(objs))
+	for _, obj := range objs {
+		if e, ok := obj.(*corev1.Event); ok {
+			events = append(events, convertEvent(e))
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].LastSeenTime.Before(events[j].LastSeenTime)
+	})
+	return events
+}
+
+// Namespaces returns the cached namespaces sorted by name
+func (w *Watcher) Namespaces() []domain.Namespace {
+	objs := w.list(ResourceNamespaces)
+	namespaces := make([]domain.Namespace, 0, len(objs))
+	for _, obj := range objs {
+		if ns, ok := obj.(*corev1.Namespace); ok {
+			namespaces = append(namespaces, convertNamespace(ns))
+		}
+	}
+	return namespaces
+}
+
+// list returns the cached objects of a resource sorted by namespace/name
+func (w *Watcher) list(resource Resource) []any {
+	informer, ok := w.informers[resource]
+	if !ok {
+		return nil
+	}
+
+	objs := informer.GetStore().List()
+	sort.Slice(objs, func(i, j int) bool {
+		return objectKey(objs[i]) < objectKey(objs[j])
+	})
+	return objs
+}
+
+// eventHandler converts informer notifications into WatchEvents.
+// Objects from the initial list are skipped; they are read in one go after DeltaSynced.
+func (w *Watcher) eventHandler(resource Resource) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, isInInitialList bool) {
+			if isInInitialList {
+				return
+			}
+			w.emitObject(resource, DeltaAdded, obj)
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldMeta, errOld := meta.Accessor(oldObj)
+			newMeta, errNew := meta.Accessor(newObj)
+			if errOld == nil && errNew == nil && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			w.emitObject(resource, DeltaUpdated, newObj)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			w.emitObject(resource, DeltaDeleted, obj)
+		},
+	}
+}
+
+// watchErrorHandler reports a broken watch and schedules a resync once the
+// informer has relisted. A watch the user is not allowed to make would fail
+// forever, so its informer is stopped and reported once as DeltaDenied.
+func (w *Watcher) watchErrorHandler(resource Resource) cache.WatchErrorHandler {
+	return func(r *cache.Reflector, err error) {
+		w.mu.Lock()
+		stop, running := w.stops[resource]
+		if !running {
+			w.mu.Unlock()
+			return
+		}
+
+		if apierrors.IsForbidden(err) || apierrors.IsUnauthorized(err) {
+			close(stop)
+			delete(w.stops, resource)
+			w.mu.Unlock()
+
+			logger.Warn("Watch denied, listing from the API instead", "resource", resource, "namespace", w.namespace, "err", err)
+			w.emit(WatchEvent{Resource: resource, Type: DeltaDenied, Err: err})
+			return
+		}
+
+		startResync := !w.resyncing[resource]
+		w.resyncing[resource] = true
+		w.mu.Unlock()
+
+		logger.Warn("Watch interrupted", "resource", resource, "namespace", w.namespace, "err", err)
+		w.emit(WatchEvent{Resource: resource, Type: DeltaError, Err: err})
+		if startResync {
+			go w.resyncAfterError(resource, stop, r.LastSyncResourceVersion())
+		}
+	}
+}
+
+// resyncAfterError waits until the informer has recovered and then reports
+// DeltaSynced so the consumer can reload the full list
+func (w *Watcher) resyncAfterError(resource Resource, stop <-chan struct{}, lastVersion string) {
+	informer := w.informers[resource]
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		if informer.LastSyncResourceVersion() != lastVersion {
+			break
+		}
+	}
+
+	w.mu.Lock()
+	w.resyncing[resource] = false
+	w.mu.Unlock()
+
+	logger.Debug("Watch resynced", "resource", resource, "namespace", w.namespace)
+	w.emit(WatchEvent{Resource: resource, Type: DeltaSynced})
+}
+
+// waitForSync reports DeltaSynced once the initial list has been delivered
+func (w *Watcher) waitForSync(resource Resource, stop <-chan struct{}, hasSynced cache.InformerSynced) {
+	if !cache.WaitForCacheSync(stop, hasSynced) {
+		return
+	}
+	w.emit(WatchEvent{Resource: resource, Type: DeltaSynced})
+}
+
+func (w *Watcher) emitObject(resource Resource, deltaType DeltaType, obj any) {
+	var converted any
+	switch o := obj.(type) {
+	case *corev1.Pod:
+		converted = convertPod(o)
+	case *appsv1.Deployment:
+		converted = convertDeployment(o)
+	case *appsv1.StatefulSet:
+		converted = convertStatefulSet(o)
+	case *appsv1.DaemonSet:
+		converted = convertDaemonSet(o)
+	case *appsv1.ReplicaSet:
+		converted = convertReplicaSet(o)
+	case *batchv1.Job:
+		converted = convertJob(o)
+	case *batchv1.CronJob:
+		converted = convertCronJob(o)
+	case *corev1.Service:
+		converted = convertService(o)
+	case *corev1.Event:
+		converted = convertEvent(o)
+	case *corev1.Namespace:
+		converted = convertNamespace(o)
+	default:
+		return
+	}
+	w.emit(WatchEvent{Resource: resource, Type: deltaType, Object: converted})
+}
+
+// emit delivers an event unless the watcher has been stopped
+func (w *Watcher) emit(event WatchEvent) {
+	select {
+	case w.deltas <- event:
+	case <-w.stopCh:
+	}
+}
+
+// objectKey returns the namespace/name key of a cached object
+func objectKey(obj any) string {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return ""
+	}
+	return key
+}
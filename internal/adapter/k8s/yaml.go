@@ -0,0 +1,157 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/yaml"
+)
+
+// resourceGVRs maps the kinds shown in k4s to their API resources
+var resourceGVRs = map[string]schema.GroupVersionResource{
+	"Pod":         {Version: "v1", Resource: "pods"},
+	"Service":     {Version: "v1", Resource: "services"},
+	"Deployment":  {Group: "apps", Version: "v1", Resource: "deployments"},
+	"StatefulSet": {Group: "apps", Version: "v1", Resource: "statefulsets"},
+	"DaemonSet":   {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"ReplicaSet":  {Group: "apps", Version: "v1", Resource: "replicasets"},
+	"Job":         {Group: "batch", Version: "v1", Resource: "jobs"},
+	"CronJob":     {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	"Node":        {Version: "v1", Resource: "nodes"},
+	"ConfigMap":   {Version: "v1", Resource: "configmaps"},
+	"Secret":      {Version: "v1", Resource: "secrets"},
+	"Ingress":     {Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+
+	"PersistentVolumeClaim": {Version: "v1", Resource: "persistentvolumeclaims"},
+	"PersistentVolume":      {Version: "v1", Resource: "persistentvolumes"},
+	"StorageClass":          {Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses"},
+
+	// k3s helm-controller
+	"HelmChart":       {Group: "helm.cattle.io", Version: "v1", Resource: "helmcharts"},
+	"HelmChartConfig": {Group: "helm.cattle.io", Version: "v1", Resource: "helmchartconfigs"},
+}
+
+// clusterScopedKinds are the kinds in resourceGVRs that live outside namespaces
+var clusterScopedKinds = map[string]bool{
+	"Node":             true,
+	"PersistentVolume": true,
+	"StorageClass":     true,
+}
+
+// resourceClient returns the dynamic client for a kind together with the
+// namespace it is addressed in, which is empty for cluster-scoped kinds.
+// Kinds without a view of their own are qualified with their API group,
+// e.g. HelmChart.helm.cattle.io, and looked up through discovery.
+func (c *Client) resourceClient(kind, namespace string) (dynamic.ResourceInterface, string, error) {
+	gvr, ok := resourceGVRs[kind]
+	clusterScoped := clusterScopedKinds[kind]
+	if _, traefik := traefikResources[kind]; !ok && traefik {
+		// Traefik kinds are served from one of two API groups
+		var err error
+		if gvr, err = c.traefikGVR(kind); err != nil {
+			return nil, "", err
+		}
+	} else if !ok {
+		res, err := c.discoveredResource(kind)
+		if err != nil {
+			return nil, "", err
+		}
+		gvr = schema.GroupVersionResource{Group: res.Group, Version: res.Version, Resource: res.Name}
+		clusterScoped = !res.Namespaced
+	}
+	if clusterScoped {
+		return c.dynamic.Resource(gvr), "", nil
+	}
+	if namespace == "" {
+		namespace = c.namespace
+	}
+	return c.dynamic.Resource(gvr).Namespace(namespace), namespace, nil
+}
+
+// GetResourceYAML returns the live object of the given kind as YAML.
+// managedFields are dropped unless showManagedFields is set.
+func (c *Client) GetResourceYAML(ctx context.Context, kind, namespace, name string, showManagedFields bool) (string, error) {
+	resources, _, err := c.resourceClient(kind, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	obj, err := resources.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("get %s %s: %w", kind, name, err)
+	}
+
+	return marshalObjectYAML(obj, showManagedFields)
+}
+
+// marshalObjectYAML renders an unstructured object as YAML
+func marshalObjectYAML(obj *unstructured.Unstructured, showManagedFields bool) (string, error) {
+	if !showManagedFields {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "managedFields")
+	}
+
+	data, err := yaml.Marshal(obj.Object)
+	if err != nil {
+		return "", fmt.Errorf("marshal %s: %w", obj.GetName(), err)
+	}
+	return string(data), nil
+}
+
+// DryRunResourceYAML validates an edited object with a server-side dry-run
+// update and returns the object the API server would store, as YAML
+func (c *Client) DryRunResourceYAML(ctx context.Context, kind, namespace, name, content string) (string, error) {
+	obj, err := c.updateResourceFromYAML(ctx, kind, namespace, name, content, true)
+	if err != nil {
+		return "", err
+	}
+	return marshalObjectYAML(obj, false)
+}
+
+// ApplyResourceYAML replaces the live object with an edited one. The edit
+// carries the resourceVersion it was based on, so it fails on conflicts.
+func (c *Client) ApplyResourceYAML(ctx context.Context, kind, namespace, name, content string) error {
+	_, err := c.updateResourceFromYAML(ctx, kind, namespace, name, content, false)
+	return err
+}
+
+func (c *Client) updateResourceFromYAML(ctx context.Context, kind, namespace, name, content string, dryRun bool) (*unstructured.Unstructured, error) {
+	resources, namespace, err := c.resourceClient(kind, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(content), &obj.Object); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if obj.Object == nil {
+		return nil, fmt.Errorf("edited object is empty")
+	}
+
+	// The edit must target the object it was opened for
+	if baseKind, _, _ := strings.Cut(kind, "."); obj.GetKind() != baseKind {
+		return nil, fmt.Errorf("kind cannot be changed (%q to %q)", baseKind, obj.GetKind())
+	}
+	if obj.GetName() != name {
+		return nil, fmt.Errorf("name cannot be changed (%q to %q)", name, obj.GetName())
+	}
+	if obj.GetNamespace() != namespace {
+		return nil, fmt.Errorf("namespace cannot be changed (%q to %q)", namespace, obj.GetNamespace())
+	}
+
+	opts := metav1.UpdateOptions{FieldManager: "k4s"}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	updated, err := resources.Update(ctx, obj, opts)
+	if err != nil {
+		return nil, fmt.Errorf("update %s %s: %w", kind, name, err)
+	}
+	return updated, nil
+}
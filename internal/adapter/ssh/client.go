@@ -2,9 +2,12 @@ package ssh
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +16,7 @@ import (
 
 	"github.com/LywwKkA-aD/k4s/internal/domain"
 	"github.com/LywwKkA-aD/k4s/internal/logger"
+	"github.com/LywwKkA-aD/k4s/internal/pathutil"
 )
 
 // ErrPassphraseRequired is returned when the private key requires a passphrase
@@ -20,9 +24,11 @@ var ErrPassphraseRequired = fmt.Errorf("passphrase required")
 
 // Client wraps SSH connection to a remote host
 type Client struct {
-	host       domain.SSHHost
-	client     *ssh.Client
-	passphrase string
+	host        domain.SSHHost
+	jumps       []domain.SSHHost // ProxyJump chain, first hop first
+	client      *ssh.Client
+	jumpClients []*ssh.Client
+	passphrase  string
 }
 
 // NewClient creates a new SSH client for the given host configuration
@@ -32,13 +38,83 @@ func NewClient(host domain.SSHHost) *Client {
 	}
 }
 
+// SetJumpHosts sets the hosts to connect through, first hop first
+func (c *Client) SetJumpHosts(jumps []domain.SSHHost) {
+	c.jumps = jumps
+}
+
 // SetPassphrase sets the passphrase for the private key
 func (c *Client) SetPassphrase(passphrase string) {
 	c.passphrase = passphrase
 }
 
-// Connect establishes SSH connection to the host
+// Connect establishes SSH connection to the host, through its jump hosts
+// if it has any. Cancelling ctx aborts a connect that is still in progress.
 func (c *Client) Connect(ctx context.Context) error {
+	c.closeJumps()
+
+	var via *ssh.Client
+	for i, hop := range append(slices.Clone(c.jumps), c.host) {
+		client, err := c.dial(ctx, hop, via)
+		if err != nil {
+			c.closeJumps()
+			return err
+		}
+		if i < len(c.jumps) {
+			c.jumpClients = append(c.jumpClients, client)
+		}
+		via = client
+	}
+
+	c.client = via
+	return nil
+}
+
+// dial connects to host directly, or through via when it is set
+func (c *Client) dial(ctx context.Context, host domain.SSHHost, via *ssh.Client) (*ssh.Client, error) {
+	config, err := c.clientConfig(host)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := hostAddress(host)
+	target := addr
+	dialCtx, cancel := context.WithTimeout(ctx, config.Timeout)
+	defer cancel()
+
+	var conn net.Conn
+	if via == nil {
+		logger.Debug("SSH connecting", "user", host.User, "addr", addr)
+		var d net.Dialer
+		conn, err = d.DialContext(dialCtx, "tcp", addr)
+	} else {
+		logger.Debug("SSH connecting through jump host", "user", host.User, "addr", addr, "via", via.RemoteAddr())
+		target = fmt.Sprintf("%s via jump host %s", addr, via.RemoteAddr())
+		conn, err = via.DialContext(dialCtx, "tcp", addr)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", target, err)
+	}
+
+	// The handshake takes no context; closing the connection aborts it
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if !stop() {
+		if err == nil {
+			clientConn.Close()
+		}
+		err = ctx.Err()
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("connect to %s: %w", target, err)
+	}
+	logger.Debug("SSH connected", "addr", addr)
+	return ssh.NewClient(clientConn, chans, reqs), nil
+}
+
+// clientConfig returns the authentication and host key settings for host
+func (c *Client) clientConfig(host domain.SSHHost) (*ssh.ClientConfig, error) {
 	var authMethods []ssh.AuthMethod
 
 	// Try ssh-agent first
@@ -48,12 +124,12 @@ func (c *Client) Connect(ctx context.Context) error {
 	}
 
 	// Also try key file if specified
-	if c.host.KeyPath != "" {
-		keyAuth, err := c.tryKeyFile()
+	if host.KeyPath != "" {
+		keyAuth, err := c.tryKeyFile(host.KeyPath)
 		if err != nil {
 			// If no ssh-agent and key file fails, return the error
 			if len(authMethods) == 0 {
-				return err
+				return nil, err
 			}
 			// Otherwise just log and continue with agent
 			logger.Debug("Key file auth failed, will use ssh-agent", "err", err)
@@ -63,35 +139,31 @@ func (c *Client) Connect(ctx context.Context) error {
 	}
 
 	if len(authMethods) == 0 {
-		return fmt.Errorf("no authentication methods available")
+		return nil, fmt.Errorf("no authentication methods available")
 	}
 
-	// Configure SSH client
-	config := &ssh.ClientConfig{
-		User:            c.host.User,
-		Auth:            authMethods,
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(), // TODO: proper host key verification
-		Timeout:         10 * time.Second,
+	// Verify the host key against known_hosts
+	hostKeyCallback, hostKeyAlgorithms, err := hostKeyCallback(host)
+	if err != nil {
+		return nil, err
 	}
 
-	// Determine port
-	port := c.host.Port
+	return &ssh.ClientConfig{
+		User:              host.User,
+		Auth:              authMethods,
+		HostKeyCallback:   hostKeyCallback,
+		HostKeyAlgorithms: hostKeyAlgorithms,
+		Timeout:           10 * time.Second,
+	}, nil
+}
+
+// hostAddress returns the host:port to dial
+func hostAddress(host domain.SSHHost) string {
+	port := host.Port
 	if port == 0 {
 		port = 22
 	}
-
-	// Connect
-	addr := fmt.Sprintf("%s:%d", c.host.Host, port)
-	logger.Debug("SSH connecting", "user", c.host.User, "addr", addr)
-
-	client, err := ssh.Dial("tcp", addr, config)
-	if err != nil {
-		return fmt.Errorf("connect to %s: %w", addr, err)
-	}
-
-	c.client = client
-	logger.Debug("SSH connected", "addr", addr)
-	return nil
+	return net.JoinHostPort(host.Host, strconv.Itoa(port))
 }
 
 // trySSHAgent attempts to connect to the SSH agent and get signers
@@ -127,15 +199,11 @@ func (c *Client) trySSHAgent() ssh.AuthMethod {
 }
 
 // tryKeyFile attempts to use the key file for authentication
-func (c *Client) tryKeyFile() (ssh.AuthMethod, error) {
+func (c *Client) tryKeyFile(keyPath string) (ssh.AuthMethod, error) {
 	// Expand ~ in key path
-	keyPath := c.host.KeyPath
-	if strings.HasPrefix(keyPath, "~/") {
-		home, err := os.UserHomeDir()
-		if err != nil {
-			return nil, fmt.Errorf("get home directory: %w", err)
-		}
-		keyPath = home + keyPath[1:]
+	keyPath, err := pathutil.ExpandHome(keyPath)
+	if err != nil {
+		return nil, err
 	}
 
 	// Read private key
@@ -166,12 +234,22 @@ func (c *Client) tryKeyFile() (ssh.AuthMethod, error) {
 	return ssh.PublicKeys(signer), nil
 }
 
-// Close closes the SSH connection
+// Close closes the SSH connection and the jump host connections under it
 func (c *Client) Close() error {
+	var err error
 	if c.client != nil {
-		return c.client.Close()
+		err = c.client.Close()
 	}
-	return nil
+	c.closeJumps()
+	return err
+}
+
+// closeJumps closes the jump host connections, last hop first
+func (c *Client) closeJumps() {
+	for i := len(c.jumpClients) - 1; i >= 0; i-- {
+		c.jumpClients[i].Close()
+	}
+	c.jumpClients = nil
 }
 
 // IsConnected returns true if connected
@@ -208,6 +286,48 @@ func (c *Client) Execute(ctx context.Context, command string) (string, error) {
 	return string(output), nil
 }
 
+// Run runs a command and returns its combined output and exit status. Unlike
+// Execute, a non-zero exit status is not an error, and cancelling ctx closes
+// the session.
+func (c *Client) Run(ctx context.Context, command string) (string, int, error) {
+	if c.client == nil {
+		return "", -1, fmt.Errorf("not connected")
+	}
+
+	session, err := c.client.NewSession()
+	if err != nil {
+		return "", -1, fmt.Errorf("create session: %w", err)
+	}
+	defer session.Close()
+
+	logger.Debug("SSH running", "host", c.host.Name, "command", command)
+
+	type result struct {
+		output []byte
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		output, err := session.CombinedOutput(command)
+		done <- result{output: output, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		session.Close()
+		return "", -1, ctx.Err()
+	case r := <-done:
+		var exitErr *ssh.ExitError
+		if errors.As(r.err, &exitErr) {
+			return string(r.output), exitErr.ExitStatus(), nil
+		}
+		if r.err != nil {
+			return string(r.output), -1, fmt.Errorf("run command: %w", r.err)
+		}
+		return string(r.output), 0, nil
+	}
+}
+
 // TestConnection tests the SSH connection by running a simple command
 func (c *Client) TestConnection(ctx context.Context) error {
 	output, err := c.Execute(ctx, "echo ok")
@@ -27,6 +27,7 @@ type CrictlContainer struct {
 	PodID            string
 	PodName          string
 	Namespace        string
+	ImageRef         string // Image ID or digest the container runs
 }
 
 // crictlPsJSON represents the JSON output from crictl ps
@@ -54,20 +55,132 @@ type imageSpec struct {
 	Image string `json:"image"`
 }
 
-// CrictlPod represents a pod from crictl pods output
+// CrictlPod represents a pod sandbox from crictl pods output
 type CrictlPod struct {
-	PodID     string
-	Created   string
-	State     string
-	Name      string
-	Namespace string
+	PodID      string // Full sandbox ID
+	PodIDShort string // Truncated for display
+	Created    string
+	CreatedAt  int64  // Unix nano timestamp
+	State      string // "Ready" or "NotReady"
+	Name       string
+	Namespace  string
+}
+
+// Ready reports whether the sandbox is running
+func (p CrictlPod) Ready() bool {
+	return p.State == "Ready"
+}
+
+// crictlPodsJSON represents the JSON output from crictl pods
+type crictlPodsJSON struct {
+	Items []struct {
+		ID       string `json:"id"`
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		State     string `json:"state"`
+		CreatedAt string `json:"createdAt"`
+	} `json:"items"`
 }
 
 // CrictlImage represents an image from crictl images output
 type CrictlImage struct {
-	ImageID string
-	Tags    string
-	Size    string
+	ImageID      string   // Full image ID, e.g. sha256:...
+	ImageIDShort string   // Truncated for display
+	Tags         []string // repo:tag references
+	Digests      []string // repo@digest references
+	Size         uint64
+	Pinned       bool
+}
+
+// UsedBy reports whether the container runs this image
+func (i CrictlImage) UsedBy(c CrictlContainer) bool {
+	if c.ImageRef == "" {
+		return false
+	}
+	if c.ImageRef == i.ImageID {
+		return true
+	}
+	for _, ref := range i.Digests {
+		if ref == c.ImageRef {
+			return true
+		}
+	}
+	return false
+}
+
+// crictlImagesJSON represents the JSON output from crictl images
+type crictlImagesJSON struct {
+	Images []struct {
+		ID          string      `json:"id"`
+		RepoTags    []string    `json:"repoTags"`
+		RepoDigests []string    `json:"repoDigests"`
+		Size        protoUint64 `json:"size"`
+		Pinned      bool        `json:"pinned"`
+	} `json:"images"`
+}
+
+// CrictlStats is a resource usage sample of a running container
+type CrictlStats struct {
+	ContainerID string
+	Name        string
+	PodName     string
+	Namespace   string
+	// CPU sample time in Unix nanoseconds
+	Timestamp int64
+	// Cumulative CPU time, used to compute usage between two samples
+	UsageCoreNanoSeconds uint64
+	// CPU usage in nanocores; zero when the runtime does not report it
+	UsageNanoCores  uint64
+	WorkingSetBytes uint64
+	// Disk space used by the container's writable layer
+	WritableLayerBytes uint64
+}
+
+// crictlStatsJSON represents the JSON output from crictl stats
+type crictlStatsJSON struct {
+	Stats []struct {
+		Attributes struct {
+			ID       string            `json:"id"`
+			Metadata containerMetadata `json:"metadata"`
+			Labels   map[string]string `json:"labels"`
+		} `json:"attributes"`
+		CPU struct {
+			Timestamp            protoUint64 `json:"timestamp"`
+			UsageCoreNanoSeconds protoValue  `json:"usageCoreNanoSeconds"`
+			UsageNanoCores       protoValue  `json:"usageNanoCores"`
+		} `json:"cpu"`
+		Memory struct {
+			WorkingSetBytes protoValue `json:"workingSetBytes"`
+		} `json:"memory"`
+		WritableLayer struct {
+			UsedBytes protoValue `json:"usedBytes"`
+		} `json:"writableLayer"`
+	} `json:"stats"`
+}
+
+// protoValue is a protobuf UInt64Value wrapper as printed by crictl
+type protoValue struct {
+	Value protoUint64 `json:"value"`
+}
+
+// protoUint64 accepts 64-bit integers both as JSON numbers and as strings,
+// which is how protobuf JSON encodes them
+type protoUint64 uint64
+
+func (v *protoUint64) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*v = 0
+		return nil
+	}
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*v = protoUint64(n)
+	return nil
 }
 
 // ListContainers runs crictl ps and returns container list
@@ -81,24 +194,62 @@ func (c *Client) ListContainers(ctx context.Context) ([]CrictlContainer, error)
 	return parseCrictlPsJSON(output)
 }
 
-// ListPods runs crictl pods and returns pod list
+// ListPods runs crictl pods and returns the pod sandboxes
 func (c *Client) ListPods(ctx context.Context) ([]CrictlPod, error) {
-	output, err := c.Execute(ctx, "sudo crictl pods")
+	output, err := c.Execute(ctx, "sudo crictl pods -o json")
 	if err != nil {
 		return nil, fmt.Errorf("crictl pods: %w", err)
 	}
 
-	return parseCrictlPods(output), nil
+	return parseCrictlPodsJSON(output)
 }
 
 // ListImages runs crictl images and returns image list
 func (c *Client) ListImages(ctx context.Context) ([]CrictlImage, error) {
-	output, err := c.Execute(ctx, "sudo crictl images")
+	output, err := c.Execute(ctx, "sudo crictl images -o json")
 	if err != nil {
 		return nil, fmt.Errorf("crictl images: %w", err)
 	}
 
-	return parseCrictlImages(output), nil
+	return parseCrictlImagesJSON(output)
+}
+
+// RemoveImage removes an image that no container uses
+func (c *Client) RemoveImage(ctx context.Context, imageID string) error {
+	output, err := c.Execute(ctx, fmt.Sprintf("sudo crictl rmi %s", imageID))
+	if err != nil {
+		return fmt.Errorf("crictl rmi: %s: %w", strings.TrimSpace(output), err)
+	}
+	return nil
+}
+
+// PruneImages removes all images not used by a container and returns the
+// references crictl reports as deleted, one per tag or untagged image
+func (c *Client) PruneImages(ctx context.Context) ([]string, error) {
+	output, err := c.Execute(ctx, "sudo crictl rmi --prune")
+	if err != nil {
+		return nil, fmt.Errorf("crictl rmi --prune: %s: %w", strings.TrimSpace(output), err)
+	}
+
+	var deleted []string
+	for _, line := range strings.Split(output, "\n") {
+		if ref, ok := strings.CutPrefix(strings.TrimSpace(line), "Deleted:"); ok {
+			deleted = append(deleted, strings.TrimSpace(ref))
+		}
+	}
+	return deleted, nil
+}
+
+// RemovePodSandboxes removes stopped pod sandboxes and their containers
+func (c *Client) RemovePodSandboxes(ctx context.Context, podIDs ...string) error {
+	if len(podIDs) == 0 {
+		return nil
+	}
+	output, err := c.Execute(ctx, "sudo crictl rmp "+strings.Join(podIDs, " "))
+	if err != nil {
+		return fmt.Errorf("crictl rmp: %s: %w", strings.TrimSpace(output), err)
+	}
+	return nil
 }
 
 // InspectContainer runs crictl inspect on a container
@@ -110,6 +261,43 @@ func (c *Client) InspectContainer(ctx context.Context, containerID string) (stri
 	return output, nil
 }
 
+// containerShell starts bash in the container when it has one, sh otherwise
+const containerShell = `/bin/sh -c '[ -x /bin/bash ] && exec /bin/bash || exec /bin/sh'`
+
+// ExecContainer opens an interactive shell in a container with crictl exec
+// on a PTY and streams stdio until it exits
+func (c *Client) ExecContainer(ctx context.Context, containerID string, opts ShellOptions) error {
+	return c.runPTY(ctx, opts, fmt.Sprintf("sudo crictl exec -it %s %s", containerID, containerShell))
+}
+
+// StopContainer stops a running container
+func (c *Client) StopContainer(ctx context.Context, containerID string) error {
+	output, err := c.Execute(ctx, fmt.Sprintf("sudo crictl stop %s", containerID))
+	if err != nil {
+		return fmt.Errorf("crictl stop: %s: %w", strings.TrimSpace(output), err)
+	}
+	return nil
+}
+
+// RemoveContainer removes a container; running containers must be stopped first
+func (c *Client) RemoveContainer(ctx context.Context, containerID string) error {
+	output, err := c.Execute(ctx, fmt.Sprintf("sudo crictl rm %s", containerID))
+	if err != nil {
+		return fmt.Errorf("crictl rm: %s: %w", strings.TrimSpace(output), err)
+	}
+	return nil
+}
+
+// ContainerStats runs crictl stats and returns a usage sample of every running container
+func (c *Client) ContainerStats(ctx context.Context) ([]CrictlStats, error) {
+	output, err := c.Execute(ctx, "sudo crictl stats -o json")
+	if err != nil {
+		return nil, fmt.Errorf("crictl stats: %w", err)
+	}
+
+	return parseCrictlStatsJSON(output)
+}
+
 // CrictlLogOptions represents options for crictl logs
 type CrictlLogOptions struct {
 	TailLines  int64
@@ -288,16 +476,41 @@ func parseCrictlPsJSON(output string) ([]CrictlContainer, error) {
 			Image:            imageName,
 			Created:          created,
 			CreatedAt:        createdAt,
-			State:            strings.ToLower(c.State),
+			State:            strings.ToLower(strings.TrimPrefix(c.State, "CONTAINER_")), // e.g. CONTAINER_RUNNING
 			Name:             c.Metadata.Name,
 			PodID:            truncateID(c.PodSandboxID),
 			PodName:          podName,
 			Namespace:        namespace,
+			ImageRef:         c.ImageRef,
 		})
 	}
 	return containers, nil
 }
 
+// parseCrictlStatsJSON parses crictl stats JSON output
+func parseCrictlStatsJSON(output string) ([]CrictlStats, error) {
+	var statsOutput crictlStatsJSON
+	if err := json.Unmarshal([]byte(output), &statsOutput); err != nil {
+		return nil, fmt.Errorf("parse crictl stats JSON: %w", err)
+	}
+
+	stats := make([]CrictlStats, 0, len(statsOutput.Stats))
+	for _, s := range statsOutput.Stats {
+		stats = append(stats, CrictlStats{
+			ContainerID:          s.Attributes.ID,
+			Name:                 s.Attributes.Metadata.Name,
+			PodName:              s.Attributes.Labels["io.kubernetes.pod.name"],
+			Namespace:            s.Attributes.Labels["io.kubernetes.pod.namespace"],
+			Timestamp:            int64(s.CPU.Timestamp),
+			UsageCoreNanoSeconds: uint64(s.CPU.UsageCoreNanoSeconds.Value),
+			UsageNanoCores:       uint64(s.CPU.UsageNanoCores.Value),
+			WorkingSetBytes:      uint64(s.Memory.WorkingSetBytes.Value),
+			WritableLayerBytes:   uint64(s.WritableLayer.UsedBytes.Value),
+		})
+	}
+	return stats, nil
+}
+
 // truncateID truncates container/pod IDs for display
 func truncateID(id string) string {
 	if len(id) > 13 {
@@ -323,46 +536,50 @@ func formatAge(t time.Time) string {
 	return fmt.Sprintf("%dd", days)
 }
 
-// parseCrictlPods parses crictl pods output
-func parseCrictlPods(output string) []CrictlPod {
-	lines := strings.Split(strings.TrimSpace(output), "\n")
-	if len(lines) <= 1 {
-		return nil
+// parseCrictlPodsJSON parses crictl pods JSON output
+func parseCrictlPodsJSON(output string) ([]CrictlPod, error) {
+	var podsOutput crictlPodsJSON
+	if err := json.Unmarshal([]byte(output), &podsOutput); err != nil {
+		return nil, fmt.Errorf("parse crictl pods JSON: %w", err)
 	}
 
-	var pods []CrictlPod
-	for _, line := range lines[1:] { // Skip header
-		fields := strings.Fields(line)
-		if len(fields) >= 5 {
-			pods = append(pods, CrictlPod{
-				PodID:     fields[0],
-				Created:   fields[1],
-				State:     fields[2],
-				Name:      fields[3],
-				Namespace: fields[4],
-			})
+	pods := make([]CrictlPod, 0, len(podsOutput.Items))
+	for _, p := range podsOutput.Items {
+		createdAt, _ := strconv.ParseInt(p.CreatedAt, 10, 64)
+		state := "NotReady"
+		if p.State == "SANDBOX_READY" {
+			state = "Ready"
 		}
+		pods = append(pods, CrictlPod{
+			PodID:      p.ID,
+			PodIDShort: truncateID(p.ID),
+			Created:    formatAge(time.Unix(0, createdAt)),
+			CreatedAt:  createdAt,
+			State:      state,
+			Name:       p.Metadata.Name,
+			Namespace:  p.Metadata.Namespace,
+		})
 	}
-	return pods
+	return pods, nil
 }
 
-// parseCrictlImages parses crictl images output
-func parseCrictlImages(output string) []CrictlImage {
-	lines := strings.Split(strings.TrimSpace(output), "\n")
-	if len(lines) <= 1 {
-		return nil
+// parseCrictlImagesJSON parses crictl images JSON output
+func parseCrictlImagesJSON(output string) ([]CrictlImage, error) {
+	var imagesOutput crictlImagesJSON
+	if err := json.Unmarshal([]byte(output), &imagesOutput); err != nil {
+		return nil, fmt.Errorf("parse crictl images JSON: %w", err)
 	}
 
-	var images []CrictlImage
-	for _, line := range lines[1:] { // Skip header
-		fields := strings.Fields(line)
-		if len(fields) >= 3 {
-			images = append(images, CrictlImage{
-				ImageID: fields[0],
-				Tags:    fields[1],
-				Size:    fields[len(fields)-1],
-			})
-		}
+	images := make([]CrictlImage, 0, len(imagesOutput.Images))
+	for _, i := range imagesOutput.Images {
+		images = append(images, CrictlImage{
+			ImageID:      i.ID,
+			ImageIDShort: truncateID(strings.TrimPrefix(i.ID, "sha256:")),
+			Tags:         i.RepoTags,
+			Digests:      i.RepoDigests,
+			Size:         uint64(i.Size),
+			Pinned:       i.Pinned,
+		})
 	}
-	return images
+	return images, nil
 }
@@ -0,0 +1,251 @@
+package ssh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// etcdCurl calls the gRPC gateway of the node's embedded etcd with the client
+// certificate k3s generates for it
+const etcdCurl = "sudo curl -sS --fail -X POST -d '{}'" +
+	" --cacert /var/lib/rancher/k3s/server/tls/etcd/server-ca.crt" +
+	" --cert /var/lib/rancher/k3s/server/tls/etcd/client.crt" +
+	" --key /var/lib/rancher/k3s/server/tls/etcd/client.key" +
+	" https://127.0.0.1:2379"
+
+// etcdSnapshotName matches the names accepted for on-demand snapshots
+var etcdSnapshotName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// EtcdSnapshot is a snapshot listed by k3s etcd-snapshot ls
+type EtcdSnapshot struct {
+	Name     string
+	Location string // file:// path on the node, or s3:// URL
+	Size     uint64
+	Created  time.Time
+}
+
+// S3 returns true for snapshots stored in S3
+func (s EtcdSnapshot) S3() bool {
+	return strings.HasPrefix(s.Location, "s3://")
+}
+
+// EtcdMember is a member of the etcd cluster
+type EtcdMember struct {
+	ID         uint64
+	Name       string
+	PeerURLs   []string
+	ClientURLs []string
+	Learner    bool
+}
+
+// IDHex returns the member ID the way etcdctl prints it
+func (m EtcdMember) IDHex() string {
+	return strconv.FormatUint(m.ID, 16)
+}
+
+// EtcdStatus is the status of the etcd member on the node
+type EtcdStatus struct {
+	MemberID uint64
+	Leader   uint64
+	Version  string
+	DBSize   uint64
+}
+
+// ValidateEtcdSnapshotName checks a name for an on-demand snapshot
+func ValidateEtcdSnapshotName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(name) > 100 {
+		return fmt.Errorf("name is too long")
+	}
+	if !etcdSnapshotName.MatchString(name) {
+		return fmt.Errorf("use letters, digits, '.', '_' and '-'")
+	}
+	return nil
+}
+
+// ListEtcdSnapshots runs k3s etcd-snapshot ls and returns the local and S3
+// snapshots, newest first
+func (c *Client) ListEtcdSnapshots(ctx context.Context) ([]EtcdSnapshot, error) {
+	output, err := c.Execute(ctx, "sudo k3s etcd-snapshot ls")
+	if err != nil {
+		return nil, fmt.Errorf("k3s etcd-snapshot ls: %w: %s", err, lastLine(output))
+	}
+	return parseEtcdSnapshots(output), nil
+}
+
+// SaveEtcdSnapshot takes an on-demand snapshot. k3s appends the node name
+// and a timestamp to name.
+func (c *Client) SaveEtcdSnapshot(ctx context.Context, name string) error {
+	if err := ValidateEtcdSnapshotName(name); err != nil {
+		return err
+	}
+	output, err := c.Execute(ctx, "sudo k3s etcd-snapshot save --name "+name)
+	if err != nil {
+		return fmt.Errorf("k3s etcd-snapshot save: %w: %s", err, lastLine(output))
+	}
+	return nil
+}
+
+// DeleteEtcdSnapshots deletes snapshots by name, locally and from S3
+func (c *Client) DeleteEtcdSnapshots(ctx context.Context, names ...string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	for _, name := range names {
+		if !etcdSnapshotName.MatchString(name) {
+			return fmt.Errorf("invalid snapshot name %q", name)
+		}
+	}
+	output, err := c.Execute(ctx, "sudo k3s etcd-snapshot delete "+strings.Join(names, " "))
+	if err != nil {
+		return fmt.Errorf("k3s etcd-snapshot delete: %w: %s", err, lastLine(output))
+	}
+	return nil
+}
+
+// PruneEtcdSnapshots deletes the oldest snapshots beyond the retention
+// configured on the node (etcd-snapshot-retention, 5 by default)
+func (c *Client) PruneEtcdSnapshots(ctx context.Context) error {
+	output, err := c.Execute(ctx, "sudo k3s etcd-snapshot prune")
+	if err != nil {
+		return fmt.Errorf("k3s etcd-snapshot prune: %w: %s", err, lastLine(output))
+	}
+	return nil
+}
+
+// EtcdMembers returns the members of the etcd cluster and the status of the
+// member on the node
+func (c *Client) EtcdMembers(ctx context.Context) ([]EtcdMember, *EtcdStatus, error) {
+	output, err := c.Execute(ctx, etcdCurl+"/v3/cluster/member/list")
+	if err != nil {
+		return nil, nil, fmt.Errorf("etcd member list: %w: %s", err, lastLine(output))
+	}
+	members, err := parseEtcdMembers(output)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	output, err = c.Execute(ctx, etcdCurl+"/v3/maintenance/status")
+	if err != nil {
+		return members, nil, fmt.Errorf("etcd status: %w: %s", err, lastLine(output))
+	}
+	status, err := parseEtcdStatus(output)
+	if err != nil {
+		return members, nil, err
+	}
+	return members, status, nil
+}
+
+// parseEtcdSnapshots parses the table printed by k3s etcd-snapshot ls. Log
+// lines before the header are skipped; columns are found by their header.
+// Some versions print nothing at all when there are no snapshots.
+func parseEtcdSnapshots(output string) []EtcdSnapshot {
+	var snapshots []EtcdSnapshot
+	var columns map[string]int
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if columns == nil {
+			if fields[0] == "Name" {
+				columns = make(map[string]int)
+				for i, f := range fields {
+					columns[f] = i
+				}
+			}
+			continue
+		}
+		if len(fields) < len(columns) {
+			continue
+		}
+
+		s := EtcdSnapshot{Name: fields[columns["Name"]]}
+		if i, ok := columns["Location"]; ok {
+			s.Location = fields[i]
+		}
+		if i, ok := columns["Size"]; ok {
+			s.Size, _ = strconv.ParseUint(fields[i], 10, 64)
+		}
+		if i, ok := columns["Created"]; ok {
+			s.Created, _ = time.Parse(time.RFC3339, fields[i])
+		}
+		snapshots = append(snapshots, s)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Created.After(snapshots[j].Created)
+	})
+	return snapshots
+}
+
+// etcdMemberListJSON is the response of /v3/cluster/member/list. The gRPC
+// gateway encodes uint64 values as strings.
+type etcdMemberListJSON struct {
+	Members []struct {
+		ID         protoUint64 `json:"ID"`
+		Name       string      `json:"name"`
+		PeerURLs   []string    `json:"peerURLs"`
+		ClientURLs []string    `json:"clientURLs"`
+		IsLearner  bool        `json:"isLearner"`
+	} `json:"members"`
+}
+
+func parseEtcdMembers(output string) ([]EtcdMember, error) {
+	var raw etcdMemberListJSON
+	if err := json.Unmarshal([]byte(output), &raw); err != nil {
+		return nil, fmt.Errorf("parse etcd member list: %w", err)
+	}
+
+	members := make([]EtcdMember, 0, len(raw.Members))
+	for _, m := range raw.Members {
+		members = append(members, EtcdMember{
+			ID:         uint64(m.ID),
+			Name:       m.Name,
+			PeerURLs:   m.PeerURLs,
+			ClientURLs: m.ClientURLs,
+			Learner:    m.IsLearner,
+		})
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+	return members, nil
+}
+
+// etcdStatusJSON is the response of /v3/maintenance/status
+type etcdStatusJSON struct {
+	Header struct {
+		MemberID protoUint64 `json:"member_id"`
+	} `json:"header"`
+	Version string      `json:"version"`
+	DBSize  protoUint64 `json:"dbSize"`
+	Leader  protoUint64 `json:"leader"`
+}
+
+func parseEtcdStatus(output string) (*EtcdStatus, error) {
+	var raw etcdStatusJSON
+	if err := json.Unmarshal([]byte(output), &raw); err != nil {
+		return nil, fmt.Errorf("parse etcd status: %w", err)
+	}
+	return &EtcdStatus{
+		MemberID: uint64(raw.Header.MemberID),
+		Leader:   uint64(raw.Leader),
+		Version:  raw.Version,
+		DBSize:   uint64(raw.DBSize),
+	}, nil
+}
+
+// lastLine returns the last non-empty line of output, which holds the error
+// k3s and curl print before exiting
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
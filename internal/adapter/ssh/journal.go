@@ -0,0 +1,283 @@
+package ssh
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+)
+
+// detectK3sUnitScript prints the k3s unit of the node: the running one of the
+// server and agent units, otherwise the one that is installed
+const detectK3sUnitScript = `for u in k3s k3s-agent; do systemctl is-active --quiet "$u" && { echo "$u"; exit 0; }; done; ` +
+	`for u in k3s k3s-agent; do systemctl cat "$u.service" >/dev/null 2>&1 && { echo "$u"; exit 0; }; done; exit 0`
+
+// maxJournalLineSize is the longest journal entry read when streaming
+const maxJournalLineSize = 1024 * 1024
+
+// Syslog priorities of journal entries
+const (
+	PriorityCrit    = 2
+	PriorityErr     = 3
+	PriorityWarning = 4
+	PriorityInfo    = 6
+	PriorityDebug   = 7
+)
+
+// JournalEntry is one entry of the systemd journal
+type JournalEntry struct {
+	Time       time.Time
+	Identifier string // SYSLOG_IDENTIFIER, e.g. "k3s"
+	PID        string
+	// Priority is the journal priority, raised to the level written in the
+	// message: k3s logs everything to stderr, which the journal records as info
+	Priority int
+	Message  string
+}
+
+// String formats the entry like journalctl -o short-iso
+func (e JournalEntry) String() string {
+	var sb strings.Builder
+	if !e.Time.IsZero() {
+		sb.WriteString(e.Time.Local().Format("2006-01-02T15:04:05"))
+		sb.WriteString(" ")
+	}
+	if e.Identifier != "" {
+		sb.WriteString(e.Identifier)
+		if e.PID != "" {
+			sb.WriteString("[" + e.PID + "]")
+		}
+		sb.WriteString(": ")
+	}
+	sb.WriteString(e.Message)
+	return sb.String()
+}
+
+// JournalOptions selects journal entries of a unit
+type JournalOptions struct {
+	Unit  string
+	Lines int64  // most recent entries, 0 for no limit
+	Since string // journalctl --since time such as "-1h"; empty for no limit
+	Boot  bool   // only entries of the current boot
+}
+
+// DetectK3sUnit returns the systemd unit k3s runs as on the node, "k3s" on
+// servers and "k3s-agent" on agents
+func (c *Client) DetectK3sUnit(ctx context.Context) (string, error) {
+	output, err := c.Execute(ctx, detectK3sUnitScript)
+	if err != nil {
+		return "", fmt.Errorf("detect k3s unit: %w", err)
+	}
+	unit := strings.TrimSpace(output)
+	if unit == "" {
+		return "", fmt.Errorf("neither k3s nor k3s-agent is installed as a systemd service")
+	}
+	return unit, nil
+}
+
+// journalctlCommand builds the journalctl command line for opts
+func journalctlCommand(opts JournalOptions, follow bool) string {
+	args := []string{"sudo", "journalctl", "-u", opts.Unit, "-o", "json", "--no-pager"}
+	if follow {
+		// Only new entries; the recent ones are already shown
+		args = append(args, "-f", "-n", "0")
+	} else {
+		if opts.Lines > 0 {
+			args = append(args, "-n", strconv.FormatInt(opts.Lines, 10))
+		}
+		if opts.Since != "" {
+			args = append(args, "--since="+opts.Since)
+		}
+		if opts.Boot {
+			args = append(args, "-b")
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+// Journal returns the journal entries of a unit, oldest first
+func (c *Client) Journal(ctx context.Context, opts JournalOptions) ([]JournalEntry, error) {
+	output, err := c.Execute(ctx, journalctlCommand(opts, false))
+	if err != nil {
+		return nil, fmt.Errorf("journalctl: %w: %s", err, strings.TrimSpace(output))
+	}
+
+	var entries []JournalEntry
+	for _, line := range strings.Split(output, "\n") {
+		// Hints journalctl prints to stderr are not JSON
+		if entry, ok := parseJournalEntry(line); ok {
+			entries = append(entries, entry)
+		}
+	}
+	return entries, nil
+}
+
+// StreamJournal streams new journal entries of a unit until ctx is cancelled
+func (c *Client) StreamJournal(ctx context.Context, opts JournalOptions, entryChan chan<- JournalEntry) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	session, err := c.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("create session: %w", err)
+	}
+
+	cmd := journalctlCommand(opts, true)
+	logger.Debug("Streaming journal", "command", cmd)
+
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		session.Close()
+		return fmt.Errorf("get stdout pipe: %w", err)
+	}
+
+	stderr, err := session.StderrPipe()
+	if err != nil {
+		session.Close()
+		return fmt.Errorf("get stderr pipe: %w", err)
+	}
+
+	if err := session.Start(cmd); err != nil {
+		session.Close()
+		return fmt.Errorf("start command: %w", err)
+	}
+
+	go func() {
+		defer session.Close()
+
+		scanner := bufio.NewScanner(stdout)
+		scanner.Buffer(make([]byte, 64*1024), maxJournalLineSize)
+		for scanner.Scan() {
+			entry, ok := parseJournalEntry(scanner.Text())
+			if !ok {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case entryChan <- entry:
+			}
+		}
+	}()
+
+	// Errors of journalctl or sudo are shown as entries
+	go func() {
+		scanner := bufio.NewScanner(stderr)
+		for scanner.Scan() {
+			entry := JournalEntry{Identifier: "journalctl", Priority: PriorityErr, Message: scanner.Text()}
+			select {
+			case <-ctx.Done():
+				return
+			case entryChan <- entry:
+			}
+		}
+	}()
+
+	<-ctx.Done()
+	session.Signal(ssh.SIGTERM)
+	return ctx.Err()
+}
+
+// journalJSON is an entry of journalctl -o json. All values are strings,
+// except MESSAGE, which is an array of bytes when it is not valid UTF-8.
+type journalJSON struct {
+	RealtimeTimestamp string          `json:"__REALTIME_TIMESTAMP"`
+	Priority          string          `json:"PRIORITY"`
+	Identifier        string          `json:"SYSLOG_IDENTIFIER"`
+	PID               string          `json:"_PID"`
+	Message           json.RawMessage `json:"MESSAGE"`
+}
+
+// parseJournalEntry parses one line of journalctl -o json
+func parseJournalEntry(line string) (JournalEntry, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "{") {
+		return JournalEntry{}, false
+	}
+
+	var raw journalJSON
+	if err := json.Unmarshal([]byte(line), &raw); err != nil {
+		return JournalEntry{}, false
+	}
+
+	entry := JournalEntry{
+		Identifier: raw.Identifier,
+		PID:        raw.PID,
+		Priority:   PriorityInfo,
+		Message:    journalMessage(raw.Message),
+	}
+	if usec, err := strconv.ParseInt(raw.RealtimeTimestamp, 10, 64); err == nil {
+		entry.Time = time.UnixMicro(usec)
+	}
+	if p, err := strconv.Atoi(raw.Priority); err == nil {
+		entry.Priority = p
+	}
+	if level, ok := messageLevel(entry.Message); ok && level < entry.Priority {
+		entry.Priority = level
+	}
+	return entry, true
+}
+
+// journalMessage decodes MESSAGE, which is a string or an array of bytes
+func journalMessage(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var b []byte
+	var ints []int
+	if err := json.Unmarshal(raw, &ints); err == nil {
+		for _, i := range ints {
+			b = append(b, byte(i))
+		}
+		return strings.ToValidUTF8(string(b), "?")
+	}
+	return ""
+}
+
+var (
+	// logrusLevel matches the level of k3s's own log lines
+	logrusLevel = regexp.MustCompile(`\blevel=(panic|fatal|error|warning|info|debug|trace)\b`)
+	// klogLevel matches the prefix of kubelet and other Kubernetes components, e.g. "E1016 "
+	klogLevel = regexp.MustCompile(`^([IWEF])\d{4} `)
+)
+
+// messageLevel returns the priority written in a k3s log line, if any
+func messageLevel(msg string) (int, bool) {
+	if m := logrusLevel.FindStringSubmatch(msg); m != nil {
+		switch m[1] {
+		case "panic", "fatal":
+			return PriorityCrit, true
+		case "error":
+			return PriorityErr, true
+		case "warning":
+			return PriorityWarning, true
+		case "info":
+			return PriorityInfo, true
+		default:
+			return PriorityDebug, true
+		}
+	}
+	if m := klogLevel.FindStringSubmatch(msg); m != nil {
+		switch m[1] {
+		case "F":
+			return PriorityCrit, true
+		case "E":
+			return PriorityErr, true
+		case "W":
+			return PriorityWarning, true
+		default:
+			return PriorityInfo, true
+		}
+	}
+	return 0, false
+}
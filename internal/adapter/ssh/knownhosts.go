@@ -0,0 +1,176 @@
+package ssh
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+	"github.com/LywwKkA-aD/k4s/internal/pathutil"
+)
+
+// defaultKnownHostsPath is used when a host has no known_hosts path configured
+const defaultKnownHostsPath = "~/.ssh/known_hosts"
+
+// UnknownHostKeyError is returned when the host's key is not in known_hosts.
+// The key can be trusted with Client.TrustHostKey before connecting again.
+type UnknownHostKeyError struct {
+	Host           string // address as written to known_hosts, e.g. "[host]:2222"
+	Key            ssh.PublicKey
+	KnownHostsPath string
+}
+
+func (e *UnknownHostKeyError) Error() string {
+	return fmt.Sprintf("unknown host key for %s (%s %s)", e.Host, e.Key.Type(), e.Fingerprint())
+}
+
+// Fingerprint returns the SHA256 fingerprint of the key, as shown by ssh
+func (e *UnknownHostKeyError) Fingerprint() string {
+	return ssh.FingerprintSHA256(e.Key)
+}
+
+// HostKeyChangedError is returned when the host presents a key that differs
+// from the one recorded in known_hosts
+type HostKeyChangedError struct {
+	Host           string
+	Key            ssh.PublicKey
+	KnownHostsPath string
+	KnownLine      int // line of the first recorded key
+}
+
+func (e *HostKeyChangedError) Error() string {
+	return fmt.Sprintf("host key for %s has changed: got %s %s, known_hosts has a different key at %s:%d",
+		e.Host, e.Key.Type(), ssh.FingerprintSHA256(e.Key), e.KnownHostsPath, e.KnownLine)
+}
+
+// Fingerprint returns the SHA256 fingerprint of the new key
+func (e *HostKeyChangedError) Fingerprint() string {
+	return ssh.FingerprintSHA256(e.Key)
+}
+
+// knownHostsPath returns the expanded known_hosts path of the host
+func knownHostsPath(host domain.SSHHost) (string, error) {
+	path := host.KnownHosts
+	if path == "" {
+		path = defaultKnownHostsPath
+	}
+	return pathutil.ExpandHome(path)
+}
+
+// hostKeyCallback verifies host keys against known_hosts. A missing file is
+// treated as empty, so the first connection asks to trust the key.
+func hostKeyCallback(host domain.SSHHost) (ssh.HostKeyCallback, []string, error) {
+	path, err := knownHostsPath(host)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var files []string
+	if _, err := os.Stat(path); err == nil {
+		files = append(files, path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("read known_hosts %s: %w", path, err)
+	}
+
+	check, err := knownhosts.New(files...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse known_hosts %s: %w", path, err)
+	}
+
+	callback := func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+		err := check(hostname, remote, key)
+		var keyErr *knownhosts.KeyError
+		if !errors.As(err, &keyErr) {
+			return err
+		}
+		if len(keyErr.Want) == 0 {
+			return &UnknownHostKeyError{Host: knownhosts.Normalize(hostname), Key: key, KnownHostsPath: path}
+		}
+		return &HostKeyChangedError{
+			Host:           knownhosts.Normalize(hostname),
+			Key:            key,
+			KnownHostsPath: path,
+			KnownLine:      keyErr.Want[0].Line,
+		}
+	}
+
+	return callback, knownHostKeyAlgorithms(check, hostAddress(host)), nil
+}
+
+// knownHostKeyAlgorithms returns the key algorithms recorded for addr, so the
+// server is asked for a key we can verify instead of one we have never seen
+func knownHostKeyAlgorithms(check ssh.HostKeyCallback, addr string) []string {
+	// An all-zero key never matches, so the error lists the known keys
+	probe, err := ssh.NewPublicKey(ed25519.PublicKey(make([]byte, ed25519.PublicKeySize)))
+	if err != nil {
+		return nil
+	}
+	err = check(addr, &net.TCPAddr{IP: net.IPv4zero}, probe)
+	var keyErr *knownhosts.KeyError
+	if !errors.As(err, &keyErr) {
+		return nil
+	}
+
+	var algorithms []string
+	for _, known := range keyErr.Want {
+		switch known.Key.Type() {
+		case ssh.KeyAlgoRSA:
+			algorithms = append(algorithms, ssh.KeyAlgoRSASHA512, ssh.KeyAlgoRSASHA256, ssh.KeyAlgoRSA)
+		default:
+			algorithms = append(algorithms, known.Key.Type())
+		}
+	}
+	return algorithms
+}
+
+// TrustHostKey appends the key of an unknown host to its known_hosts file
+func TrustHostKey(unknown *UnknownHostKeyError) error {
+	path := unknown.KnownHostsPath
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create %s: %w", filepath.Dir(path), err)
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("open known_hosts %s: %w", path, err)
+	}
+	defer f.Close()
+
+	line := knownhosts.Line([]string{unknown.Host}, unknown.Key)
+	// Don't join the entry to a last line without a newline
+	if info, err := f.Stat(); err == nil && info.Size() > 0 && !endsWithNewline(path) {
+		line = "\n" + line
+	}
+	if _, err := fmt.Fprintln(f, line); err != nil {
+		return fmt.Errorf("write known_hosts %s: %w", path, err)
+	}
+
+	logger.Info("Added SSH host key to known_hosts", "host", unknown.Host, "fingerprint", unknown.Fingerprint(), "file", path)
+	return nil
+}
+
+// endsWithNewline reports whether the file's last byte is a newline
+func endsWithNewline(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	buf := make([]byte, 1)
+	info, err := f.Stat()
+	if err != nil || info.Size() == 0 {
+		return false
+	}
+	if _, err := f.ReadAt(buf, info.Size()-1); err != nil {
+		return false
+	}
+	return buf[0] == '\n'
+}
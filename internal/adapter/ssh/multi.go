@@ -0,0 +1,85 @@
+package ssh
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+)
+
+// maxParallelHosts limits how many hosts a command runs on at the same time
+const maxParallelHosts = 16
+
+// HostTarget is a host to run a command on and the jump hosts to reach it
+type HostTarget struct {
+	Host  domain.SSHHost
+	Jumps []domain.SSHHost
+}
+
+// HostResult is the outcome of a command on one host
+type HostResult struct {
+	Host     string // host name
+	Output   string // combined stdout and stderr
+	ExitCode int    // -1 when the command did not run to completion
+	Duration time.Duration
+	Err      error // why the command could not run; a non-zero exit is not an error
+}
+
+// OK returns true if the command ran and exited with status 0
+func (r HostResult) OK() bool {
+	return r.Err == nil && r.ExitCode == 0
+}
+
+// RunOnHosts runs command on every target concurrently, each over its own
+// connection, and sends each host's result as soon as it is done. results
+// is closed once every host is done. Hosts that need a key passphrase or
+// have an unknown host key report the error instead of prompting.
+func RunOnHosts(ctx context.Context, targets []HostTarget, command string, results chan<- HostResult) {
+	defer close(results)
+
+	slots := make(chan struct{}, maxParallelHosts)
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target HostTarget) {
+			defer wg.Done()
+
+			var result HostResult
+			select {
+			case slots <- struct{}{}:
+				result = runOnHost(ctx, target, command)
+				<-slots
+			case <-ctx.Done():
+				result = HostResult{Host: target.Host.Name, ExitCode: -1, Err: ctx.Err()}
+			}
+
+			select {
+			case results <- result:
+			case <-ctx.Done():
+			}
+		}(target)
+	}
+	wg.Wait()
+}
+
+// runOnHost connects to one host, runs command and disconnects
+func runOnHost(ctx context.Context, target HostTarget, command string) HostResult {
+	start := time.Now()
+	result := HostResult{Host: target.Host.Name, ExitCode: -1}
+
+	client := NewClient(target.Host)
+	client.SetJumpHosts(target.Jumps)
+	if err := client.Connect(ctx); err != nil {
+		result.Err = err
+		result.Duration = time.Since(start)
+		return result
+	}
+	defer client.Close()
+
+	result.Output, result.ExitCode, result.Err = client.Run(ctx, command)
+	result.Duration = time.Since(start)
+	logger.Debug("SSH command finished", "host", target.Host.Name, "exit", result.ExitCode, "duration", result.Duration, "err", result.Err)
+	return result
+}
@@ -0,0 +1,113 @@
+package ssh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+	"github.com/LywwKkA-aD/k4s/internal/logger"
+)
+
+// defaultTerm is requested for the PTY when TERM is not set locally
+const defaultTerm = "xterm-256color"
+
+// ShellOptions configures an interactive shell session
+type ShellOptions struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// Resize delivers terminal size changes; the first value should be the initial size
+	Resize <-chan domain.TerminalSize
+}
+
+// Shell opens an interactive login shell on the host over the existing
+// connection and streams stdio until it exits. A non-zero exit status of the
+// shell is not treated as an error.
+func (c *Client) Shell(ctx context.Context, opts ShellOptions) error {
+	return c.runPTY(ctx, opts, "")
+}
+
+// runPTY runs command on a PTY, or the login shell when command is empty
+func (c *Client) runPTY(ctx context.Context, opts ShellOptions, command string) error {
+	if c.client == nil {
+		return fmt.Errorf("not connected")
+	}
+
+	session, err := c.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("create session: %w", err)
+	}
+	defer session.Close()
+
+	// The initial size is already queued when the terminal has one
+	size := domain.TerminalSize{Width: 80, Height: 24}
+	select {
+	case s, ok := <-opts.Resize:
+		if ok {
+			size = s
+		}
+	default:
+	}
+
+	termName := os.Getenv("TERM")
+	if termName == "" {
+		termName = defaultTerm
+	}
+	modes := ssh.TerminalModes{
+		ssh.ECHO:          1,
+		ssh.TTY_OP_ISPEED: 14400,
+		ssh.TTY_OP_OSPEED: 14400,
+	}
+	if err := session.RequestPty(termName, int(size.Height), int(size.Width), modes); err != nil {
+		return fmt.Errorf("request pty: %w", err)
+	}
+
+	session.Stdin = opts.Stdin
+	session.Stdout = opts.Stdout
+	session.Stderr = opts.Stderr
+
+	logger.Debug("SSH opening terminal", "host", c.host.Name, "command", command, "term", termName, "width", size.Width, "height", size.Height)
+	if command == "" {
+		err = session.Shell()
+	} else {
+		err = session.Start(command)
+	}
+	if err != nil {
+		return fmt.Errorf("start shell: %w", err)
+	}
+
+	// Forward window size changes until the session exits
+	go func() {
+		for s := range opts.Resize {
+			if err := session.WindowChange(int(s.Height), int(s.Width)); err != nil {
+				logger.Debug("SSH window change failed", "err", err)
+				return
+			}
+		}
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Wait()
+	}()
+
+	select {
+	case <-ctx.Done():
+		session.Close()
+		return ctx.Err()
+	case err := <-done:
+		var exitErr *ssh.ExitError
+		if errors.As(err, &exitErr) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("shell on %s: %w", c.host.Name, err)
+		}
+		return nil
+	}
+}
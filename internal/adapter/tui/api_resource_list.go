@@ -0,0 +1,156 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// apiResourceItem implements list.Item for discovered API resources
+type apiResourceItem struct {
+	resource domain.APIResource
+}
+
+func (i apiResourceItem) FilterValue() string {
+	r := i.resource
+	return r.FullName() + " " + r.Kind + " " + strings.Join(r.ShortNames, " ")
+}
+
+// apiResourceDelegate renders API resource list items
+type apiResourceDelegate struct {
+	styles Styles
+}
+
+func (d apiResourceDelegate) Height() int                             { return 1 }
+func (d apiResourceDelegate) Spacing() int                            { return 0 }
+func (d apiResourceDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d apiResourceDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(apiResourceItem)
+	if !ok {
+		return
+	}
+
+	r := item.resource
+
+	namespaced := "false"
+	if r.Namespaced {
+		namespaced = "true"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME(40) SHORTNAMES(12) APIVERSION(36) NAMESPACED(10) KIND
+	namePadded := fmt.Sprintf("%-40s", truncateString(r.FullName(), 40))
+	shortPadded := fmt.Sprintf("%-12s", truncateString(strings.Join(r.ShortNames, ","), 12))
+	versionPadded := fmt.Sprintf("%-36s", truncateString(r.GroupVersion(), 36))
+	namespacedPadded := fmt.Sprintf("%-10s", namespaced)
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	shortStyled := lipgloss.NewStyle().Foreground(colorAccent).Render(shortPadded)
+	versionStyled := mutedStyle.Render(versionPadded)
+	namespacedStyled := mutedStyle.Render(namespacedPadded)
+	kindStyled := lipgloss.NewStyle().Foreground(colorText).Render(r.Kind)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s", prefix, nameStyle.Render(namePadded), shortStyled, versionStyled, namespacedStyled, kindStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s", nameStyle.Render(namePadded), shortStyled, versionStyled, namespacedStyled, kindStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newAPIResourceList creates a list model for API resources
+func newAPIResourceList(resources []domain.APIResource, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(resources))
+	for i, r := range resources {
+		items[i] = apiResourceItem{resource: r}
+	}
+
+	delegate := apiResourceDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateAPIResourceList updates the API resource list items while preserving selection
+func updateAPIResourceList(l *list.Model, resources []domain.APIResource) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName string
+	if item, ok := l.SelectedItem().(apiResourceItem); ok {
+		currentName = item.resource.FullName()
+	}
+
+	items := make([]list.Item, len(resources))
+	newIndex := 0
+	for i, r := range resources {
+		items[i] = apiResourceItem{resource: r}
+		if r.FullName() == currentName {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
+
+// lookupAPIResource resolves input the way kubectl does: by plural name,
+// group-qualified name, short name or kind, ignoring case
+func lookupAPIResource(resources []domain.APIResource, input string) (domain.APIResource, bool) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return domain.APIResource{}, false
+	}
+	for _, r := range resources {
+		if r.Name == input || r.FullName() == input || slices.Contains(r.ShortNames, input) ||
+			strings.ToLower(r.Kind) == input || strings.ToLower(r.QualifiedKind()) == input {
+			return r, true
+		}
+	}
+	return domain.APIResource{}, false
+}
+
+// completeAPIResource returns the first group-qualified resource name
+// starting with input
+func completeAPIResource(resources []domain.APIResource, input string) string {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return ""
+	}
+	for _, r := range resources {
+		if strings.HasPrefix(r.FullName(), input) {
+			return r.FullName()
+		}
+	}
+	return ""
+}
@@ -2,7 +2,10 @@ package tui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -17,8 +20,17 @@ import (
 	"github.com/LywwKkA-aD/k4s/internal/logger"
 )
 
+// podRefreshInterval is how often the pods and events views refresh. Once the
+// watch cache is synced this only re-reads the cache to keep ages current.
 const podRefreshInterval = 5 * time.Second
 
+// rolloutRefreshInterval is how often deployment details refresh while a
+// rollout is in progress
+const rolloutRefreshInterval = 2 * time.Second
+
+// maxWatchEventsPerMsg caps how many watch deltas are applied in one update
+const maxWatchEventsPerMsg = 256
+
 // ViewState represents the current view
 type ViewState int
 
@@ -41,6 +53,50 @@ const (
 	ViewServiceDetails
 	ViewEvents
 	ViewMultiPodLogs
+	ViewPortForwards
+	ViewContextSelect
+	ViewStatefulSets
+	ViewStatefulSetDetails
+	ViewDaemonSets
+	ViewDaemonSetDetails
+	ViewReplicaSets
+	ViewReplicaSetDetails
+	ViewJobs
+	ViewJobDetails
+	ViewCronJobs
+	ViewCronJobDetails
+	ViewYAML
+	ViewEditDiff
+	ViewDeploymentHistory
+	ViewRevisionDiff
+	ViewNodes
+	ViewNodeDetails
+	ViewNodeDrain
+	ViewCrictlStats
+	ViewCrictlInspect
+	ViewCrictlImages
+	ViewCrictlSandboxes
+	ViewSSHRun
+	ViewSSHRunDiff
+	ViewK3sJournal
+	ViewEtcdSnapshots
+	ViewConfigMaps
+	ViewConfigMapDetails
+	ViewSecrets
+	ViewSecretDetails
+	ViewIngresses
+	ViewIngressDetails
+	ViewIngressRoutes
+	ViewIngressRouteDetails
+	ViewPVCs
+	ViewPVCDetails
+	ViewPVs
+	ViewPVDetails
+	ViewStorageClasses
+	ViewAPIResources
+	ViewResources
+	ViewHelmCharts
+	ViewHelmChartDetails
 )
 
 // Messages for async operations
@@ -50,6 +106,12 @@ type connectResultMsg struct {
 	err         error
 }
 
+type contextsResultMsg struct {
+	contexts []domain.KubeContext
+	picker   bool // always show the picker, even for a single context
+	err      error
+}
+
 type namespacesResultMsg struct {
 	namespaces []domain.Namespace
 	err        error
@@ -79,6 +141,41 @@ type podRestartResultMsg struct {
 	err     error
 }
 
+type execFinishedMsg struct {
+	podName   string
+	container string
+	err       error
+}
+
+// YAML viewer messages
+type resourceYAMLResultMsg struct {
+	yaml string
+	err  error
+}
+
+// Edit messages
+type editFetchedMsg struct {
+	kind       string
+	namespace  string
+	name       string
+	yaml       string
+	returnView ViewState
+	err        error
+}
+
+type editorFinishedMsg struct {
+	err error
+}
+
+type editDryRunResultMsg struct {
+	result string
+	err    error
+}
+
+type editApplyResultMsg struct {
+	err error
+}
+
 type logsResultMsg struct {
 	logs string
 	err  error
@@ -113,6 +210,12 @@ type sshConnectResultMsg struct {
 	err error
 }
 
+// sshShellFinishedMsg is sent when an interactive shell on a node ends
+type sshShellFinishedMsg struct {
+	host string
+	err  error
+}
+
 type sshCrictlContainersMsg struct {
 	containers []ssh.CrictlContainer
 	err        error
@@ -136,6 +239,95 @@ type sshCrictlLogStreamEndedMsg struct {
 	err error
 }
 
+// sshJournalMsg carries the recent journal entries of the node's k3s unit
+type sshJournalMsg struct {
+	unit    string
+	entries []ssh.JournalEntry
+	err     error
+}
+
+type sshJournalEntryMsg struct {
+	entry ssh.JournalEntry
+}
+
+type sshJournalStreamEndedMsg struct {
+	err error
+}
+
+// crictlExecFinishedMsg is sent when a crictl exec session ends
+type crictlExecFinishedMsg struct {
+	container string
+	err       error
+}
+
+// crictlTarget is what a confirmed crictl action applies to
+type crictlTarget struct {
+	name string   // shown in notifications
+	ids  []string // container, image or sandbox IDs
+}
+
+// crictlActionMsg reports the result of a confirmed crictl action
+type crictlActionMsg struct {
+	action ConfirmAction
+	name   string
+	err    error
+}
+
+// etcdSnapshotsMsg carries the etcd snapshots of the node and the members of
+// its etcd cluster. Members are optional; membersErr does not hide the list.
+type etcdSnapshotsMsg struct {
+	snapshots  []ssh.EtcdSnapshot
+	members    []ssh.EtcdMember
+	status     *ssh.EtcdStatus
+	membersErr error
+	err        error
+}
+
+// etcdSnapshotSavedMsg reports the result of an on-demand etcd snapshot
+type etcdSnapshotSavedMsg struct {
+	name string
+	err  error
+}
+
+// crictlImagesMsg carries the images of the node with the containers using them
+type crictlImagesMsg struct {
+	images     []ssh.CrictlImage
+	containers []ssh.CrictlContainer
+	err        error
+}
+
+// crictlSandboxesMsg carries the pod sandboxes of the node with their containers
+type crictlSandboxesMsg struct {
+	pods       []ssh.CrictlPod
+	containers []ssh.CrictlContainer
+	err        error
+}
+
+type crictlInspectMsg struct {
+	container ssh.CrictlContainer
+	output    string
+	err       error
+}
+
+type crictlStatsMsg struct {
+	stats []ssh.CrictlStats
+	err   error
+}
+
+type crictlStatsTickMsg struct{}
+
+// sshRunResultMsg carries the result of one host of a multi-host run
+type sshRunResultMsg struct {
+	runID   int
+	result  ssh.HostResult
+	results <-chan ssh.HostResult
+}
+
+// sshRunFinishedMsg is sent once every host of a run is done
+type sshRunFinishedMsg struct {
+	runID int
+}
+
 // Deployment-related messages
 type deploymentsResultMsg struct {
 	deployments []domain.Deployment
@@ -153,6 +345,20 @@ type deploymentScaleResultMsg struct {
 	err            error
 }
 
+type deploymentRollbackResultMsg struct {
+	deploymentName string
+	revision       int64
+	err            error
+}
+
+type deploymentPauseResultMsg struct {
+	deploymentName string
+	paused         bool
+	err            error
+}
+
+type rolloutRefreshTickMsg struct{}
+
 type deploymentRestartResultMsg struct {
 	deploymentName string
 	err            error
@@ -163,6 +369,132 @@ type deploymentDeleteResultMsg struct {
 	err            error
 }
 
+// StatefulSet-related messages
+type statefulSetsResultMsg struct {
+	statefulSets []domain.StatefulSet
+	err          error
+}
+
+type statefulSetDetailsResultMsg struct {
+	statefulSet *domain.StatefulSet
+	err         error
+}
+
+type statefulSetScaleResultMsg struct {
+	statefulSetName string
+	replicas        int32
+	err             error
+}
+
+type statefulSetRestartResultMsg struct {
+	statefulSetName string
+	err             error
+}
+
+// DaemonSet-related messages
+type daemonSetsResultMsg struct {
+	daemonSets []domain.DaemonSet
+	err        error
+}
+
+type daemonSetDetailsResultMsg struct {
+	daemonSet *domain.DaemonSet
+	err       error
+}
+
+type daemonSetRestartResultMsg struct {
+	daemonSetName string
+	err           error
+}
+
+// ReplicaSet-related messages
+type replicaSetsResultMsg struct {
+	replicaSets []domain.ReplicaSet
+	err         error
+}
+
+type replicaSetDetailsResultMsg struct {
+	replicaSet *domain.ReplicaSet
+	err        error
+}
+
+// Job-related messages
+type jobsResultMsg struct {
+	jobs []domain.Job
+	err  error
+}
+
+type jobDetailsResultMsg struct {
+	job *domain.Job
+	err error
+}
+
+// CronJob-related messages
+type cronJobsResultMsg struct {
+	cronJobs []domain.CronJob
+	err      error
+}
+
+type cronJobDetailsResultMsg struct {
+	cronJob *domain.CronJob
+	err     error
+}
+
+type cronJobTriggerResultMsg struct {
+	cronJobName string
+	jobName     string
+	err         error
+}
+
+type cronJobSuspendResultMsg struct {
+	cronJobName string
+	suspend     bool
+	err         error
+}
+
+// Node-related messages
+type nodesResultMsg struct {
+	nodes []domain.Node
+	err   error
+}
+
+type nodeDetailsResultMsg struct {
+	node *domain.Node
+	err  error
+}
+
+type nodeCordonResultMsg struct {
+	nodeName string
+	cordon   bool
+	err      error
+}
+
+// drainEventMsg carries the progress of a running drain
+type drainEventMsg struct {
+	event domain.DrainEvent
+}
+
+// drainFinishedMsg is sent when a drain has ended
+type drainFinishedMsg struct {
+	err error
+}
+
+// crictlFocus is the pod whose containers are selected in the crictl view
+type crictlFocus struct {
+	namespace string
+	pod       string
+}
+
+// nodeContainersMsg carries the nodes looked up to open the crictl
+// containers of one of them over SSH
+type nodeContainersMsg struct {
+	nodeName string
+	nodes    []domain.Node
+	focus    *crictlFocus
+	from     ViewState
+	err      error
+}
+
 // Service-related messages
 type servicesResultMsg struct {
 	services []domain.Service
@@ -174,6 +506,109 @@ type serviceDetailsResultMsg struct {
 	err     error
 }
 
+// ConfigMap and Secret messages
+type configMapsResultMsg struct {
+	configMaps []domain.ConfigMap
+	err        error
+}
+
+type configMapDetailsResultMsg struct {
+	configMap *domain.ConfigMap
+	err       error
+}
+
+type secretsResultMsg struct {
+	secrets []domain.Secret
+	err     error
+}
+
+type secretDetailsResultMsg struct {
+	secret *domain.Secret
+	err    error
+}
+
+// Ingress and IngressRoute messages
+type ingressesResultMsg struct {
+	ingresses []domain.Ingress
+	err       error
+}
+
+type ingressDetailsResultMsg struct {
+	ingress *domain.Ingress
+	err     error
+}
+
+type ingressRoutesResultMsg struct {
+	routes []domain.IngressRoute
+	err    error
+}
+
+type ingressRouteDetailsResultMsg struct {
+	route *domain.IngressRoute
+	err   error
+}
+
+// Storage messages
+type pvcsResultMsg struct {
+	claims []domain.PersistentVolumeClaim
+	err    error
+}
+
+type pvcDetailsResultMsg struct {
+	claim *domain.PersistentVolumeClaim
+	err   error
+}
+
+type pvsResultMsg struct {
+	volumes []domain.PersistentVolume
+	err     error
+}
+
+type pvDetailsResultMsg struct {
+	volume *domain.PersistentVolume
+	err    error
+}
+
+type storageClassesResultMsg struct {
+	classes []domain.StorageClass
+	err     error
+}
+
+type pvcResizeResultMsg struct {
+	name string
+	size string
+	err  error
+}
+
+// Resource browser messages
+type apiResourcesResultMsg struct {
+	resources []domain.APIResource
+	open      string // resource typed at the : prompt, opened once discovered
+	err       error
+}
+
+type resourcesResultMsg struct {
+	list *domain.ResourceList
+	err  error
+}
+
+type resourceDeleteResultMsg struct {
+	kind string
+	name string
+	err  error
+}
+
+// HelmChart messages
+type helmChartsResultMsg struct {
+	charts []domain.HelmChart
+	err    error
+}
+
+type helmChartDetailsResultMsg struct {
+	chart *domain.HelmChart
+	err   error
+}
+
 // Event-related messages
 type eventsResultMsg struct {
 	events []domain.Event
@@ -186,40 +621,63 @@ type metricsResultMsg struct {
 	err     error
 }
 
+// Port-forward messages
+type portForwardStartedMsg struct {
+	forward *k8s.PortForward
+	target  string
+	err     error
+}
+
+type portForwardEndedMsg struct {
+	id int
+}
+
+type portForwardTickMsg struct{}
+
+// Watch messages
+type watchEventsMsg struct {
+	watcher *k8s.Watcher
+	events  []k8s.WatchEvent
+}
+
 // App is the main TUI application model
 type App struct {
-	styles             Styles
-	width              int
-	height             int
-	contentWidth       int  // width for main content area (excludes sidebar + padding)
-	sidebarWidth       int  // sidebar panel width (0 when hidden)
-	showSidebar        bool // false when terminal < sidebarMinWidth
-	ready              bool
-	config             *domain.Config
-	selectedConfig     *domain.KubeConfig
-	viewState          ViewState
-	kubeConfigList     list.Model
-	namespaceList      list.Model
-	podList            list.Model
-	podDetails         PodDetailsModel
-	selectedPodName    string
-	pods               []domain.Pod
-	k8sClient          *k8s.Client
-	clusterInfo        *domain.ClusterInfo
-	connectionStatus   domain.ConnectionStatus
-	spinner            spinner.Model
-	err                error
-	loading            bool
-	podCount           int
-	namespaceCount     int
-	confirmDialog      ConfirmDialog
-	notification       Notification
-	logViewer          LogViewer
-	logSourceView      ViewState // Track where we came from when viewing logs
-	containerSelector  ContainerSelector
-	logStreamCancel    context.CancelFunc
-	logStreamActive    bool
-	logLineChan        <-chan string
+	styles               Styles
+	width                int
+	height               int
+	contentWidth         int  // width for main content area (excludes sidebar + padding)
+	sidebarWidth         int  // sidebar panel width (0 when hidden)
+	showSidebar          bool // false when terminal < sidebarMinWidth
+	ready                bool
+	config               *domain.Config
+	selectedConfig       *domain.KubeConfig
+	selectedContext      string // context in use; empty means the kubeconfig's current-context
+	viewState            ViewState
+	kubeConfigList       list.Model
+	contextList          list.Model
+	namespaceList        list.Model
+	podList              list.Model
+	podDetails           PodDetailsModel
+	selectedPodName      string
+	selectedPodNamespace string
+	pods                 []domain.Pod
+	k8sClient            *k8s.Client
+	clusterInfo          *domain.ClusterInfo
+	connectionStatus     domain.ConnectionStatus
+	spinner              spinner.Model
+	err                  error
+	loading              bool
+	podCount             int
+	namespaceCount       int
+	confirmDialog        ConfirmDialog
+	notification         Notification
+	logViewer            LogViewer
+	logSourceView        ViewState // Track where we came from when viewing logs
+	containerSelector    ContainerSelector
+	execPod              *domain.Pod // pod waiting for a container choice before exec
+	logStreamCancel      context.CancelFunc
+	logStreamActive      bool
+	logLineChan          <-chan string
 
 	// SSH-related fields
 	sshHostList             list.Model
@@ -229,11 +687,40 @@ type App struct {
 	crictlContainerList     list.Model
 	nodeInfo                *domain.NodeInfo
 	passphraseInput         PassphraseInput
+	hostKeyPrompt           HostKeyPrompt
 	crictlLogViewer         CrictlLogViewer
 	selectedCrictlContainer *ssh.CrictlContainer
 	crictlLogStreamCancel   context.CancelFunc
 	crictlLogStreamActive   bool
 	crictlLogLineChan       <-chan string
+	crictlFocus             *crictlFocus  // pod to select once containers load
+	crictlReturnView        ViewState     // view to go back to when the containers are left
+	podDetailsFromCrictl    bool          // pod details were opened from a crictl container
+	sshShellPending         bool          // open a shell once the connection is up
+	crictlAction            *crictlTarget // target of a pending crictl action
+	crictlImages            []ssh.CrictlImage
+	crictlImageList         list.Model
+	crictlSandboxes         []ssh.CrictlPod
+	crictlSandboxList       list.Model
+	crictlInspect           JSONTree
+	crictlStatsViewer       CrictlStatsViewer
+	crictlStatsWatching     bool            // a stats refresh tick is scheduled
+	markedSSHHosts          map[string]bool // hosts picked for running a command
+	sshCommandDialog        SSHCommandDialog
+	sshRunViewer            SSHRunViewer
+	sshRunTargets           []domain.SSHHost // hosts of the current run
+	sshRunCancel            context.CancelFunc
+	sshRunID                int // results of older runs are dropped
+	journalViewer           JournalViewer
+	journalStreamCancel     context.CancelFunc
+	journalStreamActive     bool
+	journalEntryChan        <-chan ssh.JournalEntry
+	etcdSnapshots           []ssh.EtcdSnapshot
+	etcdSnapshotList        list.Model
+	etcdMembers             []ssh.EtcdMember
+	etcdStatus              *ssh.EtcdStatus
+	etcdMembersErr          error
+	etcdSnapshotDialog      EtcdSnapshotDialog
 
 	// Help screen
 	helpScreen HelpScreen
@@ -242,16 +729,147 @@ type App struct {
 	searchInput SearchInput
 
 	// Deployments view
-	deploymentList       list.Model
-	deploymentCount      int
-	deploymentDetails    DeploymentDetailsModel
-	selectedDeployName   string
+	deploymentList          list.Model
+	deploymentCount         int
+	deploymentDetails       DeploymentDetailsModel
+	selectedDeployName      string
+	selectedDeployNamespace string
+
+	// StatefulSets view
+	statefulSetList              list.Model
+	statefulSetCount             int
+	statefulSetDetails           StatefulSetDetailsModel
+	selectedStatefulSetName      string
+	selectedStatefulSetNamespace string
+
+	// DaemonSets view
+	daemonSetList              list.Model
+	daemonSetCount             int
+	daemonSetDetails           DaemonSetDetailsModel
+	selectedDaemonSetName      string
+	selectedDaemonSetNamespace string
+
+	// ReplicaSets view
+	replicaSetList              list.Model
+	replicaSetCount             int
+	replicaSetDetails           ReplicaSetDetailsModel
+	selectedReplicaSetName      string
+	selectedReplicaSetNamespace string
+
+	// Jobs view
+	jobList              list.Model
+	jobCount             int
+	jobDetails           JobDetailsModel
+	selectedJobName      string
+	selectedJobNamespace string
+
+	// CronJobs view
+	cronJobList              list.Model
+	cronJobCount             int
+	cronJobDetails           CronJobDetailsModel
+	selectedCronJobName      string
+	selectedCronJobNamespace string
+
+	// Nodes view
+	nodeList         list.Model
+	nodeCount        int
+	nodes            []domain.Node // last fetched nodes, used to link SSH hosts
+	nodeDetails      NodeDetailsModel
+	selectedNodeName string
+
+	// Node drain
+	drainDialog     DrainDialog
+	drainProgress   DrainProgress
+	drainCancel     context.CancelFunc
+	drainEvents     <-chan domain.DrainEvent
+	drainResult     <-chan error
+	drainSourceView ViewState // view to go back to when the drain view is left
+
+	// Pods view narrowed to the pods of one owner, e.g. a job
+	podScope *podScope
+
+	// Resource prompt (":")
+	resourcePrompt ResourcePrompt
+
+	// YAML viewer
+	yamlViewer     YAMLViewer
+	yamlSourceView ViewState // Details view the YAML was opened from
+
+	// Edit in $EDITOR
+	edit       *editSession
+	diffViewer DiffViewer
+
+	// Deployment rollout history
+	revisionList     list.Model
+	markedRevision   int64 // revision picked as the base of a diff, 0 for none
+	rollbackRevision int64 // revision a pending rollback goes to
+	rolloutWatching  bool  // a rollout refresh tick is scheduled
 
 	// Services view
-	serviceList        list.Model
-	serviceCount       int
-	serviceDetails     ServiceDetailsModel
-	selectedServiceName string
+	serviceList              list.Model
+	serviceCount             int
+	serviceDetails           ServiceDetailsModel
+	selectedServiceName      string
+	selectedServiceNamespace string
+	serviceSourceView        ViewState // list or route details the service was opened from
+
+	// ConfigMaps and Secrets views; both details views share configData
+	configMapList              list.Model
+	configMapCount             int
+	configMaps                 []domain.ConfigMap
+	selectedConfigMapName      string
+	selectedConfigMapNamespace string
+	secretList                 list.Model
+	secretCount                int
+	secrets                    []domain.Secret
+	selectedSecretName         string
+	selectedSecretNamespace    string
+	configData                 ConfigDataViewer
+
+	// Ingresses and Traefik IngressRoutes views
+	ingressList                   list.Model
+	ingressCount                  int
+	ingresses                     []domain.Ingress
+	ingressDetails                IngressDetailsModel
+	selectedIngressName           string
+	selectedIngressNamespace      string
+	ingressRouteList              list.Model
+	ingressRouteCount             int
+	ingressRoutes                 []domain.IngressRoute
+	ingressRouteDetails           IngressRouteDetailsModel
+	selectedIngressRouteName      string
+	selectedIngressRouteNamespace string
+
+	// Storage views
+	pvcList              list.Model
+	pvcCount             int
+	pvcs                 []domain.PersistentVolumeClaim
+	pvcDetails           PVCDetailsModel
+	selectedPVCName      string
+	selectedPVCNamespace string
+	pvcResizeDialog      PVCResizeDialog
+	pvList               list.Model
+	pvs                  []domain.PersistentVolume
+	pvDetails            PVDetailsModel
+	selectedPVName       string
+	pvSourceView         ViewState // list or claim details the volume was opened from
+	pvOrphansOnly        bool      // the volumes list shows orphaned volumes only
+	storageClassList     list.Model
+	storageClasses       []domain.StorageClass
+
+	// Resource browser
+	apiResourceList  list.Model
+	apiResources     []domain.APIResource
+	resourceList     list.Model
+	resourceData     *domain.ResourceList
+	selectedResource domain.APIResource // resource type shown in the browser
+
+	// k3s HelmCharts
+	helmChartList              list.Model
+	helmCharts                 []domain.HelmChart
+	helmChartDetails           HelmChartDetailsModel
+	selectedHelmChartName      string
+	selectedHelmChartNamespace string
 
 	// Events view
 	eventViewer EventViewer
@@ -266,14 +884,37 @@ type App struct {
 	scaleDialog ScaleDialog
 
 	// Multi-pod log fields
-	podMultiSelector       PodMultiSelector
-	multiPodLogViewer      MultiPodLogViewer
-	multiPodStreamCancel   context.CancelFunc
-	multiPodStreamCtx      context.Context
-	multiPodStreamActive   bool
-	multiPodActiveStreams   int
-	multiPodLineChanMap    map[string]<-chan string
-	multiPodContainerMap   map[string]string // podName -> containerName for restarts
+	podMultiSelector      PodMultiSelector
+	multiPodLogViewer     MultiPodLogViewer
+	multiPodStreamCancel  context.CancelFunc
+	multiPodStreamCtx     context.Context
+	multiPodStreamActive  bool
+	multiPodActiveStreams int
+	multiPodLineChanMap   map[string]<-chan string
+	multiPodTargets       map[string]multiPodTarget // pod label -> stream target for restarts
+
+	// Port-forward fields
+	portForwards      []*k8s.PortForward
+	portForwardList   list.Model
+	portForwardDialog PortForwardDialog
+
+	// All-namespaces mode: pods, workloads, services and events are listed
+	// across every namespace and actions use each item's own namespace
+	allNamespaces bool
+
+	// Watch cache fields
+	watcher         *k8s.Watcher
+	watchSynced     map[k8s.Resource]bool
+	watchErrorShown bool
+	deployments     []domain.Deployment
+	statefulSets    []domain.StatefulSet
+	daemonSets      []domain.DaemonSet
+	replicaSets     []domain.ReplicaSet
+	jobs            []domain.Job
+	cronJobs        []domain.CronJob
+	services        []domain.Service
+	namespaces      []domain.Namespace
+	events          []domain.Event
 }
 
 // NewApp creates a new App instance with configuration
@@ -283,26 +924,55 @@ func NewApp(cfg *domain.Config) *App {
 	s.Style = lipgloss.NewStyle().Foreground(colorPrimary)
 
 	app := &App{
-		styles:            DefaultStyles(),
-		config:            cfg,
-		viewState:         ViewKubeConfigSelect,
-		connectionStatus:  domain.StatusDisconnected,
-		spinner:           s,
-		podDetails:        NewPodDetailsModel(DefaultStyles()),
-		confirmDialog:     NewConfirmDialog(),
-		notification:      NewNotification(),
-		logViewer:         NewLogViewer(DefaultStyles()),
-		containerSelector: NewContainerSelector(),
-		passphraseInput:       NewPassphraseInput(),
-		crictlLogViewer:       NewCrictlLogViewer(DefaultStyles()),
-		helpScreen:            NewHelpScreen(),
-		searchInput:           NewSearchInput(),
-		deploymentDetails:     NewDeploymentDetailsModel(DefaultStyles()),
-		serviceDetails:        NewServiceDetailsModel(DefaultStyles()),
-		eventViewer:           NewEventViewer(DefaultStyles()),
-		scaleDialog:           NewScaleDialog(),
-		podMultiSelector:      NewPodMultiSelector(),
-		multiPodLogViewer:     NewMultiPodLogViewer(DefaultStyles()),
+		styles:              DefaultStyles(),
+		config:              cfg,
+		viewState:           ViewKubeConfigSelect,
+		connectionStatus:    domain.StatusDisconnected,
+		spinner:             s,
+		podDetails:          NewPodDetailsModel(DefaultStyles()),
+		confirmDialog:       NewConfirmDialog(),
+		notification:        NewNotification(),
+		logViewer:           NewLogViewer(DefaultStyles()),
+		containerSelector:   NewContainerSelector(),
+		passphraseInput:     NewPassphraseInput(),
+		hostKeyPrompt:       NewHostKeyPrompt(),
+		crictlLogViewer:     NewCrictlLogViewer(DefaultStyles()),
+		crictlInspect:       NewJSONTree(),
+		crictlStatsViewer:   NewCrictlStatsViewer(),
+		markedSSHHosts:      make(map[string]bool),
+		sshCommandDialog:    NewSSHCommandDialog(),
+		sshRunViewer:        NewSSHRunViewer(),
+		journalViewer:       NewJournalViewer(DefaultStyles()),
+		etcdSnapshotDialog:  NewEtcdSnapshotDialog(),
+		helpScreen:          NewHelpScreen(),
+		searchInput:         NewSearchInput(),
+		deploymentDetails:   NewDeploymentDetailsModel(DefaultStyles()),
+		statefulSetDetails:  NewStatefulSetDetailsModel(DefaultStyles()),
+		daemonSetDetails:    NewDaemonSetDetailsModel(DefaultStyles()),
+		replicaSetDetails:   NewReplicaSetDetailsModel(DefaultStyles()),
+		jobDetails:          NewJobDetailsModel(DefaultStyles()),
+		cronJobDetails:      NewCronJobDetailsModel(DefaultStyles()),
+		nodeDetails:         NewNodeDetailsModel(DefaultStyles()),
+		drainDialog:         NewDrainDialog(),
+		drainProgress:       NewDrainProgress(DefaultStyles()),
+		resourcePrompt:      NewResourcePrompt(),
+		yamlViewer:          NewYAMLViewer(DefaultStyles()),
+		diffViewer:          NewDiffViewer(DefaultStyles()),
+		serviceDetails:      NewServiceDetailsModel(DefaultStyles()),
+		configData:          NewConfigDataViewer(),
+		ingressDetails:      NewIngressDetailsModel(DefaultStyles()),
+		ingressRouteDetails: NewIngressRouteDetailsModel(DefaultStyles()),
+		pvcDetails:          NewPVCDetailsModel(DefaultStyles()),
+		pvcResizeDialog:     NewPVCResizeDialog(),
+		pvDetails:           NewPVDetailsModel(DefaultStyles()),
+		helmChartDetails:    NewHelmChartDetailsModel(DefaultStyles()),
+		eventViewer:         NewEventViewer(DefaultStyles()),
+		scaleDialog:         NewScaleDialog(),
+		podMultiSelector:    NewPodMultiSelector(),
+		multiPodLogViewer:   NewMultiPodLogViewer(DefaultStyles()),
+		portForwardDialog:   NewPortForwardDialog(),
+		portForwardList:     newPortForwardList(nil, 0, 0, DefaultStyles()),
+		contextList:         newContextList(nil, "", 0, 0, DefaultStyles()),
 	}
 
 	// If only one kubeconfig, auto-select it
@@ -319,18 +989,227 @@ func (a *App) Init() tea.Cmd {
 
 	// Auto-connect if only one kubeconfig
 	if a.selectedConfig != nil {
-		a.viewState = ViewConnecting
-		a.connectionStatus = domain.StatusConnecting
-		cmds = append(cmds, a.connectToCluster(a.selectedConfig.Path))
+		cmds = append(cmds, a.selectKubeConfig(a.selectedConfig))
 	}
 
 	return tea.Batch(cmds...)
 }
 
+// selectKubeConfig connects to a kubeconfig. When the kubeconfig defines
+// several contexts and none is pinned in the config, a context picker is shown first.
+func (a *App) selectKubeConfig(cfg *domain.KubeConfig) tea.Cmd {
+	a.selectedConfig = cfg
+	a.err = nil
+	if cfg.Context != "" {
+		return a.connectWithContext(cfg.Context)
+	}
+
+	a.viewState = ViewConnecting
+	a.connectionStatus = domain.StatusConnecting
+	return a.fetchContexts(cfg.Path, false)
+}
+
+// connectWithContext connects to the selected kubeconfig using the given context
+func (a *App) connectWithContext(contextName string) tea.Cmd {
+	a.selectedContext = contextName
+	a.viewState = ViewConnecting
+	a.connectionStatus = domain.StatusConnecting
+	a.err = nil
+	return a.connectToCluster(a.selectedConfig.Path, contextName)
+}
+
+// disconnect drops the cluster connection and everything bound to it
+func (a *App) disconnect() {
+	a.stopLogStream()
+	a.stopMultiPodStreams()
+	a.stopAllPortForwards()
+	a.stopWatcher()
+	a.stopDrain()
+	a.k8sClient = nil
+	a.clusterInfo = nil
+	a.metricsClient = nil
+	a.metricsAvailable = false
+	a.podScope = nil
+	if a.edit != nil {
+		a.edit.cleanup()
+		a.edit = nil
+	}
+	a.connectionStatus = domain.StatusDisconnected
+}
+
+// podScope narrows the pods view to the pods matching a label selector in one
+// namespace, e.g. the pods of a job. Esc returns to returnView.
+type podScope struct {
+	label      string // shown in the pods view title, e.g. "job: backup-123"
+	namespace  string
+	selector   map[string]string
+	returnView ViewState
+}
+
+// scopePods returns the pods shown in the pods view
+func (a *App) scopePods(pods []domain.Pod) []domain.Pod {
+	if a.podScope == nil {
+		return pods
+	}
+	scoped := make([]domain.Pod, 0, len(pods))
+	for _, pod := range pods {
+		if pod.Namespace != a.podScope.namespace {
+			continue
+		}
+		matches := true
+		for k, v := range a.podScope.selector {
+			if pod.Labels[k] != v {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			scoped = append(scoped, pod)
+		}
+	}
+	return scoped
+}
+
+// gotoView switches to a top-level view and loads its contents
+func (a *App) gotoView(view ViewState) tea.Cmd {
+	a.podScope = nil
+	a.podDetailsFromCrictl = false
+	a.viewState = view
+	a.loading = true
+
+	switch view {
+	case ViewNamespaces:
+		return a.fetchNamespaces()
+	case ViewPods:
+		return tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+	case ViewDeployments:
+		return a.fetchDeployments()
+	case ViewServices:
+		return a.fetchServices()
+	case ViewEvents:
+		return tea.Batch(a.fetchEvents(), a.scheduleEventRefresh())
+	case ViewStatefulSets:
+		return a.fetchStatefulSets()
+	case ViewDaemonSets:
+		return a.fetchDaemonSets()
+	case ViewReplicaSets:
+		return a.fetchReplicaSets()
+	case ViewJobs:
+		return a.fetchJobs()
+	case ViewCronJobs:
+		return a.fetchCronJobs()
+	case ViewNodes:
+		return a.fetchNodes()
+	case ViewConfigMaps:
+		return a.fetchConfigMaps()
+	case ViewSecrets:
+		return a.fetchSecrets()
+	case ViewIngresses:
+		return a.fetchIngresses()
+	case ViewIngressRoutes:
+		return a.fetchIngressRoutes()
+	case ViewPVCs:
+		return a.fetchPVCs()
+	case ViewPVs:
+		a.pvOrphansOnly = false
+		return a.fetchPVs()
+	case ViewStorageClasses:
+		return a.fetchStorageClasses()
+	case ViewAPIResources:
+		return a.fetchAPIResources("")
+	case ViewHelmCharts:
+		return a.fetchHelmCharts()
+	case ViewPortForwards:
+		a.loading = false
+		a.err = nil
+		updatePortForwardList(&a.portForwardList, a.portForwardInfos())
+		return a.schedulePortForwardRefresh()
+	}
+	a.loading = false
+	return nil
+}
+
+// listNamespace returns the namespace the resource lists are scoped to
+func (a *App) listNamespace() string {
+	if a.allNamespaces {
+		return k8s.AllNamespaces
+	}
+	return a.k8sClient.CurrentNamespace()
+}
+
+// namespaceLabel names the namespace scope shown in the sidebar and status badge
+func (a *App) namespaceLabel() string {
+	if a.allNamespaces {
+		return "all"
+	}
+	return a.clusterInfo.Namespace
+}
+
+// setAllNamespaces switches all-namespaces mode and rebuilds the lists with or
+// without the NAMESPACE column. The caller restarts the watch cache.
+func (a *App) setAllNamespaces(enabled bool) {
+	if a.allNamespaces == enabled {
+		return
+	}
+	a.allNamespaces = enabled
+
+	a.pods = nil
+	a.deployments = nil
+	a.statefulSets = nil
+	a.daemonSets = nil
+	a.replicaSets = nil
+	a.jobs = nil
+	a.cronJobs = nil
+	a.services = nil
+	a.configMaps = nil
+	a.secrets = nil
+	a.ingresses = nil
+	a.ingressRoutes = nil
+	a.pvcs = nil
+	a.resourceData = nil
+	a.events = nil
+	a.podCount = 0
+	a.deploymentCount = 0
+	a.statefulSetCount = 0
+	a.daemonSetCount = 0
+	a.replicaSetCount = 0
+	a.jobCount = 0
+	a.cronJobCount = 0
+	a.serviceCount = 0
+	a.configMapCount = 0
+	a.secretCount = 0
+	a.ingressCount = 0
+	a.ingressRouteCount = 0
+	a.pvcCount = 0
+	a.podList = newPodList(nil, a.podList.Width(), a.podList.Height(), a.styles, a.metricsEnabled, a.podMetrics, enabled)
+	a.deploymentList = newDeploymentList(nil, a.deploymentList.Width(), a.deploymentList.Height(), a.styles, enabled)
+	a.statefulSetList = newStatefulSetList(nil, a.statefulSetList.Width(), a.statefulSetList.Height(), a.styles, enabled)
+	a.daemonSetList = newDaemonSetList(nil, a.daemonSetList.Width(), a.daemonSetList.Height(), a.styles, enabled)
+	a.replicaSetList = newReplicaSetList(nil, a.replicaSetList.Width(), a.replicaSetList.Height(), a.styles, enabled)
+	a.jobList = newJobList(nil, a.jobList.Width(), a.jobList.Height(), a.styles, enabled)
+	a.cronJobList = newCronJobList(nil, a.cronJobList.Width(), a.cronJobList.Height(), a.styles, enabled)
+	a.serviceList = newServiceList(nil, a.serviceList.Width(), a.serviceList.Height(), a.styles, enabled)
+	a.configMapList = newConfigMapList(nil, a.configMapList.Width(), a.configMapList.Height(), a.styles, enabled)
+	a.secretList = newSecretList(nil, a.secretList.Width(), a.secretList.Height(), a.styles, enabled)
+	a.ingressList = newIngressList(nil, a.ingressList.Width(), a.ingressList.Height(), a.styles, enabled)
+	a.ingressRouteList = newIngressRouteList(nil, a.ingressRouteList.Width(), a.ingressRouteList.Height(), a.styles, enabled)
+	a.pvcList = newPVCList(nil, a.pvcList.Width(), a.pvcList.Height(), a.styles, enabled)
+	updateResourceList(&a.resourceList, nil, a.styles, enabled)
+	a.eventViewer.SetShowNamespace(enabled)
+}
+
+// fetchContexts returns a command that reads the contexts of a kubeconfig file
+func (a *App) fetchContexts(kubeconfigPath string, picker bool) tea.Cmd {
+	return func() tea.Msg {
+		contexts, err := k8s.ListContexts(kubeconfigPath)
+		return contextsResultMsg{contexts: contexts, picker: picker, err: err}
+	}
+}
+
 // connectToCluster returns a command that connects to the cluster
-func (a *App) connectToCluster(kubeconfigPath string) tea.Cmd {
+func (a *App) connectToCluster(kubeconfigPath, contextName string) tea.Cmd {
 	return func() tea.Msg {
-		client, err := k8s.NewClient(kubeconfigPath)
+		client, err := k8s.NewClient(kubeconfigPath, contextName)
 		if err != nil {
 			return connectResultMsg{err: err}
 		}
@@ -349,8 +1228,13 @@ func (a *App) connectToCluster(kubeconfigPath string) tea.Cmd {
 	}
 }
 
-// fetchNamespaces returns a command that fetches namespaces
+// fetchNamespaces returns a command that fetches namespaces.
+// Once the watch cache is synced the list is served from it directly.
 func (a *App) fetchNamespaces() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceNamespaces) {
+		_, cmd := a.handleNamespacesResult(namespacesResultMsg{namespaces: a.watcher.Namespaces()})
+		return cmd
+	}
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return namespacesResultMsg{err: fmt.Errorf("not connected to cluster")}
@@ -366,15 +1250,20 @@ func (a *App) fetchNamespaces() tea.Cmd {
 	}
 }
 
-// fetchPods returns a command that fetches pods
+// fetchPods returns a command that fetches pods.
+// Once the watch cache is synced the list is served from it directly.
 func (a *App) fetchPods() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourcePods) {
+		_, cmd := a.handlePodsResult(podsResultMsg{pods: a.watcher.Pods()})
+		return cmd
+	}
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return podsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		pods, err := a.k8sClient.GetPods(ctx, a.k8sClient.CurrentNamespace())
+		pods, err := a.k8sClient.GetPods(ctx, a.listNamespace())
 		if err != nil {
 			return podsResultMsg{err: err}
 		}
@@ -384,14 +1273,13 @@ func (a *App) fetchPods() tea.Cmd {
 }
 
 // fetchPodDetails returns a command that fetches pod details and events
-func (a *App) fetchPodDetails(podName string) tea.Cmd {
+func (a *App) fetchPodDetails(namespace, podName string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return podDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		namespace := a.k8sClient.CurrentNamespace()
 
 		pod, err := a.k8sClient.GetPod(ctx, namespace, podName)
 		if err != nil {
@@ -423,1285 +1311,1799 @@ func (a *App) scheduleEventRefresh() tea.Cmd {
 }
 
 // deletePod returns a command that deletes a pod
-func (a *App) deletePod(podName string) tea.Cmd {
+func (a *App) deletePod(namespace, podName string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return podDeleteResultMsg{podName: podName, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		err := a.k8sClient.DeletePod(ctx, a.k8sClient.CurrentNamespace(), podName)
+		err := a.k8sClient.DeletePod(ctx, namespace, podName)
 		return podDeleteResultMsg{podName: podName, err: err}
 	}
 }
 
 // restartPod returns a command that restarts a pod (by deleting it)
-func (a *App) restartPod(podName string) tea.Cmd {
+func (a *App) restartPod(namespace, podName string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return podRestartResultMsg{podName: podName, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		err := a.k8sClient.DeletePod(ctx, a.k8sClient.CurrentNamespace(), podName)
+		err := a.k8sClient.DeletePod(ctx, namespace, podName)
 		return podRestartResultMsg{podName: podName, err: err}
 	}
 }
 
-// fetchDeployments returns a command that fetches deployments
-func (a *App) fetchDeployments() tea.Cmd {
-	return func() tea.Msg {
-		if a.k8sClient == nil {
-			return deploymentsResultMsg{err: fmt.Errorf("not connected to cluster")}
-		}
+// execIntoPod suspends the TUI and opens an interactive shell in a pod container
+func (a *App) execIntoPod(namespace, podName, container string) tea.Cmd {
+	if a.k8sClient == nil {
+		return nil
+	}
 
-		ctx := context.Background()
-		deployments, err := a.k8sClient.GetDeployments(ctx, a.k8sClient.CurrentNamespace())
-		return deploymentsResultMsg{deployments: deployments, err: err}
+	client := a.k8sClient
+	session := func(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, resize <-chan domain.TerminalSize) error {
+		fmt.Fprintf(stdout, "Connecting to %s/%s (container %s), exit the shell to return to k4s...\r\n", namespace, podName, container)
+		return client.ExecShell(ctx, namespace, podName, k8s.ExecOptions{
+			Container: container,
+			Stdin:     stdin,
+			Stdout:    stdout,
+			Stderr:    stderr,
+			Resize:    resize,
+		})
 	}
+
+	return tea.Exec(newTerminalCommand(session), func(err error) tea.Msg {
+		return execFinishedMsg{podName: podName, container: container, err: err}
+	})
 }
 
-// fetchDeploymentDetails returns a command that fetches deployment details
-func (a *App) fetchDeploymentDetails(name string) tea.Cmd {
-	return func() tea.Msg {
-		if a.k8sClient == nil {
-			return deploymentDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
-		}
+// startExec opens a shell in the pod, asking for the container first if it has several
+func (a *App) startExec(pod *domain.Pod) tea.Cmd {
+	if pod == nil || len(pod.Containers) == 0 {
+		return nil
+	}
+	if len(pod.Containers) == 1 {
+		return a.execIntoPod(pod.Namespace, pod.Name, pod.Containers[0].Name)
+	}
+
+	names := make([]string, 0, len(pod.Containers))
+	for _, c := range pod.Containers {
+		names = append(names, c.Name)
+	}
+	a.execPod = pod
+	return a.containerSelector.Show(ContainerSelectExec, names, "")
+}
+
+// handleContainerSelected acts on the container chosen in the container selector
+func (a *App) handleContainerSelected(container string) tea.Cmd {
+	if a.containerSelector.Purpose() == ContainerSelectExec {
+		pod := a.execPod
+		a.execPod = nil
+		if pod == nil {
+			return nil
+		}
+		return a.execIntoPod(pod.Namespace, pod.Name, container)
+	}
+
+	a.logViewer.SetContainer(container)
+	a.stopLogStream()
+	a.loading = true
+	return a.fetchLogs(
+		a.logViewer.Namespace(),
+		a.logViewer.PodName(),
+		container,
+		a.logViewer.TailLines(),
+		a.logViewer.Timestamps(),
+	)
+}
+
+// fetchDeployments returns a command that fetches deployments.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchDeployments() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceDeployments) {
+		_, cmd := a.handleDeploymentsResult(deploymentsResultMsg{deployments: a.watcher.Deployments()})
+		return cmd
+	}
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return deploymentsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		deployments, err := a.k8sClient.GetDeployments(ctx, a.listNamespace())
+		return deploymentsResultMsg{deployments: deployments, err: err}
+	}
+}
+
+// fetchDeploymentDetails returns a command that fetches deployment details
+func (a *App) fetchDeploymentDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return deploymentDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
 		ctx := context.Background()
-		deployment, err := a.k8sClient.GetDeployment(ctx, a.k8sClient.CurrentNamespace(), name)
+		deployment, err := a.k8sClient.GetDeployment(ctx, namespace, name)
 		return deploymentDetailsResultMsg{deployment: deployment, err: err}
 	}
 }
 
 // scaleDeployment returns a command that scales a deployment
-func (a *App) scaleDeployment(name string, replicas int32) tea.Cmd {
+func (a *App) scaleDeployment(namespace, name string, replicas int32) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return deploymentScaleResultMsg{deploymentName: name, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		err := a.k8sClient.ScaleDeployment(ctx, a.k8sClient.CurrentNamespace(), name, replicas)
+		err := a.k8sClient.ScaleDeployment(ctx, namespace, name, replicas)
 		return deploymentScaleResultMsg{deploymentName: name, replicas: replicas, err: err}
 	}
 }
 
 // restartDeployment returns a command that restarts a deployment
-func (a *App) restartDeployment(name string) tea.Cmd {
+func (a *App) restartDeployment(namespace, name string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return deploymentRestartResultMsg{deploymentName: name, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		err := a.k8sClient.RestartDeployment(ctx, a.k8sClient.CurrentNamespace(), name)
+		err := a.k8sClient.RestartDeployment(ctx, namespace, name)
 		return deploymentRestartResultMsg{deploymentName: name, err: err}
 	}
 }
 
+// rollbackDeployment returns a command that rolls a deployment back to a revision
+func (a *App) rollbackDeployment(namespace, name string, revision int64) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return deploymentRollbackResultMsg{deploymentName: name, err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		err := a.k8sClient.RollbackDeployment(ctx, namespace, name, revision)
+		return deploymentRollbackResultMsg{deploymentName: name, revision: revision, err: err}
+	}
+}
+
+// setDeploymentPaused returns a command that pauses or resumes a rollout
+func (a *App) setDeploymentPaused(namespace, name string, paused bool) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return deploymentPauseResultMsg{deploymentName: name, err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		err := a.k8sClient.SetDeploymentPaused(ctx, namespace, name, paused)
+		return deploymentPauseResultMsg{deploymentName: name, paused: paused, err: err}
+	}
+}
+
+// scheduleRolloutRefresh returns a command that refreshes deployment details
+// after interval, unless a refresh is already scheduled
+func (a *App) scheduleRolloutRefresh() tea.Cmd {
+	if a.rolloutWatching {
+		return nil
+	}
+	a.rolloutWatching = true
+	return tea.Tick(rolloutRefreshInterval, func(t time.Time) tea.Msg {
+		return rolloutRefreshTickMsg{}
+	})
+}
+
+// showRevisionDiff diffs the pod template of the selected revision against
+// the marked revision, or the current one when none is marked
+func (a *App) showRevisionDiff() tea.Cmd {
+	dep := a.deploymentDetails.Deployment()
+	item, ok := a.revisionList.SelectedItem().(revisionItem)
+	if dep == nil || !ok {
+		return nil
+	}
+
+	var base *domain.DeploymentRevision
+	for i := range dep.History {
+		rev := &dep.History[i]
+		if (a.markedRevision != 0 && rev.Revision == a.markedRevision) || (a.markedRevision == 0 && rev.Current) {
+			base = rev
+			break
+		}
+	}
+	if base == nil || base.Revision == item.revision.Revision {
+		return a.notification.Show("Mark another revision with space to compare against", NotificationInfo)
+	}
+
+	diff := unifiedDiff(base.Template, item.revision.Template,
+		fmt.Sprintf("revision %d", base.Revision), fmt.Sprintf("revision %d", item.revision.Revision))
+	if diff == "" {
+		return a.notification.Show(fmt.Sprintf("Revisions %d and %d have the same pod template", base.Revision, item.revision.Revision), NotificationInfo)
+	}
+
+	a.diffViewer.SetDiff(fmt.Sprintf("Pod template: revision %d → %d", base.Revision, item.revision.Revision), "", diff)
+	a.viewState = ViewRevisionDiff
+	return nil
+}
+
 // deleteDeployment returns a command that deletes a deployment
-func (a *App) deleteDeployment(name string) tea.Cmd {
+func (a *App) deleteDeployment(namespace, name string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
 			return deploymentDeleteResultMsg{deploymentName: name, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		err := a.k8sClient.DeleteDeployment(ctx, a.k8sClient.CurrentNamespace(), name)
+		err := a.k8sClient.DeleteDeployment(ctx, namespace, name)
 		return deploymentDeleteResultMsg{deploymentName: name, err: err}
 	}
 }
 
-// fetchServices returns a command that fetches services
-func (a *App) fetchServices() tea.Cmd {
+// runConfirmedAction performs an action accepted in the confirmation dialog
+func (a *App) runConfirmedAction(action ConfirmAction, namespace, name string) tea.Cmd {
+	switch action {
+	case ConfirmActionDeletePod:
+		return a.deletePod(namespace, name)
+	case ConfirmActionRestartPod:
+		return a.restartPod(namespace, name)
+	case ConfirmActionDeleteDeployment:
+		return a.deleteDeployment(namespace, name)
+	case ConfirmActionRestartDeployment:
+		return a.restartDeployment(namespace, name)
+	case ConfirmActionRestartStatefulSet:
+		return a.restartStatefulSet(namespace, name)
+	case ConfirmActionRestartDaemonSet:
+		return a.restartDaemonSet(namespace, name)
+	case ConfirmActionTriggerCronJob:
+		return a.triggerCronJob(namespace, name)
+	case ConfirmActionApplyEdit:
+		return a.applyEdit()
+	case ConfirmActionDeleteResource:
+		return a.deleteResource(a.selectedResource.QualifiedKind(), namespace, name)
+	case ConfirmActionRollbackDeployment:
+		return a.rollbackDeployment(namespace, name, a.rollbackRevision)
+	case ConfirmActionStopContainer, ConfirmActionRemoveContainer, ConfirmActionRemoveImage,
+		ConfirmActionPruneImages, ConfirmActionRemoveSandbox, ConfirmActionPruneSandboxes,
+		ConfirmActionDeleteSnapshot, ConfirmActionPruneSnapshots:
+		return a.runCrictlAction(action, a.crictlAction)
+	}
+	return nil
+}
+
+// runScale scales the workload confirmed in the scale dialog
+func (a *App) runScale(kind, namespace, name string, replicas int32) tea.Cmd {
+	if kind == "StatefulSet" {
+		return a.scaleStatefulSet(namespace, name, replicas)
+	}
+	return a.scaleDeployment(namespace, name, replicas)
+}
+
+// fetchStatefulSets returns a command that fetches statefulsets.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchStatefulSets() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceStatefulSets) {
+		_, cmd := a.handleStatefulSetsResult(statefulSetsResultMsg{statefulSets: a.watcher.StatefulSets()})
+		return cmd
+	}
 	return func() tea.Msg {
 		if a.k8sClient == nil {
-			return servicesResultMsg{err: fmt.Errorf("not connected to cluster")}
+			return statefulSetsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		services, err := a.k8sClient.GetServices(ctx, a.k8sClient.CurrentNamespace())
-		return servicesResultMsg{services: services, err: err}
+		statefulSets, err := a.k8sClient.GetStatefulSets(ctx, a.listNamespace())
+		return statefulSetsResultMsg{statefulSets: statefulSets, err: err}
 	}
 }
 
-// fetchServiceDetails returns a command that fetches service details
-func (a *App) fetchServiceDetails(name string) tea.Cmd {
+// fetchStatefulSetDetails returns a command that fetches statefulset details
+func (a *App) fetchStatefulSetDetails(namespace, name string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
-			return serviceDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+			return statefulSetDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		service, err := a.k8sClient.GetService(ctx, a.k8sClient.CurrentNamespace(), name)
-		return serviceDetailsResultMsg{service: service, err: err}
+		statefulSet, err := a.k8sClient.GetStatefulSet(ctx, namespace, name)
+		return statefulSetDetailsResultMsg{statefulSet: statefulSet, err: err}
 	}
 }
 
-// fetchEvents returns a command that fetches events
-func (a *App) fetchEvents() tea.Cmd {
+// scaleStatefulSet returns a command that scales a statefulset
+func (a *App) scaleStatefulSet(namespace, name string, replicas int32) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
-			return eventsResultMsg{err: fmt.Errorf("not connected to cluster")}
+			return statefulSetScaleResultMsg{statefulSetName: name, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		// Fetch all events - filtering is done in the viewer for better UX
-		events, err := a.k8sClient.GetEvents(ctx, a.k8sClient.CurrentNamespace())
-		return eventsResultMsg{events: events, err: err}
+		err := a.k8sClient.ScaleStatefulSet(ctx, namespace, name, replicas)
+		return statefulSetScaleResultMsg{statefulSetName: name, replicas: replicas, err: err}
 	}
 }
 
-// fetchMetrics returns a command that fetches pod metrics
-func (a *App) fetchMetrics() tea.Cmd {
+// restartStatefulSet returns a command that restarts a statefulset
+func (a *App) restartStatefulSet(namespace, name string) tea.Cmd {
 	return func() tea.Msg {
-		if a.metricsClient == nil {
-			return metricsResultMsg{err: fmt.Errorf("metrics not available")}
+		if a.k8sClient == nil {
+			return statefulSetRestartResultMsg{statefulSetName: name, err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		metrics, err := a.metricsClient.GetPodMetrics(ctx, a.k8sClient.CurrentNamespace())
-		return metricsResultMsg{metrics: metrics, err: err}
+		err := a.k8sClient.RestartStatefulSet(ctx, namespace, name)
+		return statefulSetRestartResultMsg{statefulSetName: name, err: err}
 	}
 }
 
-// fetchContainers returns a command that fetches container names for a pod
-func (a *App) fetchContainers(podName string) tea.Cmd {
-	logger.Debug("fetchContainers called", "pod", podName)
+// fetchDaemonSets returns a command that fetches daemonsets.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchDaemonSets() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceDaemonSets) {
+		_, cmd := a.handleDaemonSetsResult(daemonSetsResultMsg{daemonSets: a.watcher.DaemonSets()})
+		return cmd
+	}
 	return func() tea.Msg {
 		if a.k8sClient == nil {
-			logger.Error("fetchContainers: k8sClient is nil")
-			return containersResultMsg{err: fmt.Errorf("not connected to cluster")}
+			return daemonSetsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		logger.Debug("Calling GetPodContainers", "pod", podName, "namespace", a.k8sClient.CurrentNamespace())
-		containers, err := a.k8sClient.GetPodContainers(ctx, a.k8sClient.CurrentNamespace(), podName)
-		if err != nil {
-			logger.Error("GetPodContainers failed", "err", err)
-		} else {
-			logger.Debug("GetPodContainers returned containers", "count", len(containers))
-		}
-		return containersResultMsg{containers: containers, err: err}
+		daemonSets, err := a.k8sClient.GetDaemonSets(ctx, a.listNamespace())
+		return daemonSetsResultMsg{daemonSets: daemonSets, err: err}
 	}
 }
 
-// fetchLogs returns a command that fetches logs for a pod
-func (a *App) fetchLogs(podName, container string, tailLines int64, timestamps bool) tea.Cmd {
+// fetchDaemonSetDetails returns a command that fetches daemonset details
+// including the per-node pods and their update status
+func (a *App) fetchDaemonSetDetails(namespace, name string) tea.Cmd {
 	return func() tea.Msg {
 		if a.k8sClient == nil {
-			return logsResultMsg{err: fmt.Errorf("not connected to cluster")}
+			return daemonSetDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
 		ctx := context.Background()
-		opts := k8s.LogOptions{
-			Container:  container,
-			TailLines:  tailLines,
-			Timestamps: timestamps,
-			Follow:     false,
+		daemonSet, err := a.k8sClient.GetDaemonSet(ctx, namespace, name)
+		return daemonSetDetailsResultMsg{daemonSet: daemonSet, err: err}
+	}
+}
+
+// restartDaemonSet returns a command that restarts a daemonset
+func (a *App) restartDaemonSet(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return daemonSetRestartResultMsg{daemonSetName: name, err: fmt.Errorf("not connected to cluster")}
 		}
 
-		logs, err := a.k8sClient.GetPodLogs(ctx, a.k8sClient.CurrentNamespace(), podName, opts)
-		return logsResultMsg{logs: logs, err: err}
+		ctx := context.Background()
+		err := a.k8sClient.RestartDaemonSet(ctx, namespace, name)
+		return daemonSetRestartResultMsg{daemonSetName: name, err: err}
 	}
 }
 
-// stopLogStream stops the current log stream
-func (a *App) stopLogStream() {
-	if a.logStreamCancel != nil {
-		a.logStreamCancel()
-		a.logStreamCancel = nil
+// fetchReplicaSets returns a command that fetches replicasets.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchReplicaSets() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceReplicaSets) {
+		_, cmd := a.handleReplicaSetsResult(replicaSetsResultMsg{replicaSets: a.watcher.ReplicaSets()})
+		return cmd
+	}
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return replicaSetsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		replicaSets, err := a.k8sClient.GetReplicaSets(ctx, a.listNamespace())
+		return replicaSetsResultMsg{replicaSets: replicaSets, err: err}
 	}
-	a.logStreamActive = false
 }
 
-// Update implements tea.Model
-func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		return a.handleKeyMsg(msg)
+// fetchReplicaSetDetails returns a command that fetches replicaset details
+func (a *App) fetchReplicaSetDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return replicaSetDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case tea.WindowSizeMsg:
-		a.width = msg.Width
-		a.height = msg.Height
-		a.ready = true
+		ctx := context.Background()
+		replicaSet, err := a.k8sClient.GetReplicaSet(ctx, namespace, name)
+		return replicaSetDetailsResultMsg{replicaSet: replicaSet, err: err}
+	}
+}
 
-		// Calculate sidebar dimensions
-		if a.width >= sidebarMinWidth {
-			a.showSidebar = true
-			a.sidebarWidth = a.width / 4
-			if a.sidebarWidth < 24 {
-				a.sidebarWidth = 24
-			}
-			if a.sidebarWidth > 30 {
-				a.sidebarWidth = 30
-			}
-			a.contentWidth = a.width - 4 - a.sidebarWidth
-		} else {
-			a.showSidebar = false
-			a.sidebarWidth = 0
-			a.contentWidth = a.width - 4
+// fetchJobs returns a command that fetches jobs.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchJobs() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceJobs) {
+		_, cmd := a.handleJobsResult(jobsResultMsg{jobs: a.watcher.Jobs()})
+		return cmd
+	}
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return jobsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
-		cw := a.contentWidth
-		// When sidebar is shown, content has more vertical space (no header)
-		listH := a.height - 10
-		viewH := a.height - 8
-		logH := a.height - 10
-		if a.showSidebar {
-			listH = a.height - 8
-			viewH = a.height - 6
-			logH = a.height - 6
+		ctx := context.Background()
+		jobs, err := a.k8sClient.GetJobs(ctx, a.listNamespace())
+		return jobsResultMsg{jobs: jobs, err: err}
+	}
+}
+
+// fetchJobDetails returns a command that fetches job details including its pods
+func (a *App) fetchJobDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return jobDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
 
-		a.kubeConfigList = newKubeConfigList(
-			a.config.KubeConfigs,
-			cw,
-			listH,
-			a.styles,
-		)
-		a.namespaceList = newNamespaceList(nil, cw, listH, a.styles)
-		a.podList = newPodList(nil, cw, listH, a.styles, a.metricsEnabled, a.podMetrics)
-		a.podDetails.SetSize(cw, viewH)
-		a.logViewer.SetSize(cw, logH)
-		a.confirmDialog.SetWidth(a.width)
-		a.notification.SetWidth(a.width)
-		a.containerSelector.SetWidth(a.width)
-		a.sshHostList = newSSHHostList(a.config.SSHHosts, cw, listH, a.styles)
-		a.crictlContainerList = newCrictlContainerList(nil, cw, listH, a.styles)
-		a.passphraseInput.SetWidth(a.width)
-		a.crictlLogViewer.SetSize(cw, logH)
-		a.helpScreen.SetSize(a.width, a.height)
-		a.deploymentList = newDeploymentList(nil, cw, listH, a.styles)
-		a.deploymentDetails.SetSize(cw, viewH)
-		a.serviceList = newServiceList(nil, cw, listH, a.styles)
-		a.serviceDetails.SetSize(cw, viewH)
-		a.eventViewer.SetSize(cw, logH)
-		a.scaleDialog.SetWidth(a.width)
-		a.podMultiSelector.SetWidth(a.width)
-		a.multiPodLogViewer.SetSize(cw, logH)
-		return a, nil
+		ctx := context.Background()
+		job, err := a.k8sClient.GetJob(ctx, namespace, name)
+		return jobDetailsResultMsg{job: job, err: err}
+	}
+}
 
-	case connectResultMsg:
-		return a.handleConnectResult(msg)
+// fetchCronJobs returns a command that fetches cronjobs.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchCronJobs() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceCronJobs) {
+		_, cmd := a.handleCronJobsResult(cronJobsResultMsg{cronJobs: a.watcher.CronJobs()})
+		return cmd
+	}
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return cronJobsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case namespacesResultMsg:
-		return a.handleNamespacesResult(msg)
+		ctx := context.Background()
+		cronJobs, err := a.k8sClient.GetCronJobs(ctx, a.listNamespace())
+		return cronJobsResultMsg{cronJobs: cronJobs, err: err}
+	}
+}
 
-	case podsResultMsg:
-		return a.handlePodsResult(msg)
+// fetchCronJobDetails returns a command that fetches cronjob details including its jobs
+func (a *App) fetchCronJobDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return cronJobDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case podDetailsResultMsg:
-		return a.handlePodDetailsResult(msg)
+		ctx := context.Background()
+		cronJob, err := a.k8sClient.GetCronJob(ctx, namespace, name)
+		return cronJobDetailsResultMsg{cronJob: cronJob, err: err}
+	}
+}
 
-	case podRefreshTickMsg:
-		// Only refresh if we're on the pods view, dialog is not visible, and not filtering
-		if a.viewState == ViewPods && a.k8sClient != nil && !a.confirmDialog.IsVisible() && !a.podList.SettingFilter() && a.podList.FilterState() == list.Unfiltered {
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-		}
-		// Reschedule even if we skip refresh (to check again later)
-		if a.viewState == ViewPods && a.k8sClient != nil {
-			return a, a.schedulePodRefresh()
+// triggerCronJob returns a command that creates a job from a cronjob right away
+func (a *App) triggerCronJob(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return cronJobTriggerResultMsg{cronJobName: name, err: fmt.Errorf("not connected to cluster")}
 		}
-		return a, nil
 
-	case eventRefreshTickMsg:
-		// Only refresh if we're on the events view and following
-		if a.viewState == ViewEvents && a.k8sClient != nil && a.eventViewer.IsFollowing() {
-			return a, tea.Batch(a.fetchEvents(), a.scheduleEventRefresh())
-		}
-		// Reschedule even if we skip refresh (to check again later when following is re-enabled)
-		if a.viewState == ViewEvents && a.k8sClient != nil {
-			return a, a.scheduleEventRefresh()
-		}
-		return a, nil
+		ctx := context.Background()
+		jobName, err := a.k8sClient.TriggerCronJob(ctx, namespace, name)
+		return cronJobTriggerResultMsg{cronJobName: name, jobName: jobName, err: err}
+	}
+}
 
-	case podDeleteResultMsg:
-		return a.handlePodDeleteResult(msg)
+// setCronJobSuspend returns a command that suspends or resumes a cronjob
+func (a *App) setCronJobSuspend(namespace, name string, suspend bool) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return cronJobSuspendResultMsg{cronJobName: name, err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case podRestartResultMsg:
-		return a.handlePodRestartResult(msg)
+		ctx := context.Background()
+		err := a.k8sClient.SetCronJobSuspend(ctx, namespace, name, suspend)
+		return cronJobSuspendResultMsg{cronJobName: name, suspend: suspend, err: err}
+	}
+}
 
-	case notificationExpiredMsg:
-		a.notification.Hide()
-		return a, nil
+// fetchNodes returns a command that fetches nodes with their allocated resources
+func (a *App) fetchNodes() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return nodesResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case containersResultMsg:
-		return a.handleContainersResult(msg)
+		ctx := context.Background()
+		nodes, err := a.k8sClient.GetNodes(ctx)
+		return nodesResultMsg{nodes: nodes, err: err}
+	}
+}
 
-	case logsResultMsg:
-		return a.handleLogsResult(msg)
+// fetchNodeDetails returns a command that fetches node details including its pods
+func (a *App) fetchNodeDetails(name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return nodeDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case logLineMsg:
-		return a.handleLogLine(msg)
+		ctx := context.Background()
+		node, err := a.k8sClient.GetNode(ctx, name)
+		return nodeDetailsResultMsg{node: node, err: err}
+	}
+}
 
-	case logStreamEndedMsg:
-		return a.handleLogStreamEnded(msg)
+// setNodeUnschedulable returns a command that cordons or uncordons a node
+func (a *App) setNodeUnschedulable(name string, cordon bool) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return nodeCordonResultMsg{nodeName: name, err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case multiPodLogLineMsg:
-		return a.handleMultiPodLogLine(msg)
+		ctx := context.Background()
+		err := a.k8sClient.SetNodeUnschedulable(ctx, name, cordon)
+		return nodeCordonResultMsg{nodeName: name, cordon: cordon, err: err}
+	}
+}
 
-	case multiPodLogStreamEndedMsg:
-		return a.handleMultiPodLogStreamEnded(msg)
+// openNodeContainers looks up the SSH host of a node and opens the node's
+// crictl containers, selecting the containers of focus when set
+func (a *App) openNodeContainers(nodeName string, focus *crictlFocus) tea.Cmd {
+	if len(a.config.SSHHosts) == 0 {
+		return a.notification.Show("No SSH hosts configured", NotificationWarning)
+	}
+	from := a.viewState
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return nodeContainersMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	case sshConnectResultMsg:
-		return a.handleSSHConnectResult(msg)
+		ctx := context.Background()
+		nodes, err := a.k8sClient.GetNodes(ctx)
+		return nodeContainersMsg{nodeName: nodeName, nodes: nodes, focus: focus, from: from, err: err}
+	}
+}
 
-	case sshCrictlContainersMsg:
-		return a.handleSSHCrictlContainers(msg)
+// startDrain switches to the drain view and drains a node in the background.
+// Progress arrives through the same subscription pattern as log streaming.
+func (a *App) startDrain(node string, opts domain.DrainOptions) tea.Cmd {
+	client := a.k8sClient
+	if client == nil {
+		return nil
+	}
 
-	case sshNodeInfoMsg:
-		return a.handleSSHNodeInfo(msg)
+	a.stopDrain()
+	ctx, cancel := context.WithCancel(context.Background())
+	a.drainCancel = cancel
 
-	case sshCrictlLogsMsg:
-		return a.handleCrictlLogsResult(msg)
+	events := make(chan domain.DrainEvent, 100)
+	result := make(chan error, 1)
+	a.drainEvents = events
+	a.drainResult = result
 
-	case sshCrictlLogLineMsg:
-		return a.handleCrictlLogLine(msg)
+	go func() {
+		defer close(events)
+		result <- client.DrainNode(ctx, node, opts, events)
+	}()
 
-	case sshCrictlLogStreamEndedMsg:
-		return a.handleCrictlLogStreamEnded(msg)
+	a.drainSourceView = a.viewState
+	a.drainProgress.Start(node, opts)
+	a.viewState = ViewNodeDrain
+	return a.waitForDrainEvent(events, result)
+}
 
-	// Deployment messages
-	case deploymentsResultMsg:
-		return a.handleDeploymentsResult(msg)
+// waitForDrainEvent returns a command that waits for the next drain event
+func (a *App) waitForDrainEvent(events <-chan domain.DrainEvent, result <-chan error) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-events
+		if !ok {
+			return drainFinishedMsg{err: <-result}
+		}
+		return drainEventMsg{event: ev}
+	}
+}
 
-	case deploymentDetailsResultMsg:
-		return a.handleDeploymentDetailsResult(msg)
+// stopDrain cancels a running drain. Pods already evicted stay evicted and
+// the node stays cordoned.
+func (a *App) stopDrain() {
+	if a.drainCancel != nil {
+		a.drainCancel()
+		a.drainCancel = nil
+	}
+}
 
-	case deploymentScaleResultMsg:
-		return a.handleDeploymentScaleResult(msg)
+// detailsTarget returns the resource shown in the current details view, or
+// the one selected in a list that has no details view
+func (a *App) detailsTarget() (kind, namespace, name string, ok bool) {
+	switch a.viewState {
+	case ViewPodDetails:
+		return "Pod", a.selectedPodNamespace, a.selectedPodName, a.selectedPodName != ""
+	case ViewDeploymentDetails:
+		return "Deployment", a.selectedDeployNamespace, a.selectedDeployName, a.selectedDeployName != ""
+	case ViewServiceDetails:
+		return "Service", a.selectedServiceNamespace, a.selectedServiceName, a.selectedServiceName != ""
+	case ViewStatefulSetDetails:
+		return "StatefulSet", a.selectedStatefulSetNamespace, a.selectedStatefulSetName, a.selectedStatefulSetName != ""
+	case ViewDaemonSetDetails:
+		return "DaemonSet", a.selectedDaemonSetNamespace, a.selectedDaemonSetName, a.selectedDaemonSetName != ""
+	case ViewReplicaSetDetails:
+		return "ReplicaSet", a.selectedReplicaSetNamespace, a.selectedReplicaSetName, a.selectedReplicaSetName != ""
+	case ViewJobDetails:
+		return "Job", a.selectedJobNamespace, a.selectedJobName, a.selectedJobName != ""
+	case ViewCronJobDetails:
+		return "CronJob", a.selectedCronJobNamespace, a.selectedCronJobName, a.selectedCronJobName != ""
+	case ViewNodeDetails:
+		return "Node", "", a.selectedNodeName, a.selectedNodeName != ""
+	case ViewConfigMapDetails:
+		return "ConfigMap", a.selectedConfigMapNamespace, a.selectedConfigMapName, a.selectedConfigMapName != ""
+	case ViewSecretDetails:
+		return "Secret", a.selectedSecretNamespace, a.selectedSecretName, a.selectedSecretName != ""
+	case ViewIngressDetails:
+		return "Ingress", a.selectedIngressNamespace, a.selectedIngressName, a.selectedIngressName != ""
+	case ViewIngressRouteDetails:
+		return "IngressRoute", a.selectedIngressRouteNamespace, a.selectedIngressRouteName, a.selectedIngressRouteName != ""
+	case ViewPVCDetails:
+		return "PersistentVolumeClaim", a.selectedPVCNamespace, a.selectedPVCName, a.selectedPVCName != ""
+	case ViewPVDetails:
+		return "PersistentVolume", "", a.selectedPVName, a.selectedPVName != ""
+	case ViewStorageClasses:
+		if item, ok := a.storageClassList.SelectedItem().(storageClassItem); ok {
+			return "StorageClass", "", item.class.Name, true
+		}
+	case ViewResources:
+		if item, ok := a.resourceList.SelectedItem().(resourceItem); ok {
+			return a.selectedResource.QualifiedKind(), item.object.Namespace, item.object.Name, true
+		}
+	case ViewHelmChartDetails:
+		return "HelmChart", a.selectedHelmChartNamespace, a.selectedHelmChartName, a.selectedHelmChartName != ""
+	}
+	return "", "", "", false
+}
 
-	case deploymentRestartResultMsg:
-		return a.handleDeploymentRestartResult(msg)
+// openYAML switches to the YAML viewer for the resource of the current details view
+func (a *App) openYAML() tea.Cmd {
+	kind, namespace, name, ok := a.detailsTarget()
+	if !ok {
+		return nil
+	}
+	a.yamlSourceView = a.viewState
+	a.yamlViewer.SetResource(kind, namespace, name)
+	a.viewState = ViewYAML
+	a.loading = true
+	return a.fetchResourceYAML()
+}
 
-	case deploymentDeleteResultMsg:
-		return a.handleDeploymentDeleteResult(msg)
+// fetchResourceYAML returns a command that fetches the live object shown in the YAML viewer
+func (a *App) fetchResourceYAML() tea.Cmd {
+	kind := a.yamlViewer.Kind()
+	namespace := a.yamlViewer.Namespace()
+	name := a.yamlViewer.Name()
+	showManagedFields := a.yamlViewer.ShowManagedFields()
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return resourceYAMLResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	// Service messages
-	case servicesResultMsg:
-		return a.handleServicesResult(msg)
+		ctx := context.Background()
+		content, err := a.k8sClient.GetResourceYAML(ctx, kind, namespace, name, showManagedFields)
+		return resourceYAMLResultMsg{yaml: content, err: err}
+	}
+}
 
-	case serviceDetailsResultMsg:
-		return a.handleServiceDetailsResult(msg)
+// startEdit fetches the live object and opens it in $EDITOR
+func (a *App) startEdit(kind, namespace, name string) tea.Cmd {
+	returnView := a.viewState
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return editFetchedMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	// Event messages
-	case eventsResultMsg:
-		return a.handleEventsResult(msg)
+		ctx := context.Background()
+		content, err := a.k8sClient.GetResourceYAML(ctx, kind, namespace, name, false)
+		return editFetchedMsg{kind: kind, namespace: namespace, name: name, yaml: content, returnView: returnView, err: err}
+	}
+}
 
-	// Metrics messages
-	case metricsResultMsg:
-		return a.handleMetricsResult(msg)
+// startKeyEdit opens the value of the selected ConfigMap or Secret key in
+// $EDITOR. The write fails if the object changed since it was shown.
+func (a *App) startKeyEdit() tea.Cmd {
+	item, ok := a.configData.Selected()
+	if !ok {
+		return nil
+	}
+	if item.Binary {
+		return a.notification.Show(fmt.Sprintf("Key '%s' holds binary data and cannot be edited", item.Key), NotificationWarning)
+	}
 
-	case spinner.TickMsg:
-		var cmd tea.Cmd
-		a.spinner, cmd = a.spinner.Update(msg)
-		return a, cmd
+	edit, err := newKeyEditSession(a.configData.Kind(), a.configData.Namespace(), a.configData.Name(),
+		item.Key, string(item.Value), a.configData.ResourceVersion(), a.viewState)
+	if err != nil {
+		return a.notification.Show(fmt.Sprintf("Edit failed: %v", err), NotificationError)
 	}
+	a.edit = edit
+	return a.openEditor()
+}
 
-	// Route non-key messages to visible dialogs (huh forms produce internal
-	// commands like nextGroupMsg that need to flow back through Update).
-	if a.confirmDialog.IsVisible() {
-		confirmed, cancelled, cmd := a.confirmDialog.Update(msg)
-		if confirmed {
-			action := a.confirmDialog.Action()
-			targetName := a.confirmDialog.TargetName()
-			a.confirmDialog.Hide()
-			switch action {
-			case ConfirmActionDeletePod:
-				return a, a.deletePod(targetName)
-			case ConfirmActionRestartPod:
-				return a, a.restartPod(targetName)
-			case ConfirmActionDeleteDeployment:
-				return a, a.deleteDeployment(targetName)
-			case ConfirmActionRestartDeployment:
-				return a, a.restartDeployment(targetName)
-			}
+// startHelmValuesEdit opens the valuesContent of a HelmChart, or with config
+// of its HelmChartConfig, in $EDITOR. Saving a new HelmChartConfig creates it.
+// helm-controller upgrades the release when the values change.
+func (a *App) startHelmValuesEdit(chart *domain.HelmChart, config bool) tea.Cmd {
+	kind, values, version := "HelmChart", chart.ValuesContent, chart.ResourceVersion
+	if config {
+		kind, values, version = "HelmChartConfig", "", ""
+		if chart.Config != nil {
+			values, version = chart.Config.ValuesContent, chart.Config.ResourceVersion
 		}
-		if cancelled {
-			a.confirmDialog.Hide()
-		}
-		return a, cmd
+	} else if chart.Addon {
+		return a.notification.Show(fmt.Sprintf("k3s reverts edits to chart '%s'; press V to edit its HelmChartConfig", chart.Name), NotificationWarning)
 	}
-	if a.scaleDialog.IsVisible() {
-		confirmed, cancelled, cmd := a.scaleDialog.Update(msg)
-		if confirmed {
-			deployName := a.scaleDialog.DeploymentName()
-			replicas := a.scaleDialog.TargetReplicas()
-			a.scaleDialog.Hide()
-			return a, a.scaleDeployment(deployName, replicas)
+
+	edit, err := newKeyEditSession(kind, chart.Namespace, chart.Name, "valuesContent", values, version, a.viewState)
+	if err != nil {
+		return a.notification.Show(fmt.Sprintf("Edit failed: %v", err), NotificationError)
+	}
+	a.edit = edit
+	return a.openEditor()
+}
+
+// setDataKey writes the value of the edited key, or only validates it with dryRun
+func (a *App) setDataKey(ctx context.Context, edit *editSession, dryRun bool) error {
+	switch edit.kind {
+	case "Secret":
+		return a.k8sClient.SetSecretKey(ctx, edit.namespace, edit.name, edit.key, edit.edited, edit.version, dryRun)
+	case "HelmChart", "HelmChartConfig":
+		return a.k8sClient.SetHelmChartValues(ctx, edit.kind, edit.namespace, edit.name, edit.edited, edit.version, dryRun)
+	}
+	return a.k8sClient.SetConfigMapKey(ctx, edit.namespace, edit.name, edit.key, edit.edited, edit.version, dryRun)
+}
+
+// openEditor suspends the program and runs $EDITOR on the edit's temp file
+func (a *App) openEditor() tea.Cmd {
+	return tea.ExecProcess(a.edit.editorCommand(), func(err error) tea.Msg {
+		return editorFinishedMsg{err: err}
+	})
+}
+
+// dryRunEdit returns a command that validates the edit with a server-side dry-run
+func (a *App) dryRunEdit() tea.Cmd {
+	edit := a.edit
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return editDryRunResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-		if cancelled {
-			a.scaleDialog.Hide()
+
+		ctx := context.Background()
+		if edit.isKeyEdit() {
+			// The diff is of the value itself
+			err := a.setDataKey(ctx, edit, true)
+			return editDryRunResultMsg{result: edit.edited, err: err}
 		}
-		return a, cmd
+		result, err := a.k8sClient.DryRunResourceYAML(ctx, edit.kind, edit.namespace, edit.name, edit.edited)
+		return editDryRunResultMsg{result: result, err: err}
 	}
-	if a.containerSelector.IsVisible() {
-		selected, cancelled, cmd := a.containerSelector.Update(msg)
-		if selected {
-			container := a.containerSelector.SelectedContainer()
-			a.containerSelector.Hide()
-			a.logViewer.SetContainer(container)
-			a.stopLogStream()
-			a.loading = true
-			return a, a.fetchLogs(
-				a.logViewer.PodName(),
-				container,
-				a.logViewer.TailLines(),
-				a.logViewer.Timestamps(),
-			)
+}
+
+// applyEdit returns a command that applies the confirmed edit
+func (a *App) applyEdit() tea.Cmd {
+	if a.edit == nil {
+		return nil
+	}
+	edit := a.edit
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return editApplyResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-		if cancelled {
-			a.containerSelector.Hide()
+
+		ctx := context.Background()
+		if edit.isKeyEdit() {
+			return editApplyResultMsg{err: a.setDataKey(ctx, edit, false)}
 		}
-		return a, cmd
+		err := a.k8sClient.ApplyResourceYAML(ctx, edit.kind, edit.namespace, edit.name, edit.edited)
+		return editApplyResultMsg{err: err}
 	}
-	if a.podMultiSelector.IsVisible() {
-		confirmed, cancelled, cmd := a.podMultiSelector.Update(msg)
-		if confirmed {
-			selectedPods := a.podMultiSelector.SelectedPods()
-			a.podMultiSelector.Hide()
-			if len(selectedPods) > 0 {
-				return a, a.startMultiPodStreaming(selectedPods)
-			}
+}
+
+// reopenEdit puts a rejected edit back into the editor with the error on top.
+// A key value has no room for comments, so a rejected key edit ends instead.
+func (a *App) reopenEdit(err error) tea.Cmd {
+	if a.edit.isKeyEdit() {
+		key := a.edit.key
+		a.finishEdit()
+		return a.notification.Show(fmt.Sprintf("Failed to update key '%s': %v", key, err), NotificationError)
+	}
+	if werr := a.edit.reopenWithError(err); werr != nil {
+		a.finishEdit()
+		return a.notification.Show(fmt.Sprintf("Edit failed: %v", werr), NotificationError)
+	}
+	return a.openEditor()
+}
+
+// finishEdit removes the edit's temp file and leaves the diff view
+func (a *App) finishEdit() {
+	if a.edit == nil {
+		return
+	}
+	if a.viewState == ViewEditDiff {
+		a.viewState = a.edit.returnView
+	}
+	a.edit.cleanup()
+	a.edit = nil
+}
+
+// reloadView refetches the contents of a details or YAML view
+func (a *App) reloadView(view ViewState) tea.Cmd {
+	switch view {
+	case ViewPodDetails:
+		return a.fetchPodDetails(a.selectedPodNamespace, a.selectedPodName)
+	case ViewDeploymentDetails:
+		return a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName)
+	case ViewServiceDetails:
+		return a.fetchServiceDetails(a.selectedServiceNamespace, a.selectedServiceName)
+	case ViewStatefulSetDetails:
+		return a.fetchStatefulSetDetails(a.selectedStatefulSetNamespace, a.selectedStatefulSetName)
+	case ViewDaemonSetDetails:
+		return a.fetchDaemonSetDetails(a.selectedDaemonSetNamespace, a.selectedDaemonSetName)
+	case ViewReplicaSetDetails:
+		return a.fetchReplicaSetDetails(a.selectedReplicaSetNamespace, a.selectedReplicaSetName)
+	case ViewJobDetails:
+		return a.fetchJobDetails(a.selectedJobNamespace, a.selectedJobName)
+	case ViewCronJobDetails:
+		return a.fetchCronJobDetails(a.selectedCronJobNamespace, a.selectedCronJobName)
+	case ViewNodeDetails:
+		return a.fetchNodeDetails(a.selectedNodeName)
+	case ViewConfigMapDetails:
+		return a.fetchConfigMapDetails(a.selectedConfigMapNamespace, a.selectedConfigMapName)
+	case ViewSecretDetails:
+		return a.fetchSecretDetails(a.selectedSecretNamespace, a.selectedSecretName)
+	case ViewIngressDetails:
+		return a.fetchIngressDetails(a.selectedIngressNamespace, a.selectedIngressName)
+	case ViewIngressRouteDetails:
+		return a.fetchIngressRouteDetails(a.selectedIngressRouteNamespace, a.selectedIngressRouteName)
+	case ViewPVCDetails:
+		return a.fetchPVCDetails(a.selectedPVCNamespace, a.selectedPVCName)
+	case ViewPVDetails:
+		return a.fetchPVDetails(a.selectedPVName)
+	case ViewStorageClasses:
+		return a.fetchStorageClasses()
+	case ViewAPIResources:
+		return a.fetchAPIResources("")
+	case ViewResources:
+		return a.fetchResources()
+	case ViewHelmCharts:
+		return a.fetchHelmCharts()
+	case ViewHelmChartDetails:
+		return a.fetchHelmChartDetails(a.selectedHelmChartNamespace, a.selectedHelmChartName)
+	case ViewYAML:
+		return a.fetchResourceYAML()
+	}
+	return nil
+}
+
+// fetchServices returns a command that fetches services.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchServices() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceServices) {
+		_, cmd := a.handleServicesResult(servicesResultMsg{services: a.watcher.Services()})
+		return cmd
+	}
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return servicesResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-		if cancelled {
-			a.podMultiSelector.Hide()
+
+		ctx := context.Background()
+		services, err := a.k8sClient.GetServices(ctx, a.listNamespace())
+		return servicesResultMsg{services: services, err: err}
+	}
+}
+
+// fetchServiceDetails returns a command that fetches service details
+func (a *App) fetchServiceDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return serviceDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-		return a, cmd
+
+		ctx := context.Background()
+		service, err := a.k8sClient.GetService(ctx, namespace, name)
+		return serviceDetailsResultMsg{service: service, err: err}
 	}
-	if a.passphraseInput.IsVisible() {
-		passphrase, submitted, cancelled, cmd := a.passphraseInput.Update(msg)
-		if submitted {
-			a.passphraseInput.Hide()
-			if a.sshClient != nil && a.selectedSSHHost != nil {
-				a.sshClient.SetPassphrase(passphrase)
-				a.loading = true
-				return a, a.retrySSHConnection()
-			}
+}
+
+// fetchIngresses returns a command that fetches ingresses
+func (a *App) fetchIngresses() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return ingressesResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-		if cancelled {
-			a.passphraseInput.Hide()
-			a.viewState = ViewSSHHosts
+
+		ctx := context.Background()
+		ingresses, err := a.k8sClient.GetIngresses(ctx, a.listNamespace())
+		return ingressesResultMsg{ingresses: ingresses, err: err}
+	}
+}
+
+// fetchIngressDetails returns a command that fetches an ingress with its rules
+func (a *App) fetchIngressDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return ingressDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-		return a, cmd
+
+		ctx := context.Background()
+		ingress, err := a.k8sClient.GetIngress(ctx, namespace, name)
+		return ingressDetailsResultMsg{ingress: ingress, err: err}
 	}
+}
 
-	// Update child components based on view state
-	switch a.viewState {
-	case ViewKubeConfigSelect:
-		var cmd tea.Cmd
-		a.kubeConfigList, cmd = a.kubeConfigList.Update(msg)
-		return a, cmd
-	case ViewNamespaces:
-		var cmd tea.Cmd
-		a.namespaceList, cmd = a.namespaceList.Update(msg)
-		return a, cmd
-	case ViewPods:
-		var cmd tea.Cmd
-		a.podList, cmd = a.podList.Update(msg)
-		return a, cmd
-	case ViewPodDetails:
-		var cmd tea.Cmd
-		a.podDetails, cmd = a.podDetails.Update(msg)
-		return a, cmd
-	case ViewLogs:
-		var cmd tea.Cmd
-		a.logViewer, cmd = a.logViewer.Update(msg)
-		return a, cmd
-	case ViewMultiPodLogs:
-		var cmd tea.Cmd
-		a.multiPodLogViewer, cmd = a.multiPodLogViewer.Update(msg)
-		return a, cmd
-	case ViewSSHHosts:
-		var cmd tea.Cmd
-		a.sshHostList, cmd = a.sshHostList.Update(msg)
-		return a, cmd
-	case ViewCrictlContainers:
-		var cmd tea.Cmd
-		a.crictlContainerList, cmd = a.crictlContainerList.Update(msg)
-		return a, cmd
-	case ViewCrictlLogs:
-		var cmd tea.Cmd
-		a.crictlLogViewer, cmd = a.crictlLogViewer.Update(msg)
-		return a, cmd
-	case ViewDeployments:
-		var cmd tea.Cmd
-		a.deploymentList, cmd = a.deploymentList.Update(msg)
-		return a, cmd
-	case ViewDeploymentDetails:
-		var cmd tea.Cmd
-		a.deploymentDetails, cmd = a.deploymentDetails.Update(msg)
-		return a, cmd
-	case ViewServices:
-		var cmd tea.Cmd
-		a.serviceList, cmd = a.serviceList.Update(msg)
-		return a, cmd
-	case ViewServiceDetails:
-		var cmd tea.Cmd
-		a.serviceDetails, cmd = a.serviceDetails.Update(msg)
-		return a, cmd
-	case ViewEvents:
-		var cmd tea.Cmd
-		a.eventViewer, cmd = a.eventViewer.Update(msg)
-		return a, cmd
-	}
+// fetchIngressRoutes returns a command that fetches Traefik IngressRoutes
+func (a *App) fetchIngressRoutes() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return ingressRoutesResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	return a, nil
+		ctx := context.Background()
+		routes, err := a.k8sClient.GetIngressRoutes(ctx, a.listNamespace())
+		return ingressRoutesResultMsg{routes: routes, err: err}
+	}
 }
 
-func (a *App) handleConnectResult(msg connectResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		a.err = msg.err
-		a.connectionStatus = domain.StatusError
-		a.viewState = ViewMain
-		return a, nil
-	}
+// fetchIngressRouteDetails returns a command that fetches an IngressRoute
+// with the middlewares it references
+func (a *App) fetchIngressRouteDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return ingressRouteDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	a.k8sClient = msg.client
-	a.clusterInfo = msg.clusterInfo
-	a.connectionStatus = domain.StatusConnected
-	a.viewState = ViewNamespaces
-	a.err = nil
-	a.loading = true
+		ctx := context.Background()
+		route, err := a.k8sClient.GetIngressRoute(ctx, namespace, name)
+		return ingressRouteDetailsResultMsg{route: route, err: err}
+	}
+}
 
-	// Initialize metrics client (optional - may not be available)
-	if a.selectedConfig != nil {
-		metricsClient, err := k8s.NewMetricsClient(a.selectedConfig.Path)
-		if err == nil {
-			// Check if metrics server is actually available
-			ctx := context.Background()
-			if metricsClient.CheckMetricsAvailable(ctx) {
-				a.metricsClient = metricsClient
-				a.metricsAvailable = true
-				logger.Debug("Metrics server available")
-			} else {
-				logger.Debug("Metrics server not available")
-			}
-		} else {
-			logger.Debug("Failed to create metrics client", "err", err)
+// fetchPVCs returns a command that fetches persistent volume claims
+func (a *App) fetchPVCs() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return pvcsResultMsg{err: fmt.Errorf("not connected to cluster")}
 		}
-	}
 
-	// Fetch namespaces after successful connection
-	return a, a.fetchNamespaces()
+		ctx := context.Background()
+		claims, err := a.k8sClient.GetPersistentVolumeClaims(ctx, a.listNamespace())
+		return pvcsResultMsg{claims: claims, err: err}
+	}
 }
 
-func (a *App) handleNamespacesResult(msg namespacesResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchPVCDetails returns a command that fetches a claim with its pods and events
+func (a *App) fetchPVCDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return pvcDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		claim, err := a.k8sClient.GetPersistentVolumeClaim(ctx, namespace, name)
+		return pvcDetailsResultMsg{claim: claim, err: err}
 	}
-
-	a.namespaceCount = len(msg.namespaces)
-	updateNamespaceList(&a.namespaceList, msg.namespaces)
-	a.err = nil
-	return a, nil
 }
 
-func (a *App) handlePodsResult(msg podsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchPVs returns a command that fetches persistent volumes
+func (a *App) fetchPVs() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return pvsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		volumes, err := a.k8sClient.GetPersistentVolumes(ctx)
+		return pvsResultMsg{volumes: volumes, err: err}
 	}
+}
 
-	a.podCount = len(msg.pods)
-	a.pods = msg.pods
+// fetchPVDetails returns a command that fetches persistent volume details
+func (a *App) fetchPVDetails(name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return pvDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	// Don't update list while filtering - it breaks the filter state
-	if !a.podList.SettingFilter() && a.podList.FilterState() == list.Unfiltered {
-		updatePodList(&a.podList, msg.pods)
+		ctx := context.Background()
+		volume, err := a.k8sClient.GetPersistentVolume(ctx, name)
+		return pvDetailsResultMsg{volume: volume, err: err}
 	}
-	a.err = nil
-	return a, nil
 }
 
-func (a *App) handlePodDetailsResult(msg podDetailsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchStorageClasses returns a command that fetches storage classes
+func (a *App) fetchStorageClasses() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return storageClassesResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		classes, err := a.k8sClient.GetStorageClasses(ctx)
+		return storageClassesResultMsg{classes: classes, err: err}
 	}
-
-	a.podDetails.SetPod(msg.pod, msg.events)
-	a.err = nil
-	return a, nil
 }
 
-func (a *App) handlePodDeleteResult(msg podDeleteResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Failed to delete pod: %v", msg.err),
-			NotificationError,
-		)
-		return a, notifCmd
+// resizePVC returns a command that requests a new size for a claim
+func (a *App) resizePVC(namespace, name, size string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return pvcResizeResultMsg{name: name, err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		err := a.k8sClient.ResizePersistentVolumeClaim(ctx, namespace, name, size)
+		return pvcResizeResultMsg{name: name, size: size, err: err}
 	}
+}
 
-	notifCmd := a.notification.Show(
-		fmt.Sprintf("Pod '%s' deleted successfully", msg.podName),
-		NotificationSuccess,
-	)
+// fetchAPIResources returns a command that discovers the API resources of
+// the cluster. open names a resource to show once they are known.
+func (a *App) fetchAPIResources(open string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return apiResourcesResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	// Go back to pods view and refresh
-	a.viewState = ViewPods
-	a.selectedPodName = ""
-	return a, tea.Batch(notifCmd, a.fetchPods(), a.schedulePodRefresh())
+		ctx := context.Background()
+		resources, err := a.k8sClient.GetAPIResources(ctx)
+		return apiResourcesResultMsg{resources: resources, open: open, err: err}
+	}
 }
 
-func (a *App) handlePodRestartResult(msg podRestartResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Failed to restart pod: %v", msg.err),
-			NotificationError,
-		)
-		return a, notifCmd
+// fetchResources returns a command that lists the objects of the resource
+// type shown in the browser
+func (a *App) fetchResources() tea.Cmd {
+	res := a.selectedResource
+	namespace := a.listNamespace()
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return resourcesResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		list, err := a.k8sClient.ListResources(ctx, res, namespace)
+		return resourcesResultMsg{list: list, err: err}
 	}
+}
 
-	notifCmd := a.notification.Show(
-		fmt.Sprintf("Pod '%s' restarting...", msg.podName),
-		NotificationSuccess,
-	)
+// deleteResource returns a command that deletes an object shown in the browser
+func (a *App) deleteResource(kind, namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return resourceDeleteResultMsg{kind: kind, name: name, err: fmt.Errorf("not connected to cluster")}
+		}
 
-	// Go back to pods view and refresh
-	a.viewState = ViewPods
-	a.selectedPodName = ""
-	return a, tea.Batch(notifCmd, a.fetchPods(), a.schedulePodRefresh())
+		ctx := context.Background()
+		err := a.k8sClient.DeleteResource(ctx, kind, namespace, name)
+		return resourceDeleteResultMsg{kind: kind, name: name, err: err}
+	}
 }
 
-// Deployment result handlers
-func (a *App) handleDeploymentsResult(msg deploymentsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchHelmCharts returns a command that fetches the HelmCharts of every
+// namespace; k3s keeps its own in kube-system
+func (a *App) fetchHelmCharts() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return helmChartsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		charts, err := a.k8sClient.GetHelmCharts(ctx, k8s.AllNamespaces)
+		return helmChartsResultMsg{charts: charts, err: err}
 	}
-
-	a.deploymentCount = len(msg.deployments)
-	updateDeploymentList(&a.deploymentList, msg.deployments)
-	a.err = nil
-	return a, nil
 }
 
-func (a *App) handleDeploymentDetailsResult(msg deploymentDetailsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchHelmChartDetails returns a command that fetches a HelmChart with its install job
+func (a *App) fetchHelmChartDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return helmChartDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		chart, err := a.k8sClient.GetHelmChart(ctx, namespace, name)
+		return helmChartDetailsResultMsg{chart: chart, err: err}
 	}
-
-	a.deploymentDetails.SetDeployment(msg.deployment)
-	a.err = nil
-	return a, nil
 }
 
-func (a *App) handleDeploymentScaleResult(msg deploymentScaleResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Failed to scale deployment: %v", msg.err),
-			NotificationError,
-		)
-		return a, notifCmd
+// fetchConfigMaps returns a command that fetches configmaps
+func (a *App) fetchConfigMaps() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return configMapsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		configMaps, err := a.k8sClient.GetConfigMaps(ctx, a.listNamespace())
+		return configMapsResultMsg{configMaps: configMaps, err: err}
 	}
+}
 
-	notifCmd := a.notification.Show(
-		fmt.Sprintf("Deployment '%s' scaled to %d replicas", msg.deploymentName, msg.replicas),
-		NotificationSuccess,
-	)
+// fetchConfigMapDetails returns a command that fetches a configmap with its data
+func (a *App) fetchConfigMapDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return configMapDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	return a, tea.Batch(notifCmd, a.fetchDeployments())
+		ctx := context.Background()
+		configMap, err := a.k8sClient.GetConfigMap(ctx, namespace, name)
+		return configMapDetailsResultMsg{configMap: configMap, err: err}
+	}
 }
 
-func (a *App) handleDeploymentRestartResult(msg deploymentRestartResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Failed to restart deployment: %v", msg.err),
-			NotificationError,
-		)
-		return a, notifCmd
+// fetchSecrets returns a command that fetches secrets
+func (a *App) fetchSecrets() tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return secretsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
+
+		ctx := context.Background()
+		secrets, err := a.k8sClient.GetSecrets(ctx, a.listNamespace())
+		return secretsResultMsg{secrets: secrets, err: err}
 	}
+}
 
-	notifCmd := a.notification.Show(
-		fmt.Sprintf("Deployment '%s' restarting...", msg.deploymentName),
-		NotificationSuccess,
-	)
+// fetchSecretDetails returns a command that fetches a secret with its decoded data
+func (a *App) fetchSecretDetails(namespace, name string) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return secretDetailsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	a.viewState = ViewDeployments
-	a.selectedDeployName = ""
-	return a, tea.Batch(notifCmd, a.fetchDeployments())
+		ctx := context.Background()
+		secret, err := a.k8sClient.GetSecret(ctx, namespace, name)
+		return secretDetailsResultMsg{secret: secret, err: err}
+	}
 }
 
-func (a *App) handleDeploymentDeleteResult(msg deploymentDeleteResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Failed to delete deployment: %v", msg.err),
-			NotificationError,
-		)
-		return a, notifCmd
+// fetchEvents returns a command that fetches events.
+// Once the watch cache is synced the list is served from it directly.
+func (a *App) fetchEvents() tea.Cmd {
+	if a.isWatchSynced(k8s.ResourceEvents) {
+		_, cmd := a.handleEventsResult(eventsResultMsg{events: a.watcher.Events()})
+		return cmd
 	}
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return eventsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	notifCmd := a.notification.Show(
-		fmt.Sprintf("Deployment '%s' deleted", msg.deploymentName),
-		NotificationSuccess,
-	)
-
-	a.viewState = ViewDeployments
-	a.selectedDeployName = ""
-	return a, tea.Batch(notifCmd, a.fetchDeployments())
+		ctx := context.Background()
+		// Fetch all events - filtering is done in the viewer for better UX
+		events, err := a.k8sClient.GetEvents(ctx, a.listNamespace())
+		return eventsResultMsg{events: events, err: err}
+	}
 }
 
-// Service result handlers
-func (a *App) handleServicesResult(msg servicesResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchMetrics returns a command that fetches pod metrics
+func (a *App) fetchMetrics() tea.Cmd {
+	return func() tea.Msg {
+		if a.metricsClient == nil {
+			return metricsResultMsg{err: fmt.Errorf("metrics not available")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		metrics, err := a.metricsClient.GetPodMetrics(ctx, a.listNamespace())
+		return metricsResultMsg{metrics: metrics, err: err}
 	}
-
-	a.serviceCount = len(msg.services)
-	updateServiceList(&a.serviceList, msg.services)
-	a.err = nil
-	return a, nil
 }
 
-func (a *App) handleServiceDetailsResult(msg serviceDetailsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchContainers returns a command that fetches container names for a pod
+func (a *App) fetchContainers(namespace, podName string) tea.Cmd {
+	logger.Debug("fetchContainers called", "pod", podName, "namespace", namespace)
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			logger.Error("fetchContainers: k8sClient is nil")
+			return containersResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
+		ctx := context.Background()
+		logger.Debug("Calling GetPodContainers", "pod", podName, "namespace", namespace)
+		containers, err := a.k8sClient.GetPodContainers(ctx, namespace, podName)
+		if err != nil {
+			logger.Error("GetPodContainers failed", "err", err)
+		} else {
+			logger.Debug("GetPodContainers returned containers", "count", len(containers))
+		}
+		return containersResultMsg{containers: containers, err: err}
 	}
-
-	a.serviceDetails.SetService(msg.service)
-	a.err = nil
-	return a, nil
 }
 
-// Event result handler
-func (a *App) handleEventsResult(msg eventsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+// fetchLogs returns a command that fetches logs for a pod
+func (a *App) fetchLogs(namespace, podName, container string, tailLines int64, timestamps bool) tea.Cmd {
+	return func() tea.Msg {
+		if a.k8sClient == nil {
+			return logsResultMsg{err: fmt.Errorf("not connected to cluster")}
+		}
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
-	}
+		ctx := context.Background()
+		opts := k8s.LogOptions{
+			Container:  container,
+			TailLines:  tailLines,
+			Timestamps: timestamps,
+			Follow:     false,
+		}
 
-	a.eventViewer.SetEvents(msg.events)
-	a.err = nil
-	return a, nil
+		logs, err := a.k8sClient.GetPodLogs(ctx, namespace, podName, opts)
+		return logsResultMsg{logs: logs, err: err}
+	}
 }
 
-// Metrics result handler
-func (a *App) handleMetricsResult(msg metricsResultMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		// Silently fail - metrics are optional
-		a.podMetrics = nil
-		return a, nil
+// stopLogStream stops the current log stream
+func (a *App) stopLogStream() {
+	if a.logStreamCancel != nil {
+		a.logStreamCancel()
+		a.logStreamCancel = nil
 	}
+	a.logStreamActive = false
+}
 
-	a.podMetrics = msg.metrics
+// Update implements tea.Model
+func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return a.handleKeyMsg(msg)
 
-	// Rebuild pod list with new metrics data if metrics are enabled
-	if a.metricsEnabled && a.viewState == ViewPods {
-		a.podList = newPodList(
-			nil,
-			a.podList.Width(),
-			a.podList.Height(),
-			a.styles,
-			a.metricsEnabled,
-			a.podMetrics,
-		)
-		if a.pods != nil {
-			updatePodList(&a.podList, a.pods)
-		}
-	}
+	case tea.WindowSizeMsg:
+		a.width = msg.Width
+		a.height = msg.Height
+		a.ready = true
 
-	return a, nil
-}
+		// Calculate sidebar dimensions
+		if a.width >= sidebarMinWidth {
+			a.showSidebar = true
+			a.sidebarWidth = a.width / 4
+			if a.sidebarWidth < 24 {
+				a.sidebarWidth = 24
+			}
+			if a.sidebarWidth > 30 {
+				a.sidebarWidth = 30
+			}
+			a.contentWidth = a.width - 4 - a.sidebarWidth
+		} else {
+			a.showSidebar = false
+			a.sidebarWidth = 0
+			a.contentWidth = a.width - 4
+		}
 
-func (a *App) handleContainersResult(msg containersResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+		cw := a.contentWidth
+		// When sidebar is shown, content has more vertical space (no header)
+		listH := a.height - 10
+		viewH := a.height - 8
+		logH := a.height - 10
+		if a.showSidebar {
+			listH = a.height - 8
+			viewH = a.height - 6
+			logH = a.height - 6
+		}
 
-	if msg.err != nil {
-		logger.Error("Failed to get containers", "pod", a.selectedPodName, "err", msg.err)
-		a.err = msg.err
+		a.kubeConfigList = newKubeConfigList(
+			a.config.KubeConfigs,
+			cw,
+			listH,
+			a.styles,
+		)
+		a.contextList.SetSize(cw, listH)
+		a.namespaceList = newNamespaceList(nil, cw, listH, a.styles)
+		a.podList = newPodList(nil, cw, listH, a.styles, a.metricsEnabled, a.podMetrics, a.allNamespaces)
+		a.podDetails.SetSize(cw, viewH)
+		a.logViewer.SetSize(cw, logH)
+		a.yamlViewer.SetSize(cw, logH)
+		a.diffViewer.SetSize(cw, logH)
+		a.revisionList = newRevisionList(cw, listH, a.styles)
+		a.confirmDialog.SetWidth(a.width)
+		a.notification.SetWidth(a.width)
+		a.containerSelector.SetWidth(a.width)
+		a.sshHostList = newSSHHostList(a.config.SSHHosts, cw, listH, a.styles)
+		updateSSHHostList(&a.sshHostList, a.config.SSHHosts, a.nodes, a.markedSSHHosts)
+		a.crictlContainerList = newCrictlContainerList(nil, cw, listH, a.styles)
+		updateCrictlContainerList(&a.crictlContainerList, a.crictlContainers)
+		a.crictlImageList = newCrictlImageList(cw, listH, a.styles)
+		updateCrictlImageList(&a.crictlImageList, a.crictlImages, a.crictlContainers)
+		a.crictlSandboxList = newCrictlSandboxList(cw, listH, a.styles)
+		updateCrictlSandboxList(&a.crictlSandboxList, a.crictlSandboxes, a.crictlContainers)
+		a.etcdSnapshotList = newEtcdSnapshotList(cw, a.etcdSnapshotListHeight(), a.styles)
+		updateEtcdSnapshotList(&a.etcdSnapshotList, a.etcdSnapshots)
+		a.etcdSnapshotDialog.SetWidth(a.width)
+		a.passphraseInput.SetWidth(a.width)
+		a.hostKeyPrompt.SetWidth(a.width)
+		a.crictlLogViewer.SetSize(cw, logH)
+		a.journalViewer.SetSize(cw, logH)
+		a.crictlInspect.SetSize(cw, logH)
+		a.crictlStatsViewer.SetSize(cw, logH-1)
+		a.sshRunViewer.SetSize(cw, logH)
+		a.sshCommandDialog.SetWidth(a.width)
+		a.helpScreen.SetSize(a.width, a.height)
+		a.deploymentList = newDeploymentList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.deploymentDetails.SetSize(cw, viewH)
+		a.statefulSetList = newStatefulSetList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.statefulSetDetails.SetSize(cw, viewH)
+		a.daemonSetList = newDaemonSetList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.daemonSetDetails.SetSize(cw, viewH)
+		a.replicaSetList = newReplicaSetList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.replicaSetDetails.SetSize(cw, viewH)
+		a.jobList = newJobList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.jobDetails.SetSize(cw, viewH)
+		a.cronJobList = newCronJobList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.cronJobDetails.SetSize(cw, viewH)
+		a.nodeList = newNodeList(nil, cw, listH, a.styles)
+		a.nodeDetails.SetSize(cw, viewH)
+		a.drainDialog.SetWidth(a.width)
+		a.drainProgress.SetSize(cw, logH)
+		a.serviceList = newServiceList(nil, cw, listH, a.styles, a.allNamespaces)
+		a.serviceDetails.SetSize(cw, viewH)
+		a.configMapList = newConfigMapList(nil, cw, listH, a.styles, a.allNamespaces)
+		updateConfigMapList(&a.configMapList, a.configMaps)
+		a.secretList = newSecretList(nil, cw, listH, a.styles, a.allNamespaces)
+		updateSecretList(&a.secretList, a.secrets)
+		a.configData.SetSize(cw, logH)
+		a.ingressList = newIngressList(nil, cw, listH, a.styles, a.allNamespaces)
+		updateIngressList(&a.ingressList, a.ingresses)
+		a.ingressDetails.SetSize(cw, viewH)
+		a.ingressRouteList = newIngressRouteList(nil, cw, listH, a.styles, a.allNamespaces)
+		updateIngressRouteList(&a.ingressRouteList, a.ingressRoutes)
+		a.ingressRouteDetails.SetSize(cw, viewH)
+		a.pvcList = newPVCList(nil, cw, listH, a.styles, a.allNamespaces)
+		updatePVCList(&a.pvcList, a.pvcs)
+		a.pvcDetails.SetSize(cw, viewH)
+		a.pvcResizeDialog.SetWidth(a.width)
+		a.pvList = newPVList(nil, cw, listH, a.styles)
+		updatePVList(&a.pvList, a.pvs, a.pvOrphansOnly)
+		a.pvDetails.SetSize(cw, viewH)
+		a.storageClassList = newStorageClassList(nil, cw, listH, a.styles)
+		updateStorageClassList(&a.storageClassList, a.storageClasses)
+		a.apiResourceList = newAPIResourceList(nil, cw, listH, a.styles)
+		updateAPIResourceList(&a.apiResourceList, a.apiResources)
+		a.resourceList = newResourceList(cw, listH, a.styles)
+		updateResourceList(&a.resourceList, a.resourceData, a.styles, a.allNamespaces)
+		a.helmChartList = newHelmChartList(nil, cw, listH, a.styles)
+		updateHelmChartList(&a.helmChartList, a.helmCharts)
+		a.helmChartDetails.SetSize(cw, viewH)
+		a.eventViewer.SetSize(cw, logH)
+		a.scaleDialog.SetWidth(a.width)
+		a.podMultiSelector.SetWidth(a.width)
+		a.multiPodLogViewer.SetSize(cw, logH)
+		a.portForwardList = newPortForwardList(a.portForwardInfos(), cw, listH, a.styles)
+		a.portForwardDialog.SetWidth(a.width)
 		return a, nil
-	}
 
-	logger.Debug("Got containers for pod", "count", len(msg.containers), "pod", a.selectedPodName, "containers", msg.containers)
+	case connectResultMsg:
+		return a.handleConnectResult(msg)
 
-	// Set up log viewer with pod and containers
-	a.logViewer.SetPod(a.selectedPodName, a.k8sClient.CurrentNamespace(), msg.containers)
-	a.viewState = ViewLogs
+	case contextsResultMsg:
+		return a.handleContextsResult(msg)
 
-	logger.Debug("Switching to ViewLogs, fetching logs", "container", a.logViewer.Container())
+	case namespacesResultMsg:
+		return a.handleNamespacesResult(msg)
 
-	// Fetch initial logs
-	return a, a.fetchLogs(
-		a.selectedPodName,
-		a.logViewer.Container(),
-		a.logViewer.TailLines(),
-		a.logViewer.Timestamps(),
-	)
-}
+	case podsResultMsg:
+		return a.handlePodsResult(msg)
 
-func (a *App) handleLogsResult(msg logsResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+	case podDetailsResultMsg:
+		return a.handlePodDetailsResult(msg)
 
-	if msg.err != nil {
-		logger.Error("Failed to get logs", "err", msg.err)
-		a.err = msg.err
+	case rolloutRefreshTickMsg:
+		a.rolloutWatching = false
+		if (a.viewState == ViewDeploymentDetails || a.viewState == ViewDeploymentHistory) && a.selectedDeployName != "" && !a.confirmDialog.IsVisible() {
+			return a, a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName)
+		}
 		return a, nil
-	}
 
-	logLen := len(msg.logs)
-	logger.Debug("Received logs", "bytes", logLen)
+	case podRefreshTickMsg:
+		// Only refresh if we're on the pods view, dialog is not visible, and not filtering
+		if a.viewState == ViewPods && a.k8sClient != nil && !a.confirmDialog.IsVisible() && !a.podList.SettingFilter() && a.podList.FilterState() == list.Unfiltered {
+			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+		}
+		// Reschedule even if we skip refresh (to check again later)
+		if a.viewState == ViewPods && a.k8sClient != nil {
+			return a, a.schedulePodRefresh()
+		}
+		return a, nil
 
-	a.logViewer.SetLogs(msg.logs)
-	a.err = nil
+	case eventRefreshTickMsg:
+		// Only refresh if we're on the events view and following
+		if a.viewState == ViewEvents && a.k8sClient != nil && a.eventViewer.IsFollowing() {
+			return a, tea.Batch(a.fetchEvents(), a.scheduleEventRefresh())
+		}
+		// Reschedule even if we skip refresh (to check again later when following is re-enabled)
+		if a.viewState == ViewEvents && a.k8sClient != nil {
+			return a, a.scheduleEventRefresh()
+		}
+		return a, nil
 
-	// Don't auto-start streaming - user must press 'f' to follow
-	return a, nil
-}
+	case podDeleteResultMsg:
+		return a.handlePodDeleteResult(msg)
 
-func (a *App) handleLogLine(msg logLineMsg) (tea.Model, tea.Cmd) {
-	if a.viewState != ViewLogs {
-		return a, nil
-	}
+	case podRestartResultMsg:
+		return a.handlePodRestartResult(msg)
 
-	a.logViewer.AppendLog(msg.line)
+	case execFinishedMsg:
+		return a.handleExecFinished(msg)
 
-	// Continue reading from stream if active
-	if a.logStreamActive && a.logLineChan != nil {
-		return a, a.waitForLogLine(a.logLineChan)
-	}
+	case sshShellFinishedMsg:
+		return a.handleSSHShellFinished(msg)
 
-	return a, nil
-}
+	case crictlExecFinishedMsg:
+		return a.handleCrictlExecFinished(msg)
 
-func (a *App) handleLogStreamEnded(msg logStreamEndedMsg) (tea.Model, tea.Cmd) {
-	a.logStreamActive = false
+	case crictlActionMsg:
+		return a.handleCrictlAction(msg)
 
-	// If context was cancelled (user stopped follow), just return
-	if msg.err == context.Canceled {
-		return a, nil
-	}
+	case crictlImagesMsg:
+		return a.handleCrictlImages(msg)
 
-	// If follow mode is still enabled and we're on logs view, auto-restart the stream
-	if a.logViewer.IsFollowing() && a.viewState == ViewLogs {
-		logger.Debug("Log stream ended, auto-restarting (follow still enabled)")
-		return a, a.startLogStreaming()
-	}
+	case etcdSnapshotsMsg:
+		return a.handleEtcdSnapshots(msg)
 
-	// Show notification if there was an error
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Log stream ended: %v", msg.err),
-			NotificationInfo,
-		)
-		return a, notifCmd
-	}
+	case etcdSnapshotSavedMsg:
+		return a.handleEtcdSnapshotSaved(msg)
 
-	return a, nil
-}
+	case crictlSandboxesMsg:
+		return a.handleCrictlSandboxes(msg)
 
-// startLogStreaming starts the log streaming using a subscription pattern
-func (a *App) startLogStreaming() tea.Cmd {
-	if a.k8sClient == nil {
-		return nil
-	}
+	case crictlInspectMsg:
+		return a.handleCrictlInspect(msg)
 
-	// Stop any existing stream
-	a.stopLogStream()
+	case crictlStatsMsg:
+		return a.handleCrictlStats(msg)
 
-	// Create a cancellable context
-	ctx, cancel := context.WithCancel(context.Background())
-	a.logStreamCancel = cancel
-	a.logStreamActive = true
+	case crictlStatsTickMsg:
+		a.crictlStatsWatching = false
+		if a.viewState == ViewCrictlStats && a.sshClient != nil {
+			return a, a.fetchCrictlStats()
+		}
+		return a, nil
 
-	opts := k8s.LogOptions{
-		Container:  a.logViewer.Container(),
-		TailLines:  0, // Don't re-fetch tail when streaming
-		Timestamps: a.logViewer.Timestamps(),
-		Follow:     true,
-	}
+	case sshRunResultMsg:
+		if msg.runID != a.sshRunID {
+			return a, nil
+		}
+		a.sshRunViewer.AddResult(msg.result)
+		return a, a.waitForSSHRunResult(msg.runID, msg.results)
 
-	lineChan := make(chan string, 100)
-	a.logLineChan = lineChan
+	case sshRunFinishedMsg:
+		if msg.runID == a.sshRunID {
+			a.stopSSHRun()
+			a.sshRunViewer.Finish()
+		}
+		return a, nil
 
-	// Start streaming in a goroutine
-	go func() {
-		defer close(lineChan)
-		_ = a.k8sClient.StreamPodLogs(
-			ctx,
-			a.k8sClient.CurrentNamespace(),
-			a.logViewer.PodName(),
-			opts,
-			lineChan,
-		)
-	}()
+	case notificationExpiredMsg:
+		a.notification.Hide()
+		return a, nil
 
-	// Return a command that reads from the channel
-	return a.waitForLogLine(lineChan)
-}
+	case containersResultMsg:
+		return a.handleContainersResult(msg)
 
-// waitForLogLine returns a command that waits for the next log line
-func (a *App) waitForLogLine(lineChan <-chan string) tea.Cmd {
-	return func() tea.Msg {
-		line, ok := <-lineChan
-		if !ok {
-			return logStreamEndedMsg{}
-		}
-		return logLineMsg{line: line}
-	}
-}
+	case logsResultMsg:
+		return a.handleLogsResult(msg)
 
-// SSH-related commands and handlers
+	case logLineMsg:
+		return a.handleLogLine(msg)
 
-// connectToSSHHost connects to an SSH host and returns a command
-func (a *App) connectToSSHHost(host domain.SSHHost) tea.Cmd {
-	// Close existing connection first
-	a.closeSSHConnection()
+	case logStreamEndedMsg:
+		return a.handleLogStreamEnded(msg)
 
-	// Create and store client
-	a.sshClient = ssh.NewClient(host)
-	a.selectedSSHHost = &host
+	case multiPodLogLineMsg:
+		return a.handleMultiPodLogLine(msg)
 
-	return a.retrySSHConnection()
-}
+	case multiPodLogStreamEndedMsg:
+		return a.handleMultiPodLogStreamEnded(msg)
 
-// retrySSHConnection retries SSH connection using the existing client (useful after setting passphrase)
-func (a *App) retrySSHConnection() tea.Cmd {
-	return func() tea.Msg {
-		if a.sshClient == nil {
-			return sshConnectResultMsg{err: fmt.Errorf("no SSH client")}
-		}
+	case sshConnectResultMsg:
+		return a.handleSSHConnectResult(msg)
 
-		ctx := context.Background()
+	case sshCrictlContainersMsg:
+		return a.handleSSHCrictlContainers(msg)
 
-		if err := a.sshClient.Connect(ctx); err != nil {
-			return sshConnectResultMsg{err: err}
-		}
+	case sshNodeInfoMsg:
+		return a.handleSSHNodeInfo(msg)
 
-		if err := a.sshClient.TestConnection(ctx); err != nil {
-			a.sshClient.Close()
-			return sshConnectResultMsg{err: err}
-		}
+	case sshCrictlLogsMsg:
+		return a.handleCrictlLogsResult(msg)
 
-		return sshConnectResultMsg{err: nil}
-	}
-}
+	case sshCrictlLogLineMsg:
+		return a.handleCrictlLogLine(msg)
 
-// fetchCrictlContainers returns a command that fetches crictl containers
-func (a *App) fetchCrictlContainers() tea.Cmd {
-	return func() tea.Msg {
-		if a.sshClient == nil {
-			return sshCrictlContainersMsg{err: fmt.Errorf("not connected to SSH host")}
-		}
+	case sshCrictlLogStreamEndedMsg:
+		return a.handleCrictlLogStreamEnded(msg)
 
-		ctx := context.Background()
-		containers, err := a.sshClient.ListContainers(ctx)
-		return sshCrictlContainersMsg{containers: containers, err: err}
-	}
-}
+	case sshJournalMsg:
+		return a.handleJournalResult(msg)
 
-// fetchNodeInfo returns a command that fetches node information
-func (a *App) fetchNodeInfo() tea.Cmd {
-	return func() tea.Msg {
-		if a.sshClient == nil {
-			return sshNodeInfoMsg{err: fmt.Errorf("not connected to SSH host")}
-		}
+	case sshJournalEntryMsg:
+		return a.handleJournalEntry(msg)
 
-		ctx := context.Background()
-		info, err := a.sshClient.GetNodeInfo(ctx)
-		return sshNodeInfoMsg{info: info, err: err}
-	}
-}
+	case sshJournalStreamEndedMsg:
+		return a.handleJournalStreamEnded(msg)
 
-func (a *App) handleSSHConnectResult(msg sshConnectResultMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+	// Deployment messages
+	case deploymentsResultMsg:
+		return a.handleDeploymentsResult(msg)
 
-	if msg.err != nil {
-		// Check if passphrase is required
-		if msg.err == ssh.ErrPassphraseRequired {
-			// Show passphrase input dialog
-			hostName := ""
-			if a.selectedSSHHost != nil {
-				hostName = a.selectedSSHHost.Name
-			}
-			cmd := a.passphraseInput.Show(hostName)
-			a.viewState = ViewSSHConnecting
-			return a, cmd
-		}
-		a.err = msg.err
-		a.viewState = ViewSSHHosts
-		return a, nil
-	}
+	case deploymentDetailsResultMsg:
+		return a.handleDeploymentDetailsResult(msg)
 
-	// Connection successful - switch to crictl containers view
-	a.viewState = ViewCrictlContainers
-	a.err = nil
-	a.loading = true
+	case deploymentScaleResultMsg:
+		return a.handleDeploymentScaleResult(msg)
 
-	// Fetch containers and node info
-	return a, tea.Batch(a.fetchCrictlContainers(), a.fetchNodeInfo())
-}
+	case deploymentRestartResultMsg:
+		return a.handleDeploymentRestartResult(msg)
 
-func (a *App) handleSSHCrictlContainers(msg sshCrictlContainersMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+	case deploymentDeleteResultMsg:
+		return a.handleDeploymentDeleteResult(msg)
 
-	if msg.err != nil {
-		a.err = msg.err
-		return a, nil
-	}
+	// StatefulSet messages
+	case statefulSetsResultMsg:
+		return a.handleStatefulSetsResult(msg)
 
-	a.crictlContainers = msg.containers
-	updateCrictlContainerList(&a.crictlContainerList, msg.containers)
-	a.err = nil
-	return a, nil
-}
+	case statefulSetDetailsResultMsg:
+		return a.handleStatefulSetDetailsResult(msg)
 
-func (a *App) handleSSHNodeInfo(msg sshNodeInfoMsg) (tea.Model, tea.Cmd) {
-	if msg.err != nil {
-		// Non-fatal, just log
-		logger.Debug("Failed to get node info", "err", msg.err)
-		return a, nil
-	}
+	case statefulSetScaleResultMsg:
+		return a.handleStatefulSetScaleResult(msg)
 
-	a.nodeInfo = msg.info
-	return a, nil
-}
+	case statefulSetRestartResultMsg:
+		return a.handleStatefulSetRestartResult(msg)
 
-// closeSSHConnection closes the current SSH connection
-func (a *App) closeSSHConnection() {
-	if a.sshClient != nil {
-		a.sshClient.Close()
-		a.sshClient = nil
-	}
-	a.selectedSSHHost = nil
-	a.nodeInfo = nil
-	a.crictlContainers = nil
-}
+	// DaemonSet messages
+	case daemonSetsResultMsg:
+		return a.handleDaemonSetsResult(msg)
 
-// fetchCrictlLogs returns a command that fetches crictl container logs
-func (a *App) fetchCrictlLogs() tea.Cmd {
-	return func() tea.Msg {
-		if a.sshClient == nil || a.selectedCrictlContainer == nil {
-			return sshCrictlLogsMsg{err: fmt.Errorf("not connected or no container selected")}
-		}
+	case daemonSetDetailsResultMsg:
+		return a.handleDaemonSetDetailsResult(msg)
 
-		ctx := context.Background()
-		opts := ssh.CrictlLogOptions{
-			TailLines:  a.crictlLogViewer.TailLines(),
-			Timestamps: a.crictlLogViewer.Timestamps(),
-		}
+	case daemonSetRestartResultMsg:
+		return a.handleDaemonSetRestartResult(msg)
 
-		logs, err := a.sshClient.ContainerLogs(ctx, a.selectedCrictlContainer.ContainerID, opts)
-		return sshCrictlLogsMsg{logs: logs, err: err}
-	}
-}
+	// ReplicaSet messages
+	case replicaSetsResultMsg:
+		return a.handleReplicaSetsResult(msg)
 
-// stopCrictlLogStream stops the current crictl log stream
-func (a *App) stopCrictlLogStream() {
-	if a.crictlLogStreamCancel != nil {
-		a.crictlLogStreamCancel()
-		a.crictlLogStreamCancel = nil
-	}
-	a.crictlLogStreamActive = false
-}
+	case replicaSetDetailsResultMsg:
+		return a.handleReplicaSetDetailsResult(msg)
 
-// startCrictlLogStreaming starts the crictl log streaming
-func (a *App) startCrictlLogStreaming() tea.Cmd {
-	if a.sshClient == nil || a.selectedCrictlContainer == nil {
-		return nil
-	}
+	// Job messages
+	case jobsResultMsg:
+		return a.handleJobsResult(msg)
 
-	// Stop any existing stream
-	a.stopCrictlLogStream()
+	case jobDetailsResultMsg:
+		return a.handleJobDetailsResult(msg)
 
-	// Create a cancellable context
-	ctx, cancel := context.WithCancel(context.Background())
-	a.crictlLogStreamCancel = cancel
-	a.crictlLogStreamActive = true
+	// CronJob messages
+	case cronJobsResultMsg:
+		return a.handleCronJobsResult(msg)
 
-	opts := ssh.CrictlLogOptions{
-		TailLines:  0, // Don't re-fetch tail when streaming
-		Timestamps: a.crictlLogViewer.Timestamps(),
-		Follow:     true,
-	}
+	case cronJobDetailsResultMsg:
+		return a.handleCronJobDetailsResult(msg)
 
-	lineChan := make(chan string, 100)
-	a.crictlLogLineChan = lineChan
+	case cronJobTriggerResultMsg:
+		return a.handleCronJobTriggerResult(msg)
 
-	containerID := a.selectedCrictlContainer.ContainerID
+	case cronJobSuspendResultMsg:
+		return a.handleCronJobSuspendResult(msg)
 
-	// Start streaming in a goroutine
-	go func() {
-		defer close(lineChan)
-		_ = a.sshClient.StreamContainerLogs(ctx, containerID, opts, lineChan)
-	}()
+	// Node messages
+	case nodesResultMsg:
+		return a.handleNodesResult(msg)
 
-	// Return a command that reads from the channel
-	return a.waitForCrictlLogLine(lineChan)
-}
+	case nodeDetailsResultMsg:
+		return a.handleNodeDetailsResult(msg)
 
-// waitForCrictlLogLine returns a command that waits for the next crictl log line
-func (a *App) waitForCrictlLogLine(lineChan <-chan string) tea.Cmd {
-	return func() tea.Msg {
-		line, ok := <-lineChan
-		if !ok {
-			return sshCrictlLogStreamEndedMsg{}
-		}
-		return sshCrictlLogLineMsg{line: line}
-	}
-}
+	case nodeCordonResultMsg:
+		return a.handleNodeCordonResult(msg)
 
-func (a *App) handleCrictlLogsResult(msg sshCrictlLogsMsg) (tea.Model, tea.Cmd) {
-	a.loading = false
+	case drainEventMsg:
+		a.drainProgress.Apply(msg.event)
+		return a, a.waitForDrainEvent(a.drainEvents, a.drainResult)
 
-	if msg.err != nil {
-		logger.Error("Failed to get crictl logs", "err", msg.err)
-		a.err = msg.err
-		return a, nil
-	}
+	case drainFinishedMsg:
+		return a.handleDrainFinished(msg)
 
-	a.crictlLogViewer.SetLogs(msg.logs)
-	a.err = nil
-	return a, nil
-}
+	case nodeContainersMsg:
+		return a.handleNodeContainers(msg)
 
-func (a *App) handleCrictlLogLine(msg sshCrictlLogLineMsg) (tea.Model, tea.Cmd) {
-	if a.viewState != ViewCrictlLogs {
-		return a, nil
-	}
+	// YAML viewer messages
+	case resourceYAMLResultMsg:
+		return a.handleResourceYAMLResult(msg)
 
-	a.crictlLogViewer.AppendLog(msg.line)
+	// Deployment rollout messages
+	case deploymentRollbackResultMsg:
+		return a.handleDeploymentRollbackResult(msg)
 
-	// Continue reading from stream if active
-	if a.crictlLogStreamActive && a.crictlLogLineChan != nil {
-		return a, a.waitForCrictlLogLine(a.crictlLogLineChan)
-	}
+	case deploymentPauseResultMsg:
+		return a.handleDeploymentPauseResult(msg)
 
-	return a, nil
-}
+	// Edit messages
+	case editFetchedMsg:
+		return a.handleEditFetched(msg)
 
-func (a *App) handleCrictlLogStreamEnded(msg sshCrictlLogStreamEndedMsg) (tea.Model, tea.Cmd) {
-	a.crictlLogStreamActive = false
+	case editorFinishedMsg:
+		return a.handleEditorFinished(msg)
 
-	// If context was cancelled (user stopped follow), just return
-	if msg.err == context.Canceled {
-		return a, nil
-	}
+	case editDryRunResultMsg:
+		return a.handleEditDryRunResult(msg)
 
-	// If follow mode is still enabled and we're on logs view, auto-restart the stream
-	if a.crictlLogViewer.IsFollowing() && a.viewState == ViewCrictlLogs {
-		logger.Debug("Crictl log stream ended, auto-restarting (follow still enabled)")
-		return a, a.startCrictlLogStreaming()
-	}
+	case editApplyResultMsg:
+		return a.handleEditApplyResult(msg)
 
-	// Show notification if there was an error
-	if msg.err != nil {
-		notifCmd := a.notification.Show(
-			fmt.Sprintf("Log stream ended: %v", msg.err),
-			NotificationInfo,
-		)
-		return a, notifCmd
-	}
+	// Service messages
+	case servicesResultMsg:
+		return a.handleServicesResult(msg)
 
-	return a, nil
-}
+	case serviceDetailsResultMsg:
+		return a.handleServiceDetailsResult(msg)
 
-func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	key := msg.String()
-	logger.Debug("Key pressed", "key", key, "viewState", a.viewState)
+	// Ingress and IngressRoute messages
+	case ingressesResultMsg:
+		return a.handleIngressesResult(msg)
 
-	// Don't handle keys during connection
-	if a.viewState == ViewConnecting {
-		if key == "ctrl+c" {
-			return a, tea.Quit
+	case ingressDetailsResultMsg:
+		return a.handleIngressDetailsResult(msg)
+
+	case ingressRoutesResultMsg:
+		return a.handleIngressRoutesResult(msg)
+
+	case ingressRouteDetailsResultMsg:
+		return a.handleIngressRouteDetailsResult(msg)
+
+	// Storage messages
+	case pvcsResultMsg:
+		return a.handlePVCsResult(msg)
+
+	case pvcDetailsResultMsg:
+		return a.handlePVCDetailsResult(msg)
+
+	case pvsResultMsg:
+		return a.handlePVsResult(msg)
+
+	case pvDetailsResultMsg:
+		return a.handlePVDetailsResult(msg)
+
+	case storageClassesResultMsg:
+		return a.handleStorageClassesResult(msg)
+
+	case pvcResizeResultMsg:
+		return a.handlePVCResizeResult(msg)
+
+	// Resource browser messages
+	case apiResourcesResultMsg:
+		return a.handleAPIResourcesResult(msg)
+
+	case resourcesResultMsg:
+		return a.handleResourcesResult(msg)
+
+	case resourceDeleteResultMsg:
+		return a.handleResourceDeleteResult(msg)
+
+	// HelmChart messages
+	case helmChartsResultMsg:
+		return a.handleHelmChartsResult(msg)
+
+	case helmChartDetailsResultMsg:
+		return a.handleHelmChartDetailsResult(msg)
+
+	// ConfigMap and Secret messages
+	case configMapsResultMsg:
+		return a.handleConfigMapsResult(msg)
+
+	case configMapDetailsResultMsg:
+		return a.handleConfigMapDetailsResult(msg)
+
+	case secretsResultMsg:
+		return a.handleSecretsResult(msg)
+
+	case secretDetailsResultMsg:
+		return a.handleSecretDetailsResult(msg)
+
+	// Event messages
+	case eventsResultMsg:
+		return a.handleEventsResult(msg)
+
+	// Metrics messages
+	case metricsResultMsg:
+		return a.handleMetricsResult(msg)
+
+	// Watch messages
+	case watchEventsMsg:
+		return a.handleWatchEvents(msg)
+
+	// Port-forward messages
+	case portForwardStartedMsg:
+		return a.handlePortForwardStarted(msg)
+
+	case portForwardEndedMsg:
+		return a.handlePortForwardEnded(msg)
+
+	case portForwardTickMsg:
+		if a.viewState == ViewPortForwards {
+			updatePortForwardList(&a.portForwardList, a.portForwardInfos())
+			return a, a.schedulePortForwardRefresh()
 		}
 		return a, nil
+
+	case spinner.TickMsg:
+		var cmd tea.Cmd
+		a.spinner, cmd = a.spinner.Update(msg)
+		return a, cmd
 	}
 
-	// Handle confirmation dialog if visible
+	// Route non-key messages to visible dialogs (huh forms produce internal
+	// commands like nextGroupMsg that need to flow back through Update).
 	if a.confirmDialog.IsVisible() {
 		confirmed, cancelled, cmd := a.confirmDialog.Update(msg)
 		if confirmed {
 			action := a.confirmDialog.Action()
+			namespace := a.confirmDialog.Namespace()
 			targetName := a.confirmDialog.TargetName()
 			a.confirmDialog.Hide()
-
-			switch action {
-			case ConfirmActionDeletePod:
-				return a, a.deletePod(targetName)
-			case ConfirmActionRestartPod:
-				return a, a.restartPod(targetName)
-			case ConfirmActionDeleteDeployment:
-				return a, a.deleteDeployment(targetName)
-			case ConfirmActionRestartDeployment:
-				return a, a.restartDeployment(targetName)
-			}
+			return a, a.runConfirmedAction(action, namespace, targetName)
 		}
 		if cancelled {
 			a.confirmDialog.Hide()
 		}
 		return a, cmd
 	}
-
-	// Handle scale dialog if visible
 	if a.scaleDialog.IsVisible() {
 		confirmed, cancelled, cmd := a.scaleDialog.Update(msg)
 		if confirmed {
-			deployName := a.scaleDialog.DeploymentName()
+			kind := a.scaleDialog.Kind()
+			namespace := a.scaleDialog.Namespace()
+			name := a.scaleDialog.Name()
 			replicas := a.scaleDialog.TargetReplicas()
 			a.scaleDialog.Hide()
-			return a, a.scaleDeployment(deployName, replicas)
+			return a, a.runScale(kind, namespace, name, replicas)
 		}
 		if cancelled {
 			a.scaleDialog.Hide()
 		}
 		return a, cmd
 	}
-
-	// Handle help screen if visible
-	if a.helpScreen.IsVisible() {
-		if key == "?" || key == "esc" {
-			a.helpScreen.Hide()
+	if a.drainDialog.IsVisible() {
+		confirmed, cancelled, cmd := a.drainDialog.Update(msg)
+		if confirmed {
+			node := a.drainDialog.Node()
+			opts := a.drainDialog.Options()
+			a.drainDialog.Hide()
+			return a, a.startDrain(node, opts)
 		}
-		return a, nil
+		if cancelled {
+			a.drainDialog.Hide()
+		}
+		return a, cmd
+	}
+	if a.portForwardDialog.IsVisible() {
+		confirmed, cancelled, cmd := a.portForwardDialog.Update(msg)
+		if confirmed {
+			a.portForwardDialog.Hide()
+			return a, a.startPortForwardFromDialog()
+		}
+		if cancelled {
+			a.portForwardDialog.Hide()
+		}
+		return a, cmd
+	}
+	if a.sshCommandDialog.IsVisible() {
+		return a.updateSSHCommandDialog(msg)
+	}
+	if a.etcdSnapshotDialog.IsVisible() {
+		return a.updateEtcdSnapshotDialog(msg)
+	}
+	if a.pvcResizeDialog.IsVisible() {
+		return a.updatePVCResizeDialog(msg)
 	}
-
-	// Handle container selector if visible
 	if a.containerSelector.IsVisible() {
 		selected, cancelled, cmd := a.containerSelector.Update(msg)
 		if selected {
 			container := a.containerSelector.SelectedContainer()
 			a.containerSelector.Hide()
-			a.logViewer.SetContainer(container)
-			a.stopLogStream()
-			a.loading = true
-			return a, a.fetchLogs(
-				a.logViewer.PodName(),
-				container,
-				a.logViewer.TailLines(),
-				a.logViewer.Timestamps(),
-			)
+			return a, a.handleContainerSelected(container)
 		}
 		if cancelled {
 			a.containerSelector.Hide()
+			a.execPod = nil
 		}
 		return a, cmd
 	}
-
-	// Handle pod multi-selector if visible
 	if a.podMultiSelector.IsVisible() {
 		confirmed, cancelled, cmd := a.podMultiSelector.Update(msg)
 		if confirmed {
@@ -1716,14 +3118,11 @@ func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return a, cmd
 	}
-
-	// Handle passphrase input if visible
 	if a.passphraseInput.IsVisible() {
 		passphrase, submitted, cancelled, cmd := a.passphraseInput.Update(msg)
 		if submitted {
 			a.passphraseInput.Hide()
 			if a.sshClient != nil && a.selectedSSHHost != nil {
-				// Set passphrase on existing client and retry connection
 				a.sshClient.SetPassphrase(passphrase)
 				a.loading = true
 				return a, a.retrySSHConnection()
@@ -1735,740 +3134,6562 @@ func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return a, cmd
 	}
-
-	// Handle search input if visible (in log views)
-	if a.searchInput.IsVisible() {
-		query, submitted, cancelled, cmd := a.searchInput.Update(msg)
-		if submitted || cancelled {
-			a.searchInput.Hide()
-			if cancelled {
-				// Clear search
-				if a.viewState == ViewLogs {
-					a.logViewer.SetSearchQuery("")
-				} else if a.viewState == ViewCrictlLogs {
-					a.crictlLogViewer.SetSearchQuery("")
-				}
-			}
-		} else {
-			// Update search as user types
-			if a.viewState == ViewLogs {
-				a.logViewer.SetSearchQuery(query)
-				a.searchInput.SetMatchCount(a.logViewer.MatchCount())
-			} else if a.viewState == ViewCrictlLogs {
-				a.crictlLogViewer.SetSearchQuery(query)
-				a.searchInput.SetMatchCount(a.crictlLogViewer.MatchCount())
-			}
-		}
-		return a, cmd
+	if a.hostKeyPrompt.IsVisible() {
+		return a.updateHostKeyPrompt(msg)
 	}
 
-	// Handle filter mode for lists
-	if a.viewState == ViewKubeConfigSelect && a.kubeConfigList.SettingFilter() {
+	// Update child components based on view state
+	switch a.viewState {
+	case ViewKubeConfigSelect:
 		var cmd tea.Cmd
 		a.kubeConfigList, cmd = a.kubeConfigList.Update(msg)
 		return a, cmd
-	}
-	if a.viewState == ViewNamespaces && a.namespaceList.SettingFilter() {
+	case ViewContextSelect:
+		var cmd tea.Cmd
+		a.contextList, cmd = a.contextList.Update(msg)
+		return a, cmd
+	case ViewNamespaces:
 		var cmd tea.Cmd
 		a.namespaceList, cmd = a.namespaceList.Update(msg)
 		return a, cmd
-	}
-	if a.viewState == ViewPods && a.podList.SettingFilter() {
+	case ViewPods:
 		var cmd tea.Cmd
 		a.podList, cmd = a.podList.Update(msg)
 		return a, cmd
-	}
-	if a.viewState == ViewSSHHosts && a.sshHostList.SettingFilter() {
+	case ViewPodDetails:
+		var cmd tea.Cmd
+		a.podDetails, cmd = a.podDetails.Update(msg)
+		return a, cmd
+	case ViewLogs:
+		var cmd tea.Cmd
+		a.logViewer, cmd = a.logViewer.Update(msg)
+		return a, cmd
+	case ViewMultiPodLogs:
+		var cmd tea.Cmd
+		a.multiPodLogViewer, cmd = a.multiPodLogViewer.Update(msg)
+		return a, cmd
+	case ViewSSHHosts:
 		var cmd tea.Cmd
 		a.sshHostList, cmd = a.sshHostList.Update(msg)
 		return a, cmd
-	}
-	if a.viewState == ViewCrictlContainers && a.crictlContainerList.SettingFilter() {
+	case ViewCrictlContainers:
 		var cmd tea.Cmd
 		a.crictlContainerList, cmd = a.crictlContainerList.Update(msg)
 		return a, cmd
-	}
-
-	switch msg.String() {
-	case "ctrl+c":
-		return a, tea.Quit
-
-	case "?":
-		// Show help screen (except during connection)
-		if a.viewState != ViewConnecting && a.viewState != ViewSSHConnecting {
-			a.helpScreen.Toggle()
-			return a, nil
-		}
-
-	case "q":
-		switch a.viewState {
-		case ViewMain, ViewNamespaces, ViewPods, ViewPodDetails, ViewLogs, ViewMultiPodLogs, ViewSSHHosts, ViewCrictlContainers, ViewCrictlLogs, ViewNodeInfo:
-			a.stopLogStream()           // Clean up any active log stream
-			a.stopMultiPodStreams()      // Clean up multi-pod log streams
-			a.stopCrictlLogStream()     // Clean up crictl log stream
-			a.closeSSHConnection()      // Clean up SSH connection
-			return a, tea.Quit
-		case ViewKubeConfigSelect:
-			return a, tea.Quit
-		}
-
-	case "enter":
-		switch a.viewState {
-		case ViewKubeConfigSelect:
-			if item, ok := a.kubeConfigList.SelectedItem().(kubeConfigItem); ok {
-				a.selectedConfig = &item.kubeConfig
-				a.viewState = ViewConnecting
-				a.connectionStatus = domain.StatusConnecting
-				a.err = nil
-				return a, a.connectToCluster(item.kubeConfig.Path)
-			}
-		case ViewNamespaces:
-			if item, ok := a.namespaceList.SelectedItem().(namespaceItem); ok {
-				a.k8sClient.SetNamespace(item.namespace.Name)
-				a.clusterInfo.Namespace = item.namespace.Name
-				a.viewState = ViewPods
-				a.loading = true
-				// Fetch pods and start auto-refresh
-				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-			}
-		case ViewPods:
-			if item, ok := a.podList.SelectedItem().(podItem); ok {
-				a.selectedPodName = item.pod.Name
-				a.viewState = ViewPodDetails
-				a.loading = true
-				return a, a.fetchPodDetails(item.pod.Name)
-			}
-		case ViewSSHHosts:
-			if item, ok := a.sshHostList.SelectedItem().(sshHostItem); ok {
-				a.viewState = ViewSSHConnecting
-				a.loading = true
-				a.err = nil
-				return a, a.connectToSSHHost(item.host)
-			}
-		case ViewCrictlContainers:
-			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok {
-				container := item.container
-				a.selectedCrictlContainer = &container
-				nodeName := ""
-				if a.selectedSSHHost != nil {
-					nodeName = a.selectedSSHHost.Name
-				}
-				a.crictlLogViewer.SetContainer(container.ContainerID, container.Name, nodeName)
-				a.viewState = ViewCrictlLogs
-				a.loading = true
-				return a, a.fetchCrictlLogs()
-			}
-		case ViewDeployments:
-			if item, ok := a.deploymentList.SelectedItem().(deploymentItem); ok {
-				a.selectedDeployName = item.deployment.Name
-				a.viewState = ViewDeploymentDetails
-				a.loading = true
-				return a, a.fetchDeploymentDetails(item.deployment.Name)
-			}
-		case ViewServices:
-			if item, ok := a.serviceList.SelectedItem().(serviceItem); ok {
-				a.selectedServiceName = item.service.Name
-				a.viewState = ViewServiceDetails
-				a.loading = true
-				return a, a.fetchServiceDetails(item.service.Name)
+	case ViewCrictlLogs:
+		var cmd tea.Cmd
+		a.crictlLogViewer, cmd = a.crictlLogViewer.Update(msg)
+		return a, cmd
+	case ViewK3sJournal:
+		var cmd tea.Cmd
+		a.journalViewer, cmd = a.journalViewer.Update(msg)
+		return a, cmd
+	case ViewCrictlStats:
+		var cmd tea.Cmd
+		a.crictlStatsViewer, cmd = a.crictlStatsViewer.Update(msg)
+		return a, cmd
+	case ViewCrictlInspect:
+		var cmd tea.Cmd
+		a.crictlInspect, cmd = a.crictlInspect.Update(msg)
+		return a, cmd
+	case ViewCrictlImages:
+		var cmd tea.Cmd
+		a.crictlImageList, cmd = a.crictlImageList.Update(msg)
+		return a, cmd
+	case ViewCrictlSandboxes:
+		var cmd tea.Cmd
+		a.crictlSandboxList, cmd = a.crictlSandboxList.Update(msg)
+		return a, cmd
+	case ViewEtcdSnapshots:
+		var cmd tea.Cmd
+		a.etcdSnapshotList, cmd = a.etcdSnapshotList.Update(msg)
+		return a, cmd
+	case ViewSSHRun:
+		var cmd tea.Cmd
+		a.sshRunViewer, cmd = a.sshRunViewer.Update(msg)
+		return a, cmd
+	case ViewDeployments:
+		var cmd tea.Cmd
+		a.deploymentList, cmd = a.deploymentList.Update(msg)
+		return a, cmd
+	case ViewDeploymentDetails:
+		var cmd tea.Cmd
+		a.deploymentDetails, cmd = a.deploymentDetails.Update(msg)
+		return a, cmd
+	case ViewStatefulSets:
+		var cmd tea.Cmd
+		a.statefulSetList, cmd = a.statefulSetList.Update(msg)
+		return a, cmd
+	case ViewStatefulSetDetails:
+		var cmd tea.Cmd
+		a.statefulSetDetails, cmd = a.statefulSetDetails.Update(msg)
+		return a, cmd
+	case ViewDaemonSets:
+		var cmd tea.Cmd
+		a.daemonSetList, cmd = a.daemonSetList.Update(msg)
+		return a, cmd
+	case ViewDaemonSetDetails:
+		var cmd tea.Cmd
+		a.daemonSetDetails, cmd = a.daemonSetDetails.Update(msg)
+		return a, cmd
+	case ViewReplicaSets:
+		var cmd tea.Cmd
+		a.replicaSetList, cmd = a.replicaSetList.Update(msg)
+		return a, cmd
+	case ViewReplicaSetDetails:
+		var cmd tea.Cmd
+		a.replicaSetDetails, cmd = a.replicaSetDetails.Update(msg)
+		return a, cmd
+	case ViewJobs:
+		var cmd tea.Cmd
+		a.jobList, cmd = a.jobList.Update(msg)
+		return a, cmd
+	case ViewJobDetails:
+		var cmd tea.Cmd
+		a.jobDetails, cmd = a.jobDetails.Update(msg)
+		return a, cmd
+	case ViewCronJobs:
+		var cmd tea.Cmd
+		a.cronJobList, cmd = a.cronJobList.Update(msg)
+		return a, cmd
+	case ViewCronJobDetails:
+		var cmd tea.Cmd
+		a.cronJobDetails, cmd = a.cronJobDetails.Update(msg)
+		return a, cmd
+	case ViewNodes:
+		var cmd tea.Cmd
+		a.nodeList, cmd = a.nodeList.Update(msg)
+		return a, cmd
+	case ViewNodeDetails:
+		var cmd tea.Cmd
+		a.nodeDetails, cmd = a.nodeDetails.Update(msg)
+		return a, cmd
+	case ViewNodeDrain:
+		var cmd tea.Cmd
+		a.drainProgress, cmd = a.drainProgress.Update(msg)
+		return a, cmd
+	case ViewYAML:
+		var cmd tea.Cmd
+		a.yamlViewer, cmd = a.yamlViewer.Update(msg)
+		return a, cmd
+	case ViewEditDiff, ViewRevisionDiff, ViewSSHRunDiff:
+		var cmd tea.Cmd
+		a.diffViewer, cmd = a.diffViewer.Update(msg)
+		return a, cmd
+	case ViewDeploymentHistory:
+		var cmd tea.Cmd
+		a.revisionList, cmd = a.revisionList.Update(msg)
+		return a, cmd
+	case ViewServices:
+		var cmd tea.Cmd
+		a.serviceList, cmd = a.serviceList.Update(msg)
+		return a, cmd
+	case ViewServiceDetails:
+		var cmd tea.Cmd
+		a.serviceDetails, cmd = a.serviceDetails.Update(msg)
+		return a, cmd
+	case ViewConfigMaps:
+		var cmd tea.Cmd
+		a.configMapList, cmd = a.configMapList.Update(msg)
+		return a, cmd
+	case ViewSecrets:
+		var cmd tea.Cmd
+		a.secretList, cmd = a.secretList.Update(msg)
+		return a, cmd
+	case ViewIngresses:
+		var cmd tea.Cmd
+		a.ingressList, cmd = a.ingressList.Update(msg)
+		return a, cmd
+	case ViewIngressDetails:
+		var cmd tea.Cmd
+		a.ingressDetails, cmd = a.ingressDetails.Update(msg)
+		return a, cmd
+	case ViewIngressRoutes:
+		var cmd tea.Cmd
+		a.ingressRouteList, cmd = a.ingressRouteList.Update(msg)
+		return a, cmd
+	case ViewIngressRouteDetails:
+		var cmd tea.Cmd
+		a.ingressRouteDetails, cmd = a.ingressRouteDetails.Update(msg)
+		return a, cmd
+	case ViewPVCs:
+		var cmd tea.Cmd
+		a.pvcList, cmd = a.pvcList.Update(msg)
+		return a, cmd
+	case ViewPVCDetails:
+		var cmd tea.Cmd
+		a.pvcDetails, cmd = a.pvcDetails.Update(msg)
+		return a, cmd
+	case ViewPVs:
+		var cmd tea.Cmd
+		a.pvList, cmd = a.pvList.Update(msg)
+		return a, cmd
+	case ViewPVDetails:
+		var cmd tea.Cmd
+		a.pvDetails, cmd = a.pvDetails.Update(msg)
+		return a, cmd
+	case ViewStorageClasses:
+		var cmd tea.Cmd
+		a.storageClassList, cmd = a.storageClassList.Update(msg)
+		return a, cmd
+	case ViewAPIResources:
+		var cmd tea.Cmd
+		a.apiResourceList, cmd = a.apiResourceList.Update(msg)
+		return a, cmd
+	case ViewResources:
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+	case ViewHelmCharts:
+		var cmd tea.Cmd
+		a.helmChartList, cmd = a.helmChartList.Update(msg)
+		return a, cmd
+	case ViewHelmChartDetails:
+		var cmd tea.Cmd
+		a.helmChartDetails, cmd = a.helmChartDetails.Update(msg)
+		return a, cmd
+	case ViewConfigMapDetails, ViewSecretDetails:
+		var cmd tea.Cmd
+		a.configData, cmd = a.configData.Update(msg)
+		return a, cmd
+	case ViewEvents:
+		var cmd tea.Cmd
+		a.eventViewer, cmd = a.eventViewer.Update(msg)
+		return a, cmd
+	case ViewPortForwards:
+		var cmd tea.Cmd
+		a.portForwardList, cmd = a.portForwardList.Update(msg)
+		return a, cmd
+	}
+
+	return a, nil
+}
+
+func (a *App) handleConnectResult(msg connectResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		a.err = msg.err
+		a.connectionStatus = domain.StatusError
+		a.viewState = ViewMain
+		return a, nil
+	}
+
+	a.k8sClient = msg.client
+	a.clusterInfo = msg.clusterInfo
+	a.connectionStatus = domain.StatusConnected
+	a.viewState = ViewNamespaces
+	a.err = nil
+	a.loading = true
+
+	// Initialize metrics client (optional - may not be available)
+	if a.k8sClient != nil {
+		metricsClient, err := k8s.NewMetricsClient(a.k8sClient)
+		if err == nil {
+			// Check if metrics server is actually available
+			ctx := context.Background()
+			if metricsClient.CheckMetricsAvailable(ctx) {
+				a.metricsClient = metricsClient
+				a.metricsAvailable = true
+				logger.Debug("Metrics server available")
+			} else {
+				logger.Debug("Metrics server not available")
 			}
+		} else {
+			logger.Debug("Failed to create metrics client", "err", err)
+		}
+	}
+
+	// Fetch namespaces after successful connection
+	return a, tea.Batch(a.fetchNamespaces(), a.startWatcher())
+}
+
+func (a *App) handleContextsResult(msg contextsResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		if msg.picker {
+			notifCmd := a.notification.Show(
+				fmt.Sprintf("Failed to read contexts: %v", msg.err),
+				NotificationError,
+			)
+			return a, notifCmd
+		}
+		// Let the client report a broken kubeconfig
+		logger.Error("Failed to list contexts", "err", msg.err)
+		return a, a.connectWithContext("")
+	}
+
+	if len(msg.contexts) <= 1 && !msg.picker {
+		return a, a.connectWithContext("")
+	}
+
+	active := a.selectedContext
+	if a.k8sClient != nil {
+		active = a.k8sClient.CurrentContext()
+	}
+	a.contextList = newContextList(msg.contexts, active, a.kubeConfigList.Width(), a.kubeConfigList.Height(), a.styles)
+	if a.connectionStatus == domain.StatusConnecting {
+		a.connectionStatus = domain.StatusDisconnected
+	}
+	a.viewState = ViewContextSelect
+	return a, nil
+}
+
+func (a *App) handleNamespacesResult(msg namespacesResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.namespaceCount = len(msg.namespaces)
+	a.namespaces = msg.namespaces
+	cmd := updateNamespaceList(&a.namespaceList, msg.namespaces)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handlePodsResult(msg podsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.pods = msg.pods
+	pods := a.scopePods(msg.pods)
+	a.podCount = len(pods)
+
+	cmd := updatePodList(&a.podList, pods)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handlePodDetailsResult(msg podDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.podDetails.SetPod(msg.pod, msg.events)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handlePodDeleteResult(msg podDeleteResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to delete pod: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Pod '%s' deleted successfully", msg.podName),
+		NotificationSuccess,
+	)
+
+	// Go back to pods view and refresh
+	a.viewState = ViewPods
+	a.selectedPodName = ""
+	return a, tea.Batch(notifCmd, a.fetchPods(), a.schedulePodRefresh())
+}
+
+func (a *App) handlePodRestartResult(msg podRestartResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to restart pod: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Pod '%s' restarting...", msg.podName),
+		NotificationSuccess,
+	)
+
+	// Go back to pods view and refresh
+	a.viewState = ViewPods
+	a.selectedPodName = ""
+	return a, tea.Batch(notifCmd, a.fetchPods(), a.schedulePodRefresh())
+}
+
+func (a *App) handleExecFinished(msg execFinishedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		logger.Error("Exec session failed", "pod", msg.podName, "container", msg.container, "err", msg.err)
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Shell failed: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Shell session in '%s/%s' closed", msg.podName, msg.container),
+		NotificationInfo,
+	)
+	return a, notifCmd
+}
+
+// Watch cache handlers
+
+// startWatcher (re)starts the watch cache for the current namespace, or for
+// all namespaces in all-namespaces mode.
+// Without a cache the views fall back to listing from the API server.
+func (a *App) startWatcher() tea.Cmd {
+	a.stopWatcher()
+	if a.k8sClient == nil {
+		return nil
+	}
+
+	watcher, err := a.k8sClient.Watch(a.listNamespace())
+	if err != nil {
+		logger.Error("Failed to start watch cache", "err", err)
+		return nil
+	}
+
+	a.watcher = watcher
+	a.watchSynced = make(map[k8s.Resource]bool)
+	a.watchErrorShown = false
+	return waitForWatchEvents(watcher)
+}
+
+// stopWatcher stops the watch cache if one is running
+func (a *App) stopWatcher() {
+	if a.watcher != nil {
+		a.watcher.Stop()
+		a.watcher = nil
+	}
+	a.watchSynced = nil
+}
+
+// isWatchSynced reports whether a resource can be served from the watch cache
+func (a *App) isWatchSynced(resource k8s.Resource) bool {
+	return a.watcher != nil && a.watchSynced[resource]
+}
+
+// waitForWatchEvents returns a command that waits for the next batch of watch deltas
+func waitForWatchEvents(w *k8s.Watcher) tea.Cmd {
+	return func() tea.Msg {
+		var events []k8s.WatchEvent
+		select {
+		case ev := <-w.Deltas():
+			events = append(events, ev)
+		case <-w.Done():
+			return nil
+		}
+
+		// Drain whatever else is queued so bursts are applied together
+		for len(events) < maxWatchEventsPerMsg {
+			select {
+			case ev := <-w.Deltas():
+				events = append(events, ev)
+			default:
+				return watchEventsMsg{watcher: w, events: events}
+			}
+		}
+		return watchEventsMsg{watcher: w, events: events}
+	}
+}
+
+func (a *App) handleWatchEvents(msg watchEventsMsg) (tea.Model, tea.Cmd) {
+	// Ignore deltas from a watcher that has been replaced
+	if msg.watcher != a.watcher {
+		return a, nil
+	}
+
+	var cmds []tea.Cmd
+	changed := make(map[k8s.Resource]bool)
+	for _, ev := range msg.events {
+		switch ev.Type {
+		case k8s.DeltaSynced:
+			a.watchSynced[ev.Resource] = true
+			a.watchErrorShown = false
+			cmds = append(cmds, a.reloadFromWatchCache(ev.Resource))
+			delete(changed, ev.Resource)
+		case k8s.DeltaDenied:
+			// The watch is not permitted and will not come back; stay on the API
+			a.watchSynced[ev.Resource] = false
+		case k8s.DeltaError:
+			// Fall back to the API until the watcher reports a resync
+			a.watchSynced[ev.Resource] = false
+			if !a.watchErrorShown {
+				a.watchErrorShown = true
+				cmds = append(cmds, a.notification.Show(
+					fmt.Sprintf("Watch on %s interrupted, resyncing...", ev.Resource),
+					NotificationWarning,
+				))
+			}
+		default:
+			if a.watchSynced[ev.Resource] && a.applyWatchDelta(ev) {
+				changed[ev.Resource] = true
+			}
+		}
+	}
+
+	for resource := range changed {
+		cmds = append(cmds, a.refreshWatchedList(resource))
+	}
+	cmds = append(cmds, waitForWatchEvents(msg.watcher))
+	return a, tea.Batch(cmds...)
+}
+
+// reloadFromWatchCache replaces a list with the full contents of the watch cache
+func (a *App) reloadFromWatchCache(resource k8s.Resource) tea.Cmd {
+	switch resource {
+	case k8s.ResourcePods:
+		return a.fetchPods()
+	case k8s.ResourceDeployments:
+		return a.fetchDeployments()
+	case k8s.ResourceStatefulSets:
+		return a.fetchStatefulSets()
+	case k8s.ResourceDaemonSets:
+		return a.fetchDaemonSets()
+	case k8s.ResourceReplicaSets:
+		return a.fetchReplicaSets()
+	case k8s.ResourceJobs:
+		return a.fetchJobs()
+	case k8s.ResourceCronJobs:
+		return a.fetchCronJobs()
+	case k8s.ResourceServices:
+		return a.fetchServices()
+	case k8s.ResourceEvents:
+		return a.fetchEvents()
+	case k8s.ResourceNamespaces:
+		return a.fetchNamespaces()
+	}
+	return nil
+}
+
+// applyWatchDelta applies a single add/update/delete to the cached slices.
+// It reports whether anything changed.
+func (a *App) applyWatchDelta(ev k8s.WatchEvent) bool {
+	switch obj := ev.Object.(type) {
+	case domain.Pod:
+		a.pods = applyDelta(a.pods, obj, ev.Type, func(p domain.Pod) string { return p.Namespace + "/" + p.Name })
+	case domain.Deployment:
+		a.deployments = applyDelta(a.deployments, obj, ev.Type, func(d domain.Deployment) string { return d.Namespace + "/" + d.Name })
+	case domain.StatefulSet:
+		a.statefulSets = applyDelta(a.statefulSets, obj, ev.Type, func(s domain.StatefulSet) string { return s.Namespace + "/" + s.Name })
+	case domain.DaemonSet:
+		a.daemonSets = applyDelta(a.daemonSets, obj, ev.Type, func(d domain.DaemonSet) string { return d.Namespace + "/" + d.Name })
+	case domain.ReplicaSet:
+		a.replicaSets = applyDelta(a.replicaSets, obj, ev.Type, func(r domain.ReplicaSet) string { return r.Namespace + "/" + r.Name })
+	case domain.Job:
+		a.jobs = applyDelta(a.jobs, obj, ev.Type, func(j domain.Job) string { return j.Namespace + "/" + j.Name })
+	case domain.CronJob:
+		a.cronJobs = applyDelta(a.cronJobs, obj, ev.Type, func(cj domain.CronJob) string { return cj.Namespace + "/" + cj.Name })
+	case domain.Service:
+		a.services = applyDelta(a.services, obj, ev.Type, func(s domain.Service) string { return s.Namespace + "/" + s.Name })
+	case domain.Event:
+		a.events = applyDelta(a.events, obj, ev.Type, func(e domain.Event) string { return e.Namespace + "/" + e.Name })
+		// Events are shown oldest first
+		slices.SortStableFunc(a.events, func(x, y domain.Event) int {
+			return x.LastSeenTime.Compare(y.LastSeenTime)
+		})
+	case domain.Namespace:
+		a.namespaces = applyDelta(a.namespaces, obj, ev.Type, func(ns domain.Namespace) string { return ns.Name })
+	default:
+		return false
+	}
+	return true
+}
+
+// refreshWatchedList redraws a list after deltas were applied, keeping its selection
+func (a *App) refreshWatchedList(resource k8s.Resource) tea.Cmd {
+	switch resource {
+	case k8s.ResourcePods:
+		pods := a.scopePods(a.pods)
+		a.podCount = len(pods)
+		return updatePodList(&a.podList, pods)
+	case k8s.ResourceDeployments:
+		a.deploymentCount = len(a.deployments)
+		return updateDeploymentList(&a.deploymentList, a.deployments)
+	case k8s.ResourceStatefulSets:
+		a.statefulSetCount = len(a.statefulSets)
+		return updateStatefulSetList(&a.statefulSetList, a.statefulSets)
+	case k8s.ResourceDaemonSets:
+		a.daemonSetCount = len(a.daemonSets)
+		return updateDaemonSetList(&a.daemonSetList, a.daemonSets)
+	case k8s.ResourceReplicaSets:
+		a.replicaSetCount = len(a.replicaSets)
+		return updateReplicaSetList(&a.replicaSetList, a.replicaSets)
+	case k8s.ResourceJobs:
+		a.jobCount = len(a.jobs)
+		return updateJobList(&a.jobList, a.jobs)
+	case k8s.ResourceCronJobs:
+		a.cronJobCount = len(a.cronJobs)
+		return updateCronJobList(&a.cronJobList, a.cronJobs)
+	case k8s.ResourceServices:
+		a.serviceCount = len(a.services)
+		return updateServiceList(&a.serviceList, a.services)
+	case k8s.ResourceEvents:
+		// A paused event view stays put until follow is turned back on
+		if a.eventViewer.IsFollowing() {
+			a.eventViewer.SetEvents(a.events)
+		}
+	case k8s.ResourceNamespaces:
+		a.namespaceCount = len(a.namespaces)
+		return updateNamespaceList(&a.namespaceList, a.namespaces)
+	}
+	return nil
+}
+
+// applyDelta adds, replaces or removes an item in a slice kept sorted by key.
+// The slice is copied so lists holding the previous version are not affected.
+func applyDelta[T any](items []T, item T, deltaType k8s.DeltaType, key func(T) string) []T {
+	k := key(item)
+	items = slices.Clone(items)
+	i := slices.IndexFunc(items, func(x T) bool { return key(x) == k })
+
+	switch {
+	case deltaType == k8s.DeltaDeleted:
+		if i >= 0 {
+			items = slices.Delete(items, i, i+1)
+		}
+	case i >= 0:
+		items[i] = item
+	default:
+		pos, _ := slices.BinarySearchFunc(items, k, func(x T, k string) int {
+			return strings.Compare(key(x), k)
+		})
+		items = slices.Insert(items, pos, item)
+	}
+	return items
+}
+
+// Port-forward handlers
+
+// portForwardRefreshInterval is how often the port-forward panel redraws traffic counters
+const portForwardRefreshInterval = time.Second
+
+// podPortOptions lists the declared container ports of a pod
+func podPortOptions(pod *domain.Pod) []PortOption {
+	var opts []PortOption
+	for _, c := range pod.Containers {
+		for _, p := range c.Ports {
+			label := fmt.Sprintf("%d/%s (%s)", p.ContainerPort, p.Protocol, c.Name)
+			if p.Name != "" {
+				label = fmt.Sprintf("%d/%s %s (%s)", p.ContainerPort, p.Protocol, p.Name, c.Name)
+			}
+			opts = append(opts, PortOption{Label: label, Port: p.ContainerPort})
+		}
+	}
+	return opts
+}
+
+// showServicePortForward opens the port-forward dialog for a service
+func (a *App) showServicePortForward(svc *domain.Service) tea.Cmd {
+	opts := make([]PortOption, 0, len(svc.PortDetails))
+	for _, p := range svc.PortDetails {
+		label := fmt.Sprintf("%d/%s → %s", p.Port, p.Protocol, p.TargetPort)
+		if p.Name != "" {
+			label = fmt.Sprintf("%d/%s %s → %s", p.Port, p.Protocol, p.Name, p.TargetPort)
+		}
+		opts = append(opts, PortOption{Label: label, Port: p.Port})
+	}
+	if len(opts) == 0 {
+		return a.notification.Show(
+			fmt.Sprintf("Service '%s' exposes no ports", svc.Name),
+			NotificationWarning,
+		)
+	}
+	return a.portForwardDialog.Show(domain.PortForwardKindService, svc.Namespace, svc.Name, opts)
+}
+
+// startPortForwardFromDialog returns a command that starts the forward configured in the dialog
+func (a *App) startPortForwardFromDialog() tea.Cmd {
+	req := k8s.PortForwardRequest{
+		Kind:      a.portForwardDialog.Kind(),
+		Namespace: a.portForwardDialog.Namespace(),
+		Name:      a.portForwardDialog.Name(),
+		Port:      a.portForwardDialog.RemotePort(),
+		LocalPort: a.portForwardDialog.LocalPort(),
+	}
+	target := fmt.Sprintf("%s/%s", req.Kind, req.Name)
+	client := a.k8sClient
+	return func() tea.Msg {
+		if client == nil {
+			return portForwardStartedMsg{target: target, err: fmt.Errorf("not connected to cluster")}
+		}
+		pf, err := client.StartPortForward(req)
+		return portForwardStartedMsg{forward: pf, target: target, err: err}
+	}
+}
+
+// waitPortForward returns a command that reports when a port-forward has ended
+func waitPortForward(pf *k8s.PortForward) tea.Cmd {
+	return func() tea.Msg {
+		<-pf.Done()
+		return portForwardEndedMsg{id: pf.ID()}
+	}
+}
+
+// schedulePortForwardRefresh schedules the next redraw of the port-forward panel
+func (a *App) schedulePortForwardRefresh() tea.Cmd {
+	return tea.Tick(portForwardRefreshInterval, func(t time.Time) tea.Msg {
+		return portForwardTickMsg{}
+	})
+}
+
+// portForwardInfos returns snapshots of all managed port-forwards
+func (a *App) portForwardInfos() []domain.PortForward {
+	infos := make([]domain.PortForward, len(a.portForwards))
+	for i, pf := range a.portForwards {
+		infos[i] = pf.Info()
+	}
+	return infos
+}
+
+// stopPortForward returns a command that stops a port-forward and removes it from the panel
+func (a *App) stopPortForward(id int) tea.Cmd {
+	for i, pf := range a.portForwards {
+		if pf.ID() != id {
+			continue
+		}
+		pf.Stop()
+		a.portForwards = append(a.portForwards[:i], a.portForwards[i+1:]...)
+		updatePortForwardList(&a.portForwardList, a.portForwardInfos())
+		info := pf.Info()
+		return a.notification.Show(
+			fmt.Sprintf("Stopped forward 127.0.0.1:%d → %s/%s", info.LocalPort, info.Kind, info.Target),
+			NotificationInfo,
+		)
+	}
+	return nil
+}
+
+// stopAllPortForwards tears down every managed port-forward
+func (a *App) stopAllPortForwards() {
+	for _, pf := range a.portForwards {
+		pf.Stop()
+	}
+	a.portForwards = nil
+	updatePortForwardList(&a.portForwardList, nil)
+}
+
+func (a *App) handlePortForwardStarted(msg portForwardStartedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		logger.Error("Port-forward failed", "target", msg.target, "err", msg.err)
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Port-forward to %s failed: %v", msg.target, msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	a.portForwards = append(a.portForwards, msg.forward)
+	updatePortForwardList(&a.portForwardList, a.portForwardInfos())
+
+	info := msg.forward.Info()
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Forwarding 127.0.0.1:%d → %s:%d", info.LocalPort, msg.target, info.RemotePort),
+		NotificationSuccess,
+	)
+	return a, tea.Batch(notifCmd, waitPortForward(msg.forward))
+}
+
+func (a *App) handlePortForwardEnded(msg portForwardEndedMsg) (tea.Model, tea.Cmd) {
+	// Forwards stopped by the user are already gone; anything left ended on its own
+	for i, pf := range a.portForwards {
+		if pf.ID() != msg.id {
+			continue
+		}
+		a.portForwards = append(a.portForwards[:i], a.portForwards[i+1:]...)
+		updatePortForwardList(&a.portForwardList, a.portForwardInfos())
+		info := pf.Info()
+		text := fmt.Sprintf("Port-forward to %s/%s ended", info.Kind, info.Target)
+		if info.Error != "" {
+			text = fmt.Sprintf("%s: %s", text, info.Error)
+		}
+		return a, a.notification.Show(text, NotificationWarning)
+	}
+	return a, nil
+}
+
+// Close releases background resources held by the app.
+// It is safe to call after the program has exited.
+func (a *App) Close() {
+	a.stopLogStream()
+	a.stopMultiPodStreams()
+	a.stopCrictlLogStream()
+	a.stopJournalStream()
+	a.stopAllPortForwards()
+	a.stopWatcher()
+	a.closeSSHConnection()
+}
+
+// Deployment result handlers
+func (a *App) handleDeploymentsResult(msg deploymentsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.deploymentCount = len(msg.deployments)
+	a.deployments = msg.deployments
+	cmd := updateDeploymentList(&a.deploymentList, msg.deployments)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleDeploymentDetailsResult(msg deploymentDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.deploymentDetails.SetDeployment(msg.deployment)
+	updateRevisionList(&a.revisionList, msg.deployment.History, a.markedRevision)
+	a.err = nil
+
+	// Follow the rollout until it is complete or has failed, like kubectl rollout status
+	if !msg.deployment.Rollout.Complete && !msg.deployment.Rollout.Failed && !msg.deployment.Paused {
+		return a, a.scheduleRolloutRefresh()
+	}
+	return a, nil
+}
+
+func (a *App) handleDeploymentRollbackResult(msg deploymentRollbackResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to roll back deployment: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Deployment '%s' rolling back to revision %d...", msg.deploymentName, msg.revision),
+		NotificationSuccess,
+	)
+
+	// Show the rollout progress in the details view
+	a.viewState = ViewDeploymentDetails
+	a.markedRevision = 0
+	return a, tea.Batch(notifCmd, a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName))
+}
+
+func (a *App) handleDeploymentPauseResult(msg deploymentPauseResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to update deployment: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	state := "resumed"
+	if msg.paused {
+		state = "paused"
+	}
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Rollout of deployment '%s' %s", msg.deploymentName, state),
+		NotificationSuccess,
+	)
+	return a, tea.Batch(notifCmd, a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName))
+}
+
+func (a *App) handleDeploymentScaleResult(msg deploymentScaleResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to scale deployment: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Deployment '%s' scaled to %d replicas", msg.deploymentName, msg.replicas),
+		NotificationSuccess,
+	)
+
+	return a, tea.Batch(notifCmd, a.fetchDeployments())
+}
+
+func (a *App) handleDeploymentRestartResult(msg deploymentRestartResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to restart deployment: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Deployment '%s' restarting...", msg.deploymentName),
+		NotificationSuccess,
+	)
+
+	// Restarted from the details view: stay and follow the rollout
+	if a.viewState == ViewDeploymentDetails {
+		return a, tea.Batch(notifCmd, a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName))
+	}
+
+	a.viewState = ViewDeployments
+	a.selectedDeployName = ""
+	return a, tea.Batch(notifCmd, a.fetchDeployments())
+}
+
+func (a *App) handleDeploymentDeleteResult(msg deploymentDeleteResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to delete deployment: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Deployment '%s' deleted", msg.deploymentName),
+		NotificationSuccess,
+	)
+
+	a.viewState = ViewDeployments
+	a.selectedDeployName = ""
+	return a, tea.Batch(notifCmd, a.fetchDeployments())
+}
+
+// StatefulSet result handlers
+func (a *App) handleStatefulSetsResult(msg statefulSetsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.statefulSetCount = len(msg.statefulSets)
+	a.statefulSets = msg.statefulSets
+	cmd := updateStatefulSetList(&a.statefulSetList, msg.statefulSets)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleStatefulSetDetailsResult(msg statefulSetDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.statefulSetDetails.SetStatefulSet(msg.statefulSet)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleStatefulSetScaleResult(msg statefulSetScaleResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to scale statefulset: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("StatefulSet '%s' scaled to %d replicas", msg.statefulSetName, msg.replicas),
+		NotificationSuccess,
+	)
+
+	return a, tea.Batch(notifCmd, a.fetchStatefulSets())
+}
+
+func (a *App) handleStatefulSetRestartResult(msg statefulSetRestartResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to restart statefulset: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("StatefulSet '%s' restarting...", msg.statefulSetName),
+		NotificationSuccess,
+	)
+
+	a.viewState = ViewStatefulSets
+	a.selectedStatefulSetName = ""
+	return a, tea.Batch(notifCmd, a.fetchStatefulSets())
+}
+
+// DaemonSet result handlers
+func (a *App) handleDaemonSetsResult(msg daemonSetsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.daemonSetCount = len(msg.daemonSets)
+	a.daemonSets = msg.daemonSets
+	cmd := updateDaemonSetList(&a.daemonSetList, msg.daemonSets)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleDaemonSetDetailsResult(msg daemonSetDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.daemonSetDetails.SetDaemonSet(msg.daemonSet)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleDaemonSetRestartResult(msg daemonSetRestartResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to restart daemonset: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("DaemonSet '%s' restarting...", msg.daemonSetName),
+		NotificationSuccess,
+	)
+
+	a.viewState = ViewDaemonSets
+	a.selectedDaemonSetName = ""
+	return a, tea.Batch(notifCmd, a.fetchDaemonSets())
+}
+
+// ReplicaSet result handlers
+func (a *App) handleReplicaSetsResult(msg replicaSetsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.replicaSetCount = len(msg.replicaSets)
+	a.replicaSets = msg.replicaSets
+	cmd := updateReplicaSetList(&a.replicaSetList, msg.replicaSets)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleReplicaSetDetailsResult(msg replicaSetDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.replicaSetDetails.SetReplicaSet(msg.replicaSet)
+	a.err = nil
+	return a, nil
+}
+
+// Job result handlers
+func (a *App) handleJobsResult(msg jobsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.jobCount = len(msg.jobs)
+	a.jobs = msg.jobs
+	cmd := updateJobList(&a.jobList, msg.jobs)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleJobDetailsResult(msg jobDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.jobDetails.SetJob(msg.job)
+	a.err = nil
+	return a, nil
+}
+
+// CronJob result handlers
+func (a *App) handleCronJobsResult(msg cronJobsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.cronJobCount = len(msg.cronJobs)
+	a.cronJobs = msg.cronJobs
+	cmd := updateCronJobList(&a.cronJobList, msg.cronJobs)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleCronJobDetailsResult(msg cronJobDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.cronJobDetails.SetCronJob(msg.cronJob)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleCronJobTriggerResult(msg cronJobTriggerResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to run cronjob: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Job '%s' created from cronjob '%s'", msg.jobName, msg.cronJobName),
+		NotificationSuccess,
+	)
+
+	return a, tea.Batch(notifCmd, a.refreshCronJobView())
+}
+
+func (a *App) handleCronJobSuspendResult(msg cronJobSuspendResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to update cronjob: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	state := "resumed"
+	if msg.suspend {
+		state = "suspended"
+	}
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("CronJob '%s' %s", msg.cronJobName, state),
+		NotificationSuccess,
+	)
+
+	return a, tea.Batch(notifCmd, a.refreshCronJobView())
+}
+
+// refreshCronJobView reloads the cronjob list or the open cronjob details
+func (a *App) refreshCronJobView() tea.Cmd {
+	if a.viewState == ViewCronJobDetails && a.selectedCronJobName != "" {
+		return a.fetchCronJobDetails(a.selectedCronJobNamespace, a.selectedCronJobName)
+	}
+	return a.fetchCronJobs()
+}
+
+// Node result handlers
+func (a *App) handleNodesResult(msg nodesResultMsg) (tea.Model, tea.Cmd) {
+	// Nodes are also fetched in the background to link SSH hosts
+	if a.viewState != ViewNodes {
+		if msg.err != nil {
+			logger.Debug("Failed to get nodes", "err", msg.err)
+			return a, nil
+		}
+		a.nodes = msg.nodes
+		updateSSHHostList(&a.sshHostList, a.config.SSHHosts, a.nodes, a.markedSSHHosts)
+		return a, nil
+	}
+
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.nodes = msg.nodes
+	updateSSHHostList(&a.sshHostList, a.config.SSHHosts, a.nodes, a.markedSSHHosts)
+	a.nodeCount = len(msg.nodes)
+	cmd := updateNodeList(&a.nodeList, msg.nodes)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleNodeDetailsResult(msg nodeDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	var sshHost *domain.SSHHost
+	if msg.node != nil {
+		sshHost = domain.FindSSHHost(a.config.SSHHosts, *msg.node)
+	}
+	a.nodeDetails.SetNode(msg.node, sshHost)
+	a.err = nil
+	return a, nil
+}
+
+// handleNodeContainers connects to the SSH host of a node and shows its
+// containers, reusing the SSH connection if it is already to that host
+func (a *App) handleNodeContainers(msg nodeContainersMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to get nodes: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	a.nodes = msg.nodes
+	updateSSHHostList(&a.sshHostList, a.config.SSHHosts, a.nodes, a.markedSSHHosts)
+
+	var host *domain.SSHHost
+	for _, node := range msg.nodes {
+		if node.Name == msg.nodeName {
+			host = domain.FindSSHHost(a.config.SSHHosts, node)
+			break
+		}
+	}
+	if host == nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("No SSH host configured for node '%s'", msg.nodeName),
+			NotificationWarning,
+		)
+		return a, notifCmd
+	}
+
+	focusCrictlPod(&a.crictlContainerList, a.styles, "", "")
+	a.crictlFocus = msg.focus
+	a.crictlReturnView = msg.from
+	a.err = nil
+	a.loading = true
+
+	if a.sshClient != nil && a.selectedSSHHost != nil && a.selectedSSHHost.Name == host.Name {
+		a.viewState = ViewCrictlContainers
+		return a, a.fetchCrictlContainers()
+	}
+
+	a.viewState = ViewSSHConnecting
+	return a, a.connectToSSHHost(*host)
+}
+
+func (a *App) handleNodeCordonResult(msg nodeCordonResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to update node: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	state := "uncordoned"
+	if msg.cordon {
+		state = "cordoned"
+	}
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Node '%s' %s", msg.nodeName, state),
+		NotificationSuccess,
+	)
+
+	return a, tea.Batch(notifCmd, a.refreshNodeView())
+}
+
+func (a *App) handleDrainFinished(msg drainFinishedMsg) (tea.Model, tea.Cmd) {
+	a.stopDrain()
+	a.drainEvents = nil
+	a.drainResult = nil
+	a.drainProgress.Finish(msg.err)
+
+	node := a.drainProgress.Node()
+	var notifCmd tea.Cmd
+	switch {
+	case a.drainProgress.Cancelled():
+		notifCmd = a.notification.Show(fmt.Sprintf("Drain of node '%s' cancelled", node), NotificationWarning)
+	case msg.err != nil:
+		notifCmd = a.notification.Show(fmt.Sprintf("Failed to drain node: %v", msg.err), NotificationError)
+	default:
+		evicted, _ := a.drainProgress.Counts()
+		notifCmd = a.notification.Show(fmt.Sprintf("Node '%s' drained, %d pods evicted", node, evicted), NotificationSuccess)
+	}
+	return a, notifCmd
+}
+
+// refreshNodeView reloads the node list or the open node details
+func (a *App) refreshNodeView() tea.Cmd {
+	if a.viewState == ViewNodeDetails && a.selectedNodeName != "" {
+		return a.fetchNodeDetails(a.selectedNodeName)
+	}
+	if a.viewState == ViewNodes {
+		return a.fetchNodes()
+	}
+	return nil
+}
+
+// showJobPods switches to the pods view narrowed to the pods of a job
+func (a *App) showJobPods(job *domain.Job, returnView ViewState) tea.Cmd {
+	if len(job.Selector) == 0 {
+		return a.notification.Show(fmt.Sprintf("Job '%s' has no pod selector", job.Name), NotificationWarning)
+	}
+	a.selectedJobName = job.Name
+	a.selectedJobNamespace = job.Namespace
+	a.podScope = &podScope{
+		label:      "job: " + job.Name,
+		namespace:  job.Namespace,
+		selector:   job.Selector,
+		returnView: returnView,
+	}
+	a.viewState = ViewPods
+	a.loading = true
+	return tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+}
+
+// YAML viewer result handler
+func (a *App) handleResourceYAMLResult(msg resourceYAMLResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.yamlViewer.SetYAML(msg.yaml)
+	if a.searchInput.IsVisible() {
+		a.searchInput.SetMatchCount(a.yamlViewer.MatchCount())
+	}
+	a.err = nil
+	return a, nil
+}
+
+// Edit result handlers
+func (a *App) handleEditFetched(msg editFetchedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to fetch %s: %v", strings.ToLower(msg.kind), msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	edit, err := newEditSession(msg.kind, msg.namespace, msg.name, msg.yaml, msg.returnView)
+	if err != nil {
+		return a, a.notification.Show(fmt.Sprintf("Edit failed: %v", err), NotificationError)
+	}
+	a.edit = edit
+	return a, a.openEditor()
+}
+
+func (a *App) handleEditorFinished(msg editorFinishedMsg) (tea.Model, tea.Cmd) {
+	if a.edit == nil {
+		return a, nil
+	}
+
+	if msg.err != nil {
+		a.finishEdit()
+		return a, a.notification.Show(fmt.Sprintf("Editor failed: %v", msg.err), NotificationError)
+	}
+
+	content, err := a.edit.readEdit()
+	if err != nil {
+		a.finishEdit()
+		return a, a.notification.Show(fmt.Sprintf("Edit failed: %v", err), NotificationError)
+	}
+
+	switch {
+	case strings.TrimSpace(content) == "" && !a.edit.isKeyEdit():
+		a.finishEdit()
+		return a, a.notification.Show("Edit cancelled, saved file was empty", NotificationInfo)
+	case a.edit.unchanged(content):
+		a.finishEdit()
+		return a, a.notification.Show("Edit cancelled, no changes made", NotificationInfo)
+	case a.edit.lastErr != nil && content == a.edit.edited:
+		// Saved again without fixing the rejected edit
+		lastErr := a.edit.lastErr
+		a.finishEdit()
+		return a, a.notification.Show(fmt.Sprintf("Edit cancelled: %v", lastErr), NotificationError)
+	}
+
+	a.edit.edited = content
+	return a, a.dryRunEdit()
+}
+
+func (a *App) handleEditDryRunResult(msg editDryRunResultMsg) (tea.Model, tea.Cmd) {
+	if a.edit == nil {
+		return a, nil
+	}
+
+	if msg.err != nil {
+		logger.Warn("Edit rejected by dry-run", "kind", a.edit.kind, "target", a.edit.Target(), "err", msg.err)
+		return a, a.reopenEdit(msg.err)
+	}
+
+	a.edit.result = msg.result
+	a.edit.lastErr = nil
+	diff := a.edit.Diff()
+	if diff == "" {
+		a.finishEdit()
+		return a, a.notification.Show("No changes to apply", NotificationInfo)
+	}
+
+	title := fmt.Sprintf("Edit: %s %s", a.edit.kind, a.edit.Target())
+	if a.edit.isKeyEdit() {
+		title += fmt.Sprintf(" [%s]", a.edit.key)
+	}
+	a.diffViewer.SetDiff(title, "Dry-run passed", diff)
+	a.viewState = ViewEditDiff
+	return a, nil
+}
+
+func (a *App) handleEditApplyResult(msg editApplyResultMsg) (tea.Model, tea.Cmd) {
+	if a.edit == nil {
+		return a, nil
+	}
+
+	if msg.err != nil {
+		logger.Warn("Edit rejected", "kind", a.edit.kind, "target", a.edit.Target(), "err", msg.err)
+		return a, a.reopenEdit(msg.err)
+	}
+
+	message := fmt.Sprintf("%s '%s' updated", a.edit.kind, a.edit.name)
+	if a.edit.isKeyEdit() {
+		message = fmt.Sprintf("Key '%s' of %s '%s' updated", a.edit.key, strings.ToLower(a.edit.kind), a.edit.name)
+	}
+	notifCmd := a.notification.Show(message, NotificationSuccess)
+	returnView := a.edit.returnView
+	a.finishEdit()
+	a.viewState = returnView
+	a.loading = true
+	return a, tea.Batch(notifCmd, a.reloadView(returnView))
+}
+
+// Service result handlers
+func (a *App) handleServicesResult(msg servicesResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.serviceCount = len(msg.services)
+	a.services = msg.services
+	cmd := updateServiceList(&a.serviceList, msg.services)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleServiceDetailsResult(msg serviceDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.serviceDetails.SetService(msg.service)
+	a.err = nil
+	return a, nil
+}
+
+// Ingress and IngressRoute result handlers
+func (a *App) handleIngressesResult(msg ingressesResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.ingressCount = len(msg.ingresses)
+	a.ingresses = msg.ingresses
+	cmd := updateIngressList(&a.ingressList, msg.ingresses)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleIngressDetailsResult(msg ingressDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.ingressDetails.SetIngress(msg.ingress)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleIngressRoutesResult(msg ingressRoutesResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.ingressRouteCount = len(msg.routes)
+	a.ingressRoutes = msg.routes
+	cmd := updateIngressRouteList(&a.ingressRouteList, msg.routes)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleIngressRouteDetailsResult(msg ingressRouteDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.ingressRouteDetails.SetIngressRoute(msg.route)
+	a.err = nil
+	return a, nil
+}
+
+// openRouteBackend opens the service details of the backend selected in an
+// Ingress or IngressRoute details view; esc returns to that view
+func (a *App) openRouteBackend(b routeBackend, ok bool) tea.Cmd {
+	if !ok {
+		return a.notification.Show("No backend selected", NotificationWarning)
+	}
+	if b.unsupported != "" {
+		return a.notification.Show("Cannot open backend: "+b.unsupported, NotificationWarning)
+	}
+	a.serviceSourceView = a.viewState
+	a.selectedServiceName = b.service
+	a.selectedServiceNamespace = b.namespace
+	a.viewState = ViewServiceDetails
+	a.loading = true
+	return a.fetchServiceDetails(b.namespace, b.service)
+}
+
+// showServicePods switches to the pods view narrowed to the pods a service
+// selects
+func (a *App) showServicePods(svc *domain.Service, returnView ViewState) tea.Cmd {
+	if len(svc.Selector) == 0 {
+		return a.notification.Show(fmt.Sprintf("Service '%s' has no pod selector", svc.Name), NotificationWarning)
+	}
+	a.selectedServiceName = svc.Name
+	a.selectedServiceNamespace = svc.Namespace
+	a.podScope = &podScope{
+		label:      "service: " + svc.Name,
+		namespace:  svc.Namespace,
+		selector:   svc.Selector,
+		returnView: returnView,
+	}
+	a.viewState = ViewPods
+	a.loading = true
+	return tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+}
+
+// Storage result handlers
+func (a *App) handlePVCsResult(msg pvcsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.pvcCount = len(msg.claims)
+	a.pvcs = msg.claims
+	cmd := updatePVCList(&a.pvcList, msg.claims)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handlePVCDetailsResult(msg pvcDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.pvcDetails.SetClaim(msg.claim)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handlePVsResult(msg pvsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.pvs = msg.volumes
+	cmd := updatePVList(&a.pvList, msg.volumes, a.pvOrphansOnly)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handlePVDetailsResult(msg pvDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.pvDetails.SetVolume(msg.volume)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleStorageClassesResult(msg storageClassesResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.storageClasses = msg.classes
+	cmd := updateStorageClassList(&a.storageClassList, msg.classes)
+	a.err = nil
+	return a, cmd
+}
+
+// showPVCResize opens the resize dialog for a claim
+func (a *App) showPVCResize(pvc *domain.PersistentVolumeClaim) tea.Cmd {
+	if pvc.Requested == "" {
+		return a.notification.Show(fmt.Sprintf("Claim '%s' requests no storage", pvc.Name), NotificationWarning)
+	}
+	return a.pvcResizeDialog.Show(pvc.Namespace, pvc.Name, pvc.Requested)
+}
+
+func (a *App) updatePVCResizeDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
+	confirmed, cancelled, cmd := a.pvcResizeDialog.Update(msg)
+	if confirmed {
+		namespace := a.pvcResizeDialog.Namespace()
+		name := a.pvcResizeDialog.Name()
+		size := a.pvcResizeDialog.Size()
+		a.pvcResizeDialog.Hide()
+		return a, a.resizePVC(namespace, name, size)
+	}
+	if cancelled {
+		a.pvcResizeDialog.Hide()
+	}
+	return a, cmd
+}
+
+func (a *App) handlePVCResizeResult(msg pvcResizeResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to resize claim: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Claim '%s' resize to %s requested", msg.name, msg.size),
+		NotificationSuccess,
+	)
+
+	switch a.viewState {
+	case ViewPVCs:
+		return a, tea.Batch(notifCmd, a.fetchPVCs())
+	case ViewPVCDetails:
+		return a, tea.Batch(notifCmd, a.fetchPVCDetails(a.selectedPVCNamespace, a.selectedPVCName))
+	}
+	return a, notifCmd
+}
+
+// Resource browser result handlers
+func (a *App) handleAPIResourcesResult(msg apiResourcesResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		if a.viewState == ViewAPIResources {
+			a.loading = false
+			a.err = msg.err
+		}
+		if msg.open != "" {
+			return a, a.notification.Show(fmt.Sprintf("Failed to discover API resources: %v", msg.err), NotificationError)
+		}
+		// Discovery in the background for the : prompt fails quietly
+		return a, nil
+	}
+
+	a.apiResources = msg.resources
+	a.resourcePrompt.SetAPIResources(msg.resources)
+	cmd := updateAPIResourceList(&a.apiResourceList, msg.resources)
+
+	if msg.open != "" {
+		res, ok := lookupAPIResource(msg.resources, msg.open)
+		if !ok {
+			return a, a.notification.Show(fmt.Sprintf("Unknown resource '%s'", msg.open), NotificationWarning)
+		}
+		return a, tea.Batch(cmd, a.openResource(res))
+	}
+
+	if a.viewState == ViewAPIResources {
+		a.loading = false
+		a.err = nil
+	}
+	return a, cmd
+}
+
+func (a *App) handleResourcesResult(msg resourcesResultMsg) (tea.Model, tea.Cmd) {
+	// Drop lists of a resource type the browser no longer shows
+	if msg.list != nil && msg.list.Resource.FullName() != a.selectedResource.FullName() {
+		return a, nil
+	}
+
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.resourceData = msg.list
+	cmd := updateResourceList(&a.resourceList, msg.list, a.styles, a.allNamespaces)
+	a.err = nil
+	return a, cmd
+}
+
+// openResource shows the objects of a resource type in the resource browser
+func (a *App) openResource(res domain.APIResource) tea.Cmd {
+	a.podScope = nil
+	a.selectedResource = res
+	a.resourceData = nil
+	a.resourceList.ResetFilter()
+	updateResourceList(&a.resourceList, nil, a.styles, a.allNamespaces)
+	a.viewState = ViewResources
+	a.loading = true
+	return a.fetchResources()
+}
+
+// confirmDeleteResource asks before deleting the object selected in the browser
+func (a *App) confirmDeleteResource() tea.Cmd {
+	item, ok := a.resourceList.SelectedItem().(resourceItem)
+	if !ok {
+		return nil
+	}
+	res := a.selectedResource
+	if !res.Supports("delete") {
+		return a.notification.Show(fmt.Sprintf("%s cannot be deleted", res.FullName()), NotificationWarning)
+	}
+	detail := fmt.Sprintf("Kind: %s (%s)", res.Kind, res.GroupVersion())
+	return a.confirmDialog.ShowDetail(ConfirmActionDeleteResource, item.object.Namespace, item.object.Name, detail)
+}
+
+func (a *App) handleResourceDeleteResult(msg resourceDeleteResultMsg) (tea.Model, tea.Cmd) {
+	kind, _, _ := strings.Cut(msg.kind, ".")
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Failed to delete %s: %v", kind, msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("%s '%s' deleted", kind, msg.name),
+		NotificationSuccess,
+	)
+
+	if a.viewState == ViewResources {
+		return a, tea.Batch(notifCmd, a.fetchResources())
+	}
+	return a, notifCmd
+}
+
+// HelmChart result handlers
+func (a *App) handleHelmChartsResult(msg helmChartsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.helmCharts = msg.charts
+	cmd := updateHelmChartList(&a.helmChartList, msg.charts)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleHelmChartDetailsResult(msg helmChartDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.helmChartDetails.SetChart(msg.chart)
+	a.err = nil
+	return a, nil
+}
+
+// selectedHelmChart returns the chart of the HelmChart list or details view
+func (a *App) selectedHelmChart() *domain.HelmChart {
+	switch a.viewState {
+	case ViewHelmCharts:
+		if item, ok := a.helmChartList.SelectedItem().(helmChartItem); ok {
+			chart := item.chart
+			return &chart
+		}
+	case ViewHelmChartDetails:
+		return a.helmChartDetails.Chart()
+	}
+	return nil
+}
+
+// ConfigMap and Secret result handlers
+func (a *App) handleConfigMapsResult(msg configMapsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.configMapCount = len(msg.configMaps)
+	a.configMaps = msg.configMaps
+	cmd := updateConfigMapList(&a.configMapList, msg.configMaps)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleConfigMapDetailsResult(msg configMapDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.configData.SetConfigMap(msg.configMap)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleSecretsResult(msg secretsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.secretCount = len(msg.secrets)
+	a.secrets = msg.secrets
+	cmd := updateSecretList(&a.secretList, msg.secrets)
+	a.err = nil
+	return a, cmd
+}
+
+func (a *App) handleSecretDetailsResult(msg secretDetailsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.configData.SetSecret(msg.secret)
+	a.err = nil
+	return a, nil
+}
+
+// Event result handler
+func (a *App) handleEventsResult(msg eventsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.events = msg.events
+	a.eventViewer.SetEvents(msg.events)
+	a.err = nil
+	return a, nil
+}
+
+// Metrics result handler
+func (a *App) handleMetricsResult(msg metricsResultMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		// Silently fail - metrics are optional
+		a.podMetrics = nil
+		return a, nil
+	}
+
+	a.podMetrics = msg.metrics
+
+	// Rebuild pod list with new metrics data if metrics are enabled
+	if a.metricsEnabled && a.viewState == ViewPods {
+		a.podList = newPodList(
+			nil,
+			a.podList.Width(),
+			a.podList.Height(),
+			a.styles,
+			a.metricsEnabled,
+			a.podMetrics,
+			a.allNamespaces,
+		)
+		if a.pods != nil {
+			updatePodList(&a.podList, a.scopePods(a.pods))
+		}
+	}
+
+	return a, nil
+}
+
+func (a *App) handleContainersResult(msg containersResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to get containers", "pod", a.selectedPodName, "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	logger.Debug("Got containers for pod", "count", len(msg.containers), "pod", a.selectedPodName, "containers", msg.containers)
+
+	// Set up log viewer with pod and containers
+	a.logViewer.SetPod(a.selectedPodName, a.selectedPodNamespace, msg.containers)
+	a.viewState = ViewLogs
+
+	logger.Debug("Switching to ViewLogs, fetching logs", "container", a.logViewer.Container())
+
+	// Fetch initial logs
+	return a, a.fetchLogs(
+		a.selectedPodNamespace,
+		a.selectedPodName,
+		a.logViewer.Container(),
+		a.logViewer.TailLines(),
+		a.logViewer.Timestamps(),
+	)
+}
+
+func (a *App) handleLogsResult(msg logsResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to get logs", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	logLen := len(msg.logs)
+	logger.Debug("Received logs", "bytes", logLen)
+
+	a.logViewer.SetLogs(msg.logs)
+	a.err = nil
+
+	// Don't auto-start streaming - user must press 'f' to follow
+	return a, nil
+}
+
+func (a *App) handleLogLine(msg logLineMsg) (tea.Model, tea.Cmd) {
+	if a.viewState != ViewLogs {
+		return a, nil
+	}
+
+	a.logViewer.AppendLog(msg.line)
+
+	// Continue reading from stream if active
+	if a.logStreamActive && a.logLineChan != nil {
+		return a, a.waitForLogLine(a.logLineChan)
+	}
+
+	return a, nil
+}
+
+func (a *App) handleLogStreamEnded(msg logStreamEndedMsg) (tea.Model, tea.Cmd) {
+	a.logStreamActive = false
+
+	// If context was cancelled (user stopped follow), just return
+	if msg.err == context.Canceled {
+		return a, nil
+	}
+
+	// If follow mode is still enabled and we're on logs view, auto-restart the stream
+	if a.logViewer.IsFollowing() && a.viewState == ViewLogs {
+		logger.Debug("Log stream ended, auto-restarting (follow still enabled)")
+		return a, a.startLogStreaming()
+	}
+
+	// Show notification if there was an error
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Log stream ended: %v", msg.err),
+			NotificationInfo,
+		)
+		return a, notifCmd
+	}
+
+	return a, nil
+}
+
+// startLogStreaming starts the log streaming using a subscription pattern
+func (a *App) startLogStreaming() tea.Cmd {
+	if a.k8sClient == nil {
+		return nil
+	}
+
+	// Stop any existing stream
+	a.stopLogStream()
+
+	// Create a cancellable context
+	ctx, cancel := context.WithCancel(context.Background())
+	a.logStreamCancel = cancel
+	a.logStreamActive = true
+
+	opts := k8s.LogOptions{
+		Container:  a.logViewer.Container(),
+		TailLines:  0, // Don't re-fetch tail when streaming
+		Timestamps: a.logViewer.Timestamps(),
+		Follow:     true,
+	}
+
+	lineChan := make(chan string, 100)
+	a.logLineChan = lineChan
+
+	// Start streaming in a goroutine
+	go func() {
+		defer close(lineChan)
+		_ = a.k8sClient.StreamPodLogs(
+			ctx,
+			a.logViewer.Namespace(),
+			a.logViewer.PodName(),
+			opts,
+			lineChan,
+		)
+	}()
+
+	// Return a command that reads from the channel
+	return a.waitForLogLine(lineChan)
+}
+
+// waitForLogLine returns a command that waits for the next log line
+func (a *App) waitForLogLine(lineChan <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lineChan
+		if !ok {
+			return logStreamEndedMsg{}
+		}
+		return logLineMsg{line: line}
+	}
+}
+
+// SSH-related commands and handlers
+
+// connectToSSHHost connects to an SSH host and returns a command
+func (a *App) connectToSSHHost(host domain.SSHHost) tea.Cmd {
+	// Close existing connection first
+	a.closeSSHConnection()
+
+	// Create and store client
+	a.sshClient = ssh.NewClient(host)
+	a.selectedSSHHost = &host
+
+	// Bastions of the host, from its ProxyJump
+	jumps, err := a.config.JumpHosts(host)
+	if err != nil {
+		return func() tea.Msg {
+			return sshConnectResultMsg{err: err}
+		}
+	}
+	a.sshClient.SetJumpHosts(jumps)
+
+	return a.retrySSHConnection()
+}
+
+// retrySSHConnection retries SSH connection using the existing client (useful after setting passphrase)
+func (a *App) retrySSHConnection() tea.Cmd {
+	return func() tea.Msg {
+		if a.sshClient == nil {
+			return sshConnectResultMsg{err: fmt.Errorf("no SSH client")}
+		}
+
+		ctx := context.Background()
+
+		if err := a.sshClient.Connect(ctx); err != nil {
+			return sshConnectResultMsg{err: err}
+		}
+
+		if err := a.sshClient.TestConnection(ctx); err != nil {
+			a.sshClient.Close()
+			return sshConnectResultMsg{err: err}
+		}
+
+		return sshConnectResultMsg{err: nil}
+	}
+}
+
+// fetchCrictlContainers returns a command that fetches crictl containers
+func (a *App) fetchCrictlContainers() tea.Cmd {
+	return func() tea.Msg {
+		if a.sshClient == nil {
+			return sshCrictlContainersMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		containers, err := a.sshClient.ListContainers(ctx)
+		return sshCrictlContainersMsg{containers: containers, err: err}
+	}
+}
+
+// fetchNodeInfo returns a command that fetches node information
+func (a *App) fetchNodeInfo() tea.Cmd {
+	return func() tea.Msg {
+		if a.sshClient == nil {
+			return sshNodeInfoMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		info, err := a.sshClient.GetNodeInfo(ctx)
+		return sshNodeInfoMsg{info: info, err: err}
+	}
+}
+
+func (a *App) handleSSHConnectResult(msg sshConnectResultMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		// Check if passphrase is required
+		if msg.err == ssh.ErrPassphraseRequired {
+			// Show passphrase input dialog
+			hostName := ""
+			if a.selectedSSHHost != nil {
+				hostName = a.selectedSSHHost.Name
+			}
+			cmd := a.passphraseInput.Show(hostName)
+			a.viewState = ViewSSHConnecting
+			return a, cmd
+		}
+		// Ask whether to trust a host key seen for the first time
+		var unknown *ssh.UnknownHostKeyError
+		if errors.As(msg.err, &unknown) {
+			cmd := a.hostKeyPrompt.Show(unknown)
+			a.viewState = ViewSSHConnecting
+			return a, cmd
+		}
+		a.err = msg.err
+		a.sshShellPending = false
+		a.viewState = ViewSSHHosts
+		return a, nil
+	}
+
+	// Connection successful - switch to crictl containers view
+	a.viewState = ViewCrictlContainers
+	a.err = nil
+	a.loading = true
+
+	// Fetch containers and node info, and open the shell if one was asked for
+	cmds := []tea.Cmd{a.fetchCrictlContainers(), a.fetchNodeInfo()}
+	if a.sshShellPending {
+		a.sshShellPending = false
+		cmds = append(cmds, a.openSSHShell())
+	}
+	return a, tea.Batch(cmds...)
+}
+
+// openSSHShell suspends the TUI and opens an interactive shell on the
+// connected SSH host, reusing its connection
+func (a *App) openSSHShell() tea.Cmd {
+	if a.sshClient == nil || a.selectedSSHHost == nil {
+		return nil
+	}
+
+	client := a.sshClient
+	hostName := a.selectedSSHHost.Name
+	session := func(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, resize <-chan domain.TerminalSize) error {
+		fmt.Fprintf(stdout, "Connecting to %s, exit the shell to return to k4s...\r\n", hostName)
+		return client.Shell(ctx, ssh.ShellOptions{
+			Stdin:  stdin,
+			Stdout: stdout,
+			Stderr: stderr,
+			Resize: resize,
+		})
+	}
+
+	return tea.Exec(newTerminalCommand(session), func(err error) tea.Msg {
+		return sshShellFinishedMsg{host: hostName, err: err}
+	})
+}
+
+func (a *App) handleSSHShellFinished(msg sshShellFinishedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		logger.Error("SSH shell failed", "host", msg.host, "err", msg.err)
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Shell failed: %v", msg.err),
+			NotificationError,
+		)
+		return a, notifCmd
+	}
+
+	notifCmd := a.notification.Show(
+		fmt.Sprintf("Shell session on '%s' closed", msg.host),
+		NotificationInfo,
+	)
+	return a, notifCmd
+}
+
+func (a *App) handleSSHCrictlContainers(msg sshCrictlContainersMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.crictlContainers = msg.containers
+	updateCrictlContainerList(&a.crictlContainerList, msg.containers)
+	a.err = nil
+
+	// Select the pod the containers were opened for
+	if focus := a.crictlFocus; focus != nil {
+		a.crictlFocus = nil
+		if !focusCrictlPod(&a.crictlContainerList, a.styles, focus.namespace, focus.pod) {
+			notifCmd := a.notification.Show(
+				fmt.Sprintf("No containers of pod '%s' on this node", focus.pod),
+				NotificationWarning,
+			)
+			return a, notifCmd
+		}
+	}
+	return a, nil
+}
+
+// crictlNodeName returns the Kubernetes node of the connected SSH host
+func (a *App) crictlNodeName() string {
+	if a.selectedSSHHost == nil {
+		return ""
+	}
+	if node := domain.FindNodeForSSHHost(a.nodes, *a.selectedSSHHost); node != nil {
+		return node.Name
+	}
+	return ""
+}
+
+func (a *App) handleSSHNodeInfo(msg sshNodeInfoMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		// Non-fatal, just log
+		logger.Debug("Failed to get node info", "err", msg.err)
+		return a, nil
+	}
+
+	a.nodeInfo = msg.info
+	return a, nil
+}
+
+// updateHostKeyPrompt handles the trust-on-first-use prompt. A trusted key is
+// added to known_hosts and the connection is retried.
+func (a *App) updateHostKeyPrompt(msg tea.Msg) (tea.Model, tea.Cmd) {
+	trusted, cancelled, cmd := a.hostKeyPrompt.Update(msg)
+	if trusted {
+		unknown := a.hostKeyPrompt.HostKey()
+		a.hostKeyPrompt.Hide()
+		if err := ssh.TrustHostKey(unknown); err != nil {
+			a.err = err
+			a.viewState = ViewSSHHosts
+			return a, nil
+		}
+		if a.sshClient != nil && a.selectedSSHHost != nil {
+			a.loading = true
+			return a, a.retrySSHConnection()
+		}
+	}
+	if cancelled {
+		a.hostKeyPrompt.Hide()
+		a.closeSSHConnection()
+		a.viewState = ViewSSHHosts
+	}
+	return a, cmd
+}
+
+// closeSSHConnection closes the current SSH connection
+func (a *App) closeSSHConnection() {
+	if a.sshClient != nil {
+		a.sshClient.Close()
+		a.sshClient = nil
+	}
+	a.selectedSSHHost = nil
+	a.nodeInfo = nil
+	a.crictlContainers = nil
+	focusCrictlPod(&a.crictlContainerList, a.styles, "", "")
+}
+
+// execCrictlContainer opens an interactive shell in a container with crictl exec
+func (a *App) execCrictlContainer(container ssh.CrictlContainer) tea.Cmd {
+	if a.sshClient == nil {
+		return nil
+	}
+
+	client := a.sshClient
+	session := func(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, resize <-chan domain.TerminalSize) error {
+		fmt.Fprintf(stdout, "Exec into container %s (%s), exit the shell to return to k4s...\r\n", container.Name, container.ContainerIDShort)
+		return client.ExecContainer(ctx, container.ContainerID, ssh.ShellOptions{
+			Stdin:  stdin,
+			Stdout: stdout,
+			Stderr: stderr,
+			Resize: resize,
+		})
+	}
+
+	return tea.Exec(newTerminalCommand(session), func(err error) tea.Msg {
+		return crictlExecFinishedMsg{container: container.Name, err: err}
+	})
+}
+
+func (a *App) handleCrictlExecFinished(msg crictlExecFinishedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		logger.Error("crictl exec failed", "container", msg.container, "err", msg.err)
+		return a, a.notification.Show(fmt.Sprintf("Exec failed: %v", msg.err), NotificationError)
+	}
+	return a, a.notification.Show(fmt.Sprintf("Exec session in '%s' closed", msg.container), NotificationInfo)
+}
+
+// confirmCrictlContainerAction asks to stop or remove a container
+func (a *App) confirmCrictlContainerAction(action ConfirmAction, container ssh.CrictlContainer) tea.Cmd {
+	if action == ConfirmActionRemoveContainer && container.State == "running" {
+		return a.notification.Show(fmt.Sprintf("Container '%s' is running, stop it first (s)", container.Name), NotificationWarning)
+	}
+	if action == ConfirmActionStopContainer && container.State != "running" {
+		return a.notification.Show(fmt.Sprintf("Container '%s' is not running", container.Name), NotificationWarning)
+	}
+
+	a.crictlAction = &crictlTarget{name: container.Name, ids: []string{container.ContainerID}}
+	detail := fmt.Sprintf("ID: %s", container.ContainerIDShort)
+	if container.PodName != "" {
+		detail += fmt.Sprintf(" · Pod: %s/%s", container.Namespace, container.PodName)
+	}
+	return a.confirmDialog.ShowDetail(action, "", container.Name, detail)
+}
+
+// confirmRemoveCrictlImage asks to remove an image no container uses
+func (a *App) confirmRemoveCrictlImage(item crictlImageItem) tea.Cmd {
+	name := imageDisplayName(item.image)
+	if item.users > 0 {
+		return a.notification.Show(fmt.Sprintf("Image '%s' is used by %d container(s)", name, item.users), NotificationWarning)
+	}
+
+	a.crictlAction = &crictlTarget{name: name, ids: []string{item.image.ImageID}}
+	detail := fmt.Sprintf("ID: %s · Size: %s", item.image.ImageIDShort, formatBytes(int64(item.image.Size)))
+	return a.confirmDialog.ShowDetail(ConfirmActionRemoveImage, "", name, detail)
+}
+
+// confirmPruneCrictlImages asks to remove every image no container uses
+func (a *App) confirmPruneCrictlImages() tea.Cmd {
+	_, unused, unusedCount := crictlImageUsage(a.crictlImageList)
+	if unusedCount == 0 {
+		return a.notification.Show("No unused images to prune", NotificationInfo)
+	}
+
+	a.crictlAction = &crictlTarget{name: fmt.Sprintf("%d unused images", unusedCount)}
+	detail := fmt.Sprintf("%d images, %s", unusedCount, formatBytes(int64(unused)))
+	return a.confirmDialog.ShowDetail(ConfirmActionPruneImages, "", a.crictlHostName(), detail)
+}
+
+// confirmRemoveCrictlSandbox asks to remove a NotReady pod sandbox
+func (a *App) confirmRemoveCrictlSandbox(pod ssh.CrictlPod) tea.Cmd {
+	if pod.Ready() {
+		return a.notification.Show(fmt.Sprintf("Sandbox '%s' is Ready, only NotReady sandboxes can be removed", pod.Name), NotificationWarning)
+	}
+
+	a.crictlAction = &crictlTarget{name: pod.Name, ids: []string{pod.PodID}}
+	return a.confirmDialog.ShowDetail(ConfirmActionRemoveSandbox, pod.Namespace, pod.Name, fmt.Sprintf("ID: %s", pod.PodIDShort))
+}
+
+// confirmPruneCrictlSandboxes asks to remove every NotReady pod sandbox
+func (a *App) confirmPruneCrictlSandboxes() tea.Cmd {
+	var ids []string
+	for _, p := range a.crictlSandboxes {
+		if !p.Ready() {
+			ids = append(ids, p.PodID)
+		}
+	}
+	if len(ids) == 0 {
+		return a.notification.Show("No NotReady sandboxes to remove", NotificationInfo)
+	}
+
+	a.crictlAction = &crictlTarget{name: fmt.Sprintf("%d NotReady sandboxes", len(ids)), ids: ids}
+	return a.confirmDialog.ShowDetail(ConfirmActionPruneSandboxes, "", a.crictlHostName(), fmt.Sprintf("%d sandboxes", len(ids)))
+}
+
+// crictlHostName returns the name of the connected SSH host
+func (a *App) crictlHostName() string {
+	if a.selectedSSHHost == nil {
+		return ""
+	}
+	return a.selectedSSHHost.Name
+}
+
+// crictlActionVerbs names each crictl action for error and success notifications
+var crictlActionVerbs = map[ConfirmAction]struct{ verb, done string }{
+	ConfirmActionStopContainer:   {"stop container", "Container '%s' stopped"},
+	ConfirmActionRemoveContainer: {"remove container", "Container '%s' removed"},
+	ConfirmActionRemoveImage:     {"remove image", "Image '%s' removed"},
+	ConfirmActionPruneImages:     {"prune images", "Pruned %s"},
+	ConfirmActionRemoveSandbox:   {"remove sandbox", "Sandbox '%s' removed"},
+	ConfirmActionPruneSandboxes:  {"remove sandboxes", "Removed %s"},
+	ConfirmActionDeleteSnapshot:  {"delete etcd snapshot", "Snapshot '%s' deleted"},
+	ConfirmActionPruneSnapshots:  {"prune etcd snapshots", "Pruned etcd snapshots of '%s'"},
+}
+
+// runCrictlAction runs a confirmed crictl or etcd snapshot action on the
+// connected node
+func (a *App) runCrictlAction(action ConfirmAction, target *crictlTarget) tea.Cmd {
+	client := a.sshClient
+	if client == nil || target == nil {
+		return nil
+	}
+	a.crictlAction = nil
+
+	return func() tea.Msg {
+		ctx := context.Background()
+		var err error
+		switch action {
+		case ConfirmActionStopContainer:
+			err = client.StopContainer(ctx, target.ids[0])
+		case ConfirmActionRemoveContainer:
+			err = client.RemoveContainer(ctx, target.ids[0])
+		case ConfirmActionRemoveImage:
+			err = client.RemoveImage(ctx, target.ids[0])
+		case ConfirmActionPruneImages:
+			var deleted []string
+			deleted, err = client.PruneImages(ctx)
+			logger.Debug("crictl pruned images", "deleted", deleted)
+		case ConfirmActionRemoveSandbox, ConfirmActionPruneSandboxes:
+			err = client.RemovePodSandboxes(ctx, target.ids...)
+		case ConfirmActionDeleteSnapshot:
+			err = client.DeleteEtcdSnapshots(ctx, target.ids...)
+		case ConfirmActionPruneSnapshots:
+			err = client.PruneEtcdSnapshots(ctx)
+		}
+		return crictlActionMsg{action: action, name: target.name, err: err}
+	}
+}
+
+func (a *App) handleCrictlAction(msg crictlActionMsg) (tea.Model, tea.Cmd) {
+	verbs := crictlActionVerbs[msg.action]
+	if msg.err != nil {
+		logger.Error("crictl action failed", "action", verbs.verb, "target", msg.name, "err", msg.err)
+		return a, a.notification.Show(fmt.Sprintf("Failed to %s: %v", verbs.verb, msg.err), NotificationError)
+	}
+
+	logger.Info("crictl action done", "action", verbs.verb, "target", msg.name)
+	notifCmd := a.notification.Show(fmt.Sprintf(verbs.done, msg.name), NotificationSuccess)
+	switch a.viewState {
+	case ViewCrictlContainers:
+		return a, tea.Batch(notifCmd, a.fetchCrictlContainers())
+	case ViewCrictlImages:
+		return a, tea.Batch(notifCmd, a.fetchCrictlImages())
+	case ViewCrictlSandboxes:
+		return a, tea.Batch(notifCmd, a.fetchCrictlSandboxes())
+	case ViewEtcdSnapshots:
+		return a, tea.Batch(notifCmd, a.fetchEtcdSnapshots())
+	}
+	return a, notifCmd
+}
+
+// fetchCrictlImages returns a command that fetches the node's images and
+// the containers, to tell which images are in use
+func (a *App) fetchCrictlImages() tea.Cmd {
+	client := a.sshClient
+	return func() tea.Msg {
+		if client == nil {
+			return crictlImagesMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		images, err := client.ListImages(ctx)
+		if err != nil {
+			return crictlImagesMsg{err: err}
+		}
+		containers, err := client.ListContainers(ctx)
+		return crictlImagesMsg{images: images, containers: containers, err: err}
+	}
+}
+
+func (a *App) handleCrictlImages(msg crictlImagesMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to list crictl images", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.err = nil
+	a.crictlContainers = msg.containers
+	updateCrictlContainerList(&a.crictlContainerList, msg.containers)
+	a.crictlImages = msg.images
+	updateCrictlImageList(&a.crictlImageList, msg.images, msg.containers)
+	return a, nil
+}
+
+// fetchCrictlSandboxes returns a command that fetches the node's pod
+// sandboxes and the containers in them
+func (a *App) fetchCrictlSandboxes() tea.Cmd {
+	client := a.sshClient
+	return func() tea.Msg {
+		if client == nil {
+			return crictlSandboxesMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		pods, err := client.ListPods(ctx)
+		if err != nil {
+			return crictlSandboxesMsg{err: err}
+		}
+		containers, err := client.ListContainers(ctx)
+		return crictlSandboxesMsg{pods: pods, containers: containers, err: err}
+	}
+}
+
+func (a *App) handleCrictlSandboxes(msg crictlSandboxesMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to list crictl pod sandboxes", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.err = nil
+	a.crictlContainers = msg.containers
+	updateCrictlContainerList(&a.crictlContainerList, msg.containers)
+	a.crictlSandboxes = msg.pods
+	updateCrictlSandboxList(&a.crictlSandboxList, msg.pods, msg.containers)
+	return a, nil
+}
+
+// fetchEtcdSnapshots returns a command that lists the node's etcd snapshots
+// and the members of its etcd cluster
+func (a *App) fetchEtcdSnapshots() tea.Cmd {
+	client := a.sshClient
+	return func() tea.Msg {
+		if client == nil {
+			return etcdSnapshotsMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		snapshots, err := client.ListEtcdSnapshots(ctx)
+		if err != nil {
+			return etcdSnapshotsMsg{err: err}
+		}
+		members, status, membersErr := client.EtcdMembers(ctx)
+		return etcdSnapshotsMsg{snapshots: snapshots, members: members, status: status, membersErr: membersErr}
+	}
+}
+
+func (a *App) handleEtcdSnapshots(msg etcdSnapshotsMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to list etcd snapshots", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+	if msg.membersErr != nil {
+		logger.Warn("Failed to list etcd members", "err", msg.membersErr)
+	}
+
+	a.err = nil
+	a.etcdSnapshots = msg.snapshots
+	a.etcdMembers = msg.members
+	a.etcdStatus = msg.status
+	a.etcdMembersErr = msg.membersErr
+	updateEtcdSnapshotList(&a.etcdSnapshotList, msg.snapshots)
+	a.etcdSnapshotList.SetHeight(a.etcdSnapshotListHeight())
+	return a, nil
+}
+
+// etcdSnapshotListHeight returns the height of the snapshot list, which
+// shares the content area with the etcd members
+func (a *App) etcdSnapshotListHeight() int {
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 8
+	}
+	members := 1
+	if a.etcdMembersErr == nil {
+		members = len(a.etcdMembers)
+	}
+	return max(h-members-3, 3)
+}
+
+func (a *App) updateEtcdSnapshotDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
+	confirmed, cancelled, cmd := a.etcdSnapshotDialog.Update(msg)
+	if confirmed {
+		name := a.etcdSnapshotDialog.Name()
+		a.etcdSnapshotDialog.Hide()
+		notifCmd := a.notification.Show(fmt.Sprintf("Taking etcd snapshot '%s'...", name), NotificationInfo)
+		return a, tea.Batch(notifCmd, a.saveEtcdSnapshot(name))
+	}
+	if cancelled {
+		a.etcdSnapshotDialog.Hide()
+	}
+	return a, cmd
+}
+
+// saveEtcdSnapshot returns a command that takes an on-demand etcd snapshot
+func (a *App) saveEtcdSnapshot(name string) tea.Cmd {
+	client := a.sshClient
+	return func() tea.Msg {
+		if client == nil {
+			return etcdSnapshotSavedMsg{name: name, err: fmt.Errorf("not connected to SSH host")}
+		}
+		return etcdSnapshotSavedMsg{name: name, err: client.SaveEtcdSnapshot(context.Background(), name)}
+	}
+}
+
+func (a *App) handleEtcdSnapshotSaved(msg etcdSnapshotSavedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		logger.Error("etcd snapshot failed", "name", msg.name, "err", msg.err)
+		return a, a.notification.Show(fmt.Sprintf("Failed to take snapshot: %v", msg.err), NotificationError)
+	}
+
+	logger.Info("etcd snapshot taken", "name", msg.name)
+	notifCmd := a.notification.Show(fmt.Sprintf("Snapshot '%s' taken", msg.name), NotificationSuccess)
+	if a.viewState == ViewEtcdSnapshots {
+		return a, tea.Batch(notifCmd, a.fetchEtcdSnapshots())
+	}
+	return a, notifCmd
+}
+
+// confirmDeleteEtcdSnapshot asks to delete an etcd snapshot
+func (a *App) confirmDeleteEtcdSnapshot(snapshot ssh.EtcdSnapshot) tea.Cmd {
+	a.crictlAction = &crictlTarget{name: snapshot.Name, ids: []string{snapshot.Name}}
+	detail := fmt.Sprintf("Storage: %s · Size: %s", etcdSnapshotStorage(snapshot), formatBytes(int64(snapshot.Size)))
+	return a.confirmDialog.ShowDetail(ConfirmActionDeleteSnapshot, "", snapshot.Name, detail)
+}
+
+// fetchCrictlInspect returns a command that runs crictl inspect on a container
+func (a *App) fetchCrictlInspect(container ssh.CrictlContainer) tea.Cmd {
+	client := a.sshClient
+	return func() tea.Msg {
+		if client == nil {
+			return crictlInspectMsg{container: container, err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		output, err := client.InspectContainer(ctx, container.ContainerID)
+		return crictlInspectMsg{container: container, output: output, err: err}
+	}
+}
+
+func (a *App) handleCrictlInspect(msg crictlInspectMsg) (tea.Model, tea.Cmd) {
+	if a.viewState != ViewCrictlInspect {
+		return a, nil
+	}
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to inspect container", "container", msg.container.Name, "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	title := fmt.Sprintf("Inspect: %s (%s)", msg.container.Name, msg.container.ContainerIDShort)
+	if err := a.crictlInspect.SetJSON(title, msg.output); err != nil {
+		a.err = err
+		return a, nil
+	}
+	a.err = nil
+	return a, nil
+}
+
+// fetchCrictlStats returns a command that samples crictl stats
+func (a *App) fetchCrictlStats() tea.Cmd {
+	client := a.sshClient
+	return func() tea.Msg {
+		if client == nil {
+			return crictlStatsMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		stats, err := client.ContainerStats(ctx)
+		return crictlStatsMsg{stats: stats, err: err}
+	}
+}
+
+// scheduleCrictlStatsRefresh returns a command that samples stats again after
+// crictlStatsInterval, unless a sample is already scheduled
+func (a *App) scheduleCrictlStatsRefresh() tea.Cmd {
+	if a.crictlStatsWatching {
+		return nil
+	}
+	a.crictlStatsWatching = true
+	return tea.Tick(crictlStatsInterval, func(t time.Time) tea.Msg {
+		return crictlStatsTickMsg{}
+	})
+}
+
+func (a *App) handleCrictlStats(msg crictlStatsMsg) (tea.Model, tea.Cmd) {
+	if a.viewState != ViewCrictlStats {
+		return a, nil
+	}
+
+	if msg.err != nil {
+		logger.Error("Failed to get crictl stats", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.err = nil
+	a.crictlStatsViewer.AddSample(msg.stats)
+	return a, a.scheduleCrictlStatsRefresh()
+}
+
+// sshRunHosts returns the marked SSH hosts in list order, or the selected
+// host when none are marked
+func (a *App) sshRunHosts() []domain.SSHHost {
+	var hosts []domain.SSHHost
+	for _, host := range a.config.SSHHosts {
+		if a.markedSSHHosts[host.Name] {
+			hosts = append(hosts, host)
+		}
+	}
+	if len(hosts) > 0 {
+		return hosts
+	}
+	if item, ok := a.sshHostList.SelectedItem().(sshHostItem); ok {
+		return []domain.SSHHost{item.host}
+	}
+	return nil
+}
+
+// showSSHCommandDialog asks for a command to run on hosts, starting with the
+// command of the last run
+func (a *App) showSSHCommandDialog(hosts []domain.SSHHost) tea.Cmd {
+	if len(hosts) == 0 {
+		return nil
+	}
+	a.sshRunTargets = hosts
+	names := make([]string, len(hosts))
+	for i, host := range hosts {
+		names[i] = host.Name
+	}
+	return a.sshCommandDialog.Show(names, a.config.Snippets(), a.sshRunViewer.Command())
+}
+
+func (a *App) updateSSHCommandDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
+	command, submitted, cancelled, cmd := a.sshCommandDialog.Update(msg)
+	if cancelled {
+		a.sshCommandDialog.Hide()
+		return a, nil
+	}
+	if submitted {
+		a.sshCommandDialog.Hide()
+		return a, a.startSSHRun(command)
+	}
+	return a, cmd
+}
+
+// startSSHRun runs command on every host of the run over its own connection
+// and shows the results as they arrive
+func (a *App) startSSHRun(command string) tea.Cmd {
+	a.stopSSHRun()
+
+	names := make([]string, 0, len(a.sshRunTargets))
+	targets := make([]ssh.HostTarget, 0, len(a.sshRunTargets))
+	var failed []ssh.HostResult
+	for _, host := range a.sshRunTargets {
+		names = append(names, host.Name)
+		jumps, err := a.config.JumpHosts(host)
+		if err != nil {
+			failed = append(failed, ssh.HostResult{Host: host.Name, ExitCode: -1, Err: err})
+			continue
+		}
+		targets = append(targets, ssh.HostTarget{Host: host, Jumps: jumps})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.sshRunCancel = cancel
+	a.sshRunID++
+
+	results := make(chan ssh.HostResult, len(targets))
+	go ssh.RunOnHosts(ctx, targets, command, results)
+
+	logger.Info("Running command on SSH hosts", "command", command, "hosts", len(names))
+	a.sshRunViewer.Start(command, names)
+	for _, r := range failed {
+		a.sshRunViewer.AddResult(r)
+	}
+	a.viewState = ViewSSHRun
+	return a.waitForSSHRunResult(a.sshRunID, results)
+}
+
+// waitForSSHRunResult returns a command that waits for the next host result
+func (a *App) waitForSSHRunResult(runID int, results <-chan ssh.HostResult) tea.Cmd {
+	return func() tea.Msg {
+		r, ok := <-results
+		if !ok {
+			return sshRunFinishedMsg{runID: runID}
+		}
+		return sshRunResultMsg{runID: runID, result: r, results: results}
+	}
+}
+
+// stopSSHRun cancels a running multi-host command
+func (a *App) stopSSHRun() {
+	if a.sshRunCancel != nil {
+		a.sshRunCancel()
+		a.sshRunCancel = nil
+	}
+}
+
+// showSSHRunDiff compares the output of the selected host with the output
+// most hosts returned
+func (a *App) showSSHRunDiff() tea.Cmd {
+	host, result, ok := a.sshRunViewer.Selected()
+	if !ok {
+		return a.notification.Show("Select a host that has finished with tab", NotificationInfo)
+	}
+	if result.Err != nil {
+		return a.notification.Show(fmt.Sprintf("%s did not run the command", host), NotificationWarning)
+	}
+	if !a.sshRunViewer.Differs(host) {
+		return a.notification.Show(fmt.Sprintf("%s has the same output as the majority", host), NotificationInfo)
+	}
+
+	majority, count := a.sshRunViewer.Majority()
+	diff := unifiedDiff(majority+"\n", strings.TrimSpace(result.Output)+"\n",
+		fmt.Sprintf("majority (%d hosts)", count), host)
+	a.diffViewer.SetDiff(fmt.Sprintf("Output: majority → %s", host), a.sshRunViewer.Command(), diff)
+	a.viewState = ViewSSHRunDiff
+	return nil
+}
+
+// fetchCrictlLogs returns a command that fetches crictl container logs
+func (a *App) fetchCrictlLogs() tea.Cmd {
+	return func() tea.Msg {
+		if a.sshClient == nil || a.selectedCrictlContainer == nil {
+			return sshCrictlLogsMsg{err: fmt.Errorf("not connected or no container selected")}
+		}
+
+		ctx := context.Background()
+		opts := ssh.CrictlLogOptions{
+			TailLines:  a.crictlLogViewer.TailLines(),
+			Timestamps: a.crictlLogViewer.Timestamps(),
+		}
+
+		logs, err := a.sshClient.ContainerLogs(ctx, a.selectedCrictlContainer.ContainerID, opts)
+		return sshCrictlLogsMsg{logs: logs, err: err}
+	}
+}
+
+// stopCrictlLogStream stops the current crictl log stream
+func (a *App) stopCrictlLogStream() {
+	if a.crictlLogStreamCancel != nil {
+		a.crictlLogStreamCancel()
+		a.crictlLogStreamCancel = nil
+	}
+	a.crictlLogStreamActive = false
+}
+
+// startCrictlLogStreaming starts the crictl log streaming
+func (a *App) startCrictlLogStreaming() tea.Cmd {
+	if a.sshClient == nil || a.selectedCrictlContainer == nil {
+		return nil
+	}
+
+	// Stop any existing stream
+	a.stopCrictlLogStream()
+
+	// Create a cancellable context
+	ctx, cancel := context.WithCancel(context.Background())
+	a.crictlLogStreamCancel = cancel
+	a.crictlLogStreamActive = true
+
+	opts := ssh.CrictlLogOptions{
+		TailLines:  0, // Don't re-fetch tail when streaming
+		Timestamps: a.crictlLogViewer.Timestamps(),
+		Follow:     true,
+	}
+
+	lineChan := make(chan string, 100)
+	a.crictlLogLineChan = lineChan
+
+	containerID := a.selectedCrictlContainer.ContainerID
+
+	// Start streaming in a goroutine
+	go func() {
+		defer close(lineChan)
+		_ = a.sshClient.StreamContainerLogs(ctx, containerID, opts, lineChan)
+	}()
+
+	// Return a command that reads from the channel
+	return a.waitForCrictlLogLine(lineChan)
+}
+
+// waitForCrictlLogLine returns a command that waits for the next crictl log line
+func (a *App) waitForCrictlLogLine(lineChan <-chan string) tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-lineChan
+		if !ok {
+			return sshCrictlLogStreamEndedMsg{}
+		}
+		return sshCrictlLogLineMsg{line: line}
+	}
+}
+
+func (a *App) handleCrictlLogsResult(msg sshCrictlLogsMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+
+	if msg.err != nil {
+		logger.Error("Failed to get crictl logs", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.crictlLogViewer.SetLogs(msg.logs)
+	a.err = nil
+	return a, nil
+}
+
+func (a *App) handleCrictlLogLine(msg sshCrictlLogLineMsg) (tea.Model, tea.Cmd) {
+	if a.viewState != ViewCrictlLogs {
+		return a, nil
+	}
+
+	a.crictlLogViewer.AppendLog(msg.line)
+
+	// Continue reading from stream if active
+	if a.crictlLogStreamActive && a.crictlLogLineChan != nil {
+		return a, a.waitForCrictlLogLine(a.crictlLogLineChan)
+	}
+
+	return a, nil
+}
+
+func (a *App) handleCrictlLogStreamEnded(msg sshCrictlLogStreamEndedMsg) (tea.Model, tea.Cmd) {
+	a.crictlLogStreamActive = false
+
+	// If context was cancelled (user stopped follow), just return
+	if msg.err == context.Canceled {
+		return a, nil
+	}
+
+	// If follow mode is still enabled and we're on logs view, auto-restart the stream
+	if a.crictlLogViewer.IsFollowing() && a.viewState == ViewCrictlLogs {
+		logger.Debug("Crictl log stream ended, auto-restarting (follow still enabled)")
+		return a, a.startCrictlLogStreaming()
+	}
+
+	// Show notification if there was an error
+	if msg.err != nil {
+		notifCmd := a.notification.Show(
+			fmt.Sprintf("Log stream ended: %v", msg.err),
+			NotificationInfo,
+		)
+		return a, notifCmd
+	}
+
+	return a, nil
+}
+
+// fetchJournal returns a command that fetches the journal of the node's k3s
+// unit, detecting whether the node runs k3s or k3s-agent first
+func (a *App) fetchJournal() tea.Cmd {
+	client := a.sshClient
+	opts := a.journalViewer.Options()
+	return func() tea.Msg {
+		if client == nil {
+			return sshJournalMsg{err: fmt.Errorf("not connected to SSH host")}
+		}
+
+		ctx := context.Background()
+		if opts.Unit == "" {
+			unit, err := client.DetectK3sUnit(ctx)
+			if err != nil {
+				return sshJournalMsg{err: err}
+			}
+			opts.Unit = unit
+		}
+
+		entries, err := client.Journal(ctx, opts)
+		return sshJournalMsg{unit: opts.Unit, entries: entries, err: err}
+	}
+}
+
+// stopJournalStream stops following the journal
+func (a *App) stopJournalStream() {
+	if a.journalStreamCancel != nil {
+		a.journalStreamCancel()
+		a.journalStreamCancel = nil
+	}
+	a.journalStreamActive = false
+}
+
+// startJournalStreaming follows new journal entries
+func (a *App) startJournalStreaming() tea.Cmd {
+	if a.sshClient == nil || a.journalViewer.Unit() == "" {
+		return nil
+	}
+
+	a.stopJournalStream()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.journalStreamCancel = cancel
+	a.journalStreamActive = true
+
+	entryChan := make(chan ssh.JournalEntry, 100)
+	a.journalEntryChan = entryChan
+
+	client := a.sshClient
+	opts := a.journalViewer.Options()
+	go func() {
+		defer close(entryChan)
+		_ = client.StreamJournal(ctx, opts, entryChan)
+	}()
+
+	return a.waitForJournalEntry(entryChan)
+}
+
+// waitForJournalEntry returns a command that waits for the next journal entry
+func (a *App) waitForJournalEntry(entryChan <-chan ssh.JournalEntry) tea.Cmd {
+	return func() tea.Msg {
+		entry, ok := <-entryChan
+		if !ok {
+			return sshJournalStreamEndedMsg{}
+		}
+		return sshJournalEntryMsg{entry: entry}
+	}
+}
+
+func (a *App) handleJournalResult(msg sshJournalMsg) (tea.Model, tea.Cmd) {
+	a.loading = false
+	if a.viewState != ViewK3sJournal {
+		return a, nil
+	}
+
+	if msg.err != nil {
+		logger.Error("Failed to get k3s journal", "err", msg.err)
+		a.err = msg.err
+		return a, nil
+	}
+
+	a.err = nil
+	a.journalViewer.SetUnit(msg.unit)
+	a.journalViewer.SetEntries(msg.entries)
+	if a.searchInput.Query() != "" {
+		a.searchInput.SetMatchCount(a.journalViewer.MatchCount())
+	}
+
+	// Keep following across a refresh or a new time range
+	if a.journalViewer.IsFollowing() {
+		return a, a.startJournalStreaming()
+	}
+	return a, nil
+}
+
+func (a *App) handleJournalEntry(msg sshJournalEntryMsg) (tea.Model, tea.Cmd) {
+	if a.viewState != ViewK3sJournal {
+		return a, nil
+	}
+
+	a.journalViewer.AppendEntry(msg.entry)
+
+	if a.journalStreamActive && a.journalEntryChan != nil {
+		return a, a.waitForJournalEntry(a.journalEntryChan)
+	}
+	return a, nil
+}
+
+func (a *App) handleJournalStreamEnded(msg sshJournalStreamEndedMsg) (tea.Model, tea.Cmd) {
+	a.journalStreamActive = false
+
+	if msg.err == context.Canceled {
+		return a, nil
+	}
+
+	if a.journalViewer.IsFollowing() && a.viewState == ViewK3sJournal {
+		logger.Debug("Journal stream ended, restarting (follow still enabled)")
+		return a, a.startJournalStreaming()
+	}
+
+	if msg.err != nil {
+		return a, a.notification.Show(fmt.Sprintf("Journal stream ended: %v", msg.err), NotificationInfo)
+	}
+	return a, nil
+}
+
+func (a *App) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	key := msg.String()
+	logger.Debug("Key pressed", "key", key, "viewState", a.viewState)
+
+	// Don't handle keys during connection
+	if a.viewState == ViewConnecting {
+		if key == "ctrl+c" {
+			return a, tea.Quit
+		}
+		return a, nil
+	}
+
+	// Handle confirmation dialog if visible
+	if a.confirmDialog.IsVisible() {
+		confirmed, cancelled, cmd := a.confirmDialog.Update(msg)
+		if confirmed {
+			action := a.confirmDialog.Action()
+			namespace := a.confirmDialog.Namespace()
+			targetName := a.confirmDialog.TargetName()
+			a.confirmDialog.Hide()
+			return a, a.runConfirmedAction(action, namespace, targetName)
+		}
+		if cancelled {
+			a.confirmDialog.Hide()
+		}
+		return a, cmd
+	}
+
+	// Handle scale dialog if visible
+	if a.scaleDialog.IsVisible() {
+		confirmed, cancelled, cmd := a.scaleDialog.Update(msg)
+		if confirmed {
+			kind := a.scaleDialog.Kind()
+			namespace := a.scaleDialog.Namespace()
+			name := a.scaleDialog.Name()
+			replicas := a.scaleDialog.TargetReplicas()
+			a.scaleDialog.Hide()
+			return a, a.runScale(kind, namespace, name, replicas)
+		}
+		if cancelled {
+			a.scaleDialog.Hide()
+		}
+		return a, cmd
+	}
+
+	// Handle drain dialog if visible
+	if a.drainDialog.IsVisible() {
+		confirmed, cancelled, cmd := a.drainDialog.Update(msg)
+		if confirmed {
+			node := a.drainDialog.Node()
+			opts := a.drainDialog.Options()
+			a.drainDialog.Hide()
+			return a, a.startDrain(node, opts)
+		}
+		if cancelled {
+			a.drainDialog.Hide()
+		}
+		return a, cmd
+	}
+
+	// Handle port-forward dialog if visible
+	if a.portForwardDialog.IsVisible() {
+		confirmed, cancelled, cmd := a.portForwardDialog.Update(msg)
+		if confirmed {
+			a.portForwardDialog.Hide()
+			return a, a.startPortForwardFromDialog()
+		}
+		if cancelled {
+			a.portForwardDialog.Hide()
+		}
+		return a, cmd
+	}
+
+	// Handle SSH command dialog if visible
+	if a.sshCommandDialog.IsVisible() {
+		return a.updateSSHCommandDialog(msg)
+	}
+
+	// Handle etcd snapshot dialog if visible
+	if a.etcdSnapshotDialog.IsVisible() {
+		return a.updateEtcdSnapshotDialog(msg)
+	}
+	if a.pvcResizeDialog.IsVisible() {
+		return a.updatePVCResizeDialog(msg)
+	}
+
+	// Handle help screen if visible
+	if a.helpScreen.IsVisible() {
+		if key == "?" || key == "esc" {
+			a.helpScreen.Hide()
+		}
+		return a, nil
+	}
+
+	// Handle container selector if visible
+	if a.containerSelector.IsVisible() {
+		selected, cancelled, cmd := a.containerSelector.Update(msg)
+		if selected {
+			container := a.containerSelector.SelectedContainer()
+			a.containerSelector.Hide()
+			return a, a.handleContainerSelected(container)
+		}
+		if cancelled {
+			a.containerSelector.Hide()
+			a.execPod = nil
+		}
+		return a, cmd
+	}
+
+	// Handle pod multi-selector if visible
+	if a.podMultiSelector.IsVisible() {
+		confirmed, cancelled, cmd := a.podMultiSelector.Update(msg)
+		if confirmed {
+			selectedPods := a.podMultiSelector.SelectedPods()
+			a.podMultiSelector.Hide()
+			if len(selectedPods) > 0 {
+				return a, a.startMultiPodStreaming(selectedPods)
+			}
+		}
+		if cancelled {
+			a.podMultiSelector.Hide()
+		}
+		return a, cmd
+	}
+
+	// Handle passphrase input if visible
+	if a.passphraseInput.IsVisible() {
+		passphrase, submitted, cancelled, cmd := a.passphraseInput.Update(msg)
+		if submitted {
+			a.passphraseInput.Hide()
+			if a.sshClient != nil && a.selectedSSHHost != nil {
+				// Set passphrase on existing client and retry connection
+				a.sshClient.SetPassphrase(passphrase)
+				a.loading = true
+				return a, a.retrySSHConnection()
+			}
+		}
+		if cancelled {
+			a.passphraseInput.Hide()
+			a.viewState = ViewSSHHosts
+		}
+		return a, cmd
+	}
+
+	// Handle host key prompt if visible
+	if a.hostKeyPrompt.IsVisible() {
+		return a.updateHostKeyPrompt(msg)
+	}
+
+	// Handle resource prompt if visible
+	if a.resourcePrompt.IsVisible() {
+		input, submitted, cancelled, cmd := a.resourcePrompt.Update(msg)
+		if cancelled {
+			a.resourcePrompt.Hide()
+			return a, nil
+		}
+		if submitted {
+			a.resourcePrompt.Hide()
+			if strings.TrimSpace(input) == "" {
+				return a, nil
+			}
+			if view, ok := lookupResource(input); ok {
+				return a, a.gotoView(view)
+			}
+			// Any other resource opens in the resource browser
+			if res, ok := lookupAPIResource(a.apiResources, input); ok {
+				return a, a.openResource(res)
+			}
+			if a.apiResources == nil {
+				return a, a.fetchAPIResources(input)
+			}
+			return a, a.notification.Show(fmt.Sprintf("Unknown resource '%s'", input), NotificationWarning)
+		}
+		return a, cmd
+	}
+
+	// Handle search input if visible (in log views)
+	if a.searchInput.IsVisible() {
+		query, submitted, cancelled, cmd := a.searchInput.Update(msg)
+		if submitted || cancelled {
+			a.searchInput.Hide()
+			if cancelled {
+				// Clear search
+				if a.viewState == ViewLogs {
+					a.logViewer.SetSearchQuery("")
+				} else if a.viewState == ViewCrictlLogs {
+					a.crictlLogViewer.SetSearchQuery("")
+				} else if a.viewState == ViewK3sJournal {
+					a.journalViewer.SetSearchQuery("")
+				} else if a.viewState == ViewYAML {
+					a.yamlViewer.SetSearchQuery("")
+				} else if a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails {
+					a.configData.SetSearchQuery("")
+				}
+			}
+		} else {
+			// Update search as user types
+			if a.viewState == ViewLogs {
+				a.logViewer.SetSearchQuery(query)
+				a.searchInput.SetMatchCount(a.logViewer.MatchCount())
+			} else if a.viewState == ViewCrictlLogs {
+				a.crictlLogViewer.SetSearchQuery(query)
+				a.searchInput.SetMatchCount(a.crictlLogViewer.MatchCount())
+			} else if a.viewState == ViewK3sJournal {
+				a.journalViewer.SetSearchQuery(query)
+				a.searchInput.SetMatchCount(a.journalViewer.MatchCount())
+			} else if a.viewState == ViewYAML {
+				a.yamlViewer.SetSearchQuery(query)
+				a.searchInput.SetMatchCount(a.yamlViewer.MatchCount())
+			} else if a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails {
+				a.configData.SetSearchQuery(query)
+				a.searchInput.SetMatchCount(a.configData.MatchCount())
+			}
+		}
+		return a, cmd
+	}
+
+	// Handle filter mode for lists
+	if a.viewState == ViewKubeConfigSelect && a.kubeConfigList.SettingFilter() {
+		var cmd tea.Cmd
+		a.kubeConfigList, cmd = a.kubeConfigList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewContextSelect && a.contextList.SettingFilter() {
+		var cmd tea.Cmd
+		a.contextList, cmd = a.contextList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewNamespaces && a.namespaceList.SettingFilter() {
+		var cmd tea.Cmd
+		a.namespaceList, cmd = a.namespaceList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewPods && a.podList.SettingFilter() {
+		var cmd tea.Cmd
+		a.podList, cmd = a.podList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewSSHHosts && a.sshHostList.SettingFilter() {
+		var cmd tea.Cmd
+		a.sshHostList, cmd = a.sshHostList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewCrictlContainers && a.crictlContainerList.SettingFilter() {
+		var cmd tea.Cmd
+		a.crictlContainerList, cmd = a.crictlContainerList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewCrictlImages && a.crictlImageList.SettingFilter() {
+		var cmd tea.Cmd
+		a.crictlImageList, cmd = a.crictlImageList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewCrictlSandboxes && a.crictlSandboxList.SettingFilter() {
+		var cmd tea.Cmd
+		a.crictlSandboxList, cmd = a.crictlSandboxList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewEtcdSnapshots && a.etcdSnapshotList.SettingFilter() {
+		var cmd tea.Cmd
+		a.etcdSnapshotList, cmd = a.etcdSnapshotList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewPortForwards && a.portForwardList.SettingFilter() {
+		var cmd tea.Cmd
+		a.portForwardList, cmd = a.portForwardList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewStatefulSets && a.statefulSetList.SettingFilter() {
+		var cmd tea.Cmd
+		a.statefulSetList, cmd = a.statefulSetList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewDaemonSets && a.daemonSetList.SettingFilter() {
+		var cmd tea.Cmd
+		a.daemonSetList, cmd = a.daemonSetList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewReplicaSets && a.replicaSetList.SettingFilter() {
+		var cmd tea.Cmd
+		a.replicaSetList, cmd = a.replicaSetList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewJobs && a.jobList.SettingFilter() {
+		var cmd tea.Cmd
+		a.jobList, cmd = a.jobList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewCronJobs && a.cronJobList.SettingFilter() {
+		var cmd tea.Cmd
+		a.cronJobList, cmd = a.cronJobList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewNodes && a.nodeList.SettingFilter() {
+		var cmd tea.Cmd
+		a.nodeList, cmd = a.nodeList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewConfigMaps && a.configMapList.SettingFilter() {
+		var cmd tea.Cmd
+		a.configMapList, cmd = a.configMapList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewSecrets && a.secretList.SettingFilter() {
+		var cmd tea.Cmd
+		a.secretList, cmd = a.secretList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewIngresses && a.ingressList.SettingFilter() {
+		var cmd tea.Cmd
+		a.ingressList, cmd = a.ingressList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewIngressRoutes && a.ingressRouteList.SettingFilter() {
+		var cmd tea.Cmd
+		a.ingressRouteList, cmd = a.ingressRouteList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewPVCs && a.pvcList.SettingFilter() {
+		var cmd tea.Cmd
+		a.pvcList, cmd = a.pvcList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewPVs && a.pvList.SettingFilter() {
+		var cmd tea.Cmd
+		a.pvList, cmd = a.pvList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewStorageClasses && a.storageClassList.SettingFilter() {
+		var cmd tea.Cmd
+		a.storageClassList, cmd = a.storageClassList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewAPIResources && a.apiResourceList.SettingFilter() {
+		var cmd tea.Cmd
+		a.apiResourceList, cmd = a.apiResourceList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewResources && a.resourceList.SettingFilter() {
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+	}
+	if a.viewState == ViewHelmCharts && a.helmChartList.SettingFilter() {
+		var cmd tea.Cmd
+		a.helmChartList, cmd = a.helmChartList.Update(msg)
+		return a, cmd
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		a.stopAllPortForwards()
+		return a, tea.Quit
+
+	case "?":
+		// Show help screen (except during connection)
+		if a.viewState != ViewConnecting && a.viewState != ViewSSHConnecting {
+			a.helpScreen.Toggle()
+			return a, nil
+		}
+
+	case "q":
+		switch a.viewState {
+		case ViewMain, ViewNamespaces, ViewPods, ViewPodDetails, ViewLogs, ViewMultiPodLogs, ViewSSHHosts, ViewCrictlContainers, ViewCrictlLogs, ViewCrictlStats, ViewCrictlInspect, ViewCrictlImages, ViewCrictlSandboxes, ViewSSHRun, ViewSSHRunDiff, ViewK3sJournal, ViewEtcdSnapshots, ViewNodeInfo, ViewPortForwards:
+			a.stopSSHRun()          // Cancel a multi-host run
+			a.stopLogStream()       // Clean up any active log stream
+			a.stopMultiPodStreams() // Clean up multi-pod log streams
+			a.stopCrictlLogStream() // Clean up crictl log stream
+			a.stopJournalStream()   // Clean up journal stream
+			a.closeSSHConnection()  // Clean up SSH connection
+			a.stopAllPortForwards() // Tear down port-forwards
+			return a, tea.Quit
+		case ViewKubeConfigSelect, ViewContextSelect:
+			a.stopAllPortForwards()
+			return a, tea.Quit
+		}
+
+	case "enter":
+		switch a.viewState {
+		case ViewEditDiff:
+			// Confirm the reviewed edit
+			if a.edit != nil {
+				return a, a.confirmDialog.Show(ConfirmActionApplyEdit, a.edit.namespace, a.edit.name)
+			}
+		case ViewDeploymentHistory:
+			return a, a.showRevisionDiff()
+		case ViewKubeConfigSelect:
+			if item, ok := a.kubeConfigList.SelectedItem().(kubeConfigItem); ok {
+				return a, a.selectKubeConfig(&item.kubeConfig)
+			}
+		case ViewContextSelect:
+			if item, ok := a.contextList.SelectedItem().(contextItem); ok {
+				a.disconnect()
+				return a, a.connectWithContext(item.context.Name)
+			}
+		case ViewNamespaces:
+			if item, ok := a.namespaceList.SelectedItem().(namespaceItem); ok {
+				a.k8sClient.SetNamespace(item.namespace.Name)
+				a.clusterInfo.Namespace = item.namespace.Name
+				a.setAllNamespaces(false)
+				a.viewState = ViewPods
+				a.loading = true
+				// Re-scope the watch cache, then fetch pods and start auto-refresh
+				watchCmd := a.startWatcher()
+				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh(), watchCmd)
+			}
+		case ViewPods:
+			if item, ok := a.podList.SelectedItem().(podItem); ok {
+				a.selectedPodName = item.pod.Name
+				a.selectedPodNamespace = item.pod.Namespace
+				a.podDetailsFromCrictl = false
+				a.viewState = ViewPodDetails
+				a.loading = true
+				return a, a.fetchPodDetails(item.pod.Namespace, item.pod.Name)
+			}
+		case ViewSSHHosts:
+			if item, ok := a.sshHostList.SelectedItem().(sshHostItem); ok {
+				a.viewState = ViewSSHConnecting
+				a.loading = true
+				a.err = nil
+				a.crictlFocus = nil
+				a.crictlReturnView = ViewSSHHosts
+				a.sshShellPending = false
+				return a, a.connectToSSHHost(item.host)
+			}
+		case ViewCrictlImages:
+			// Show the containers running the image
+			if item, ok := a.crictlImageList.SelectedItem().(crictlImageItem); ok {
+				if item.users == 0 {
+					return a, a.notification.Show(fmt.Sprintf("Image '%s' is not used by any container", imageDisplayName(item.image)), NotificationInfo)
+				}
+				focusCrictlImage(&a.crictlContainerList, a.styles, item.image)
+				a.viewState = ViewCrictlContainers
+				return a, nil
+			}
+		case ViewCrictlSandboxes:
+			// Show the containers of the sandbox's pod
+			if item, ok := a.crictlSandboxList.SelectedItem().(crictlSandboxItem); ok {
+				if !focusCrictlPod(&a.crictlContainerList, a.styles, item.pod.Namespace, item.pod.Name) {
+					focusCrictlPod(&a.crictlContainerList, a.styles, "", "")
+					return a, a.notification.Show(fmt.Sprintf("Sandbox '%s' has no containers", item.pod.Name), NotificationInfo)
+				}
+				a.viewState = ViewCrictlContainers
+				return a, nil
+			}
+		case ViewCrictlContainers:
+			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok {
+				container := item.container
+				a.selectedCrictlContainer = &container
+				nodeName := ""
+				if a.selectedSSHHost != nil {
+					nodeName = a.selectedSSHHost.Name
+				}
+				a.crictlLogViewer.SetContainer(container.ContainerID, container.Name, nodeName)
+				a.viewState = ViewCrictlLogs
+				a.loading = true
+				return a, a.fetchCrictlLogs()
+			}
+		case ViewDeployments:
+			if item, ok := a.deploymentList.SelectedItem().(deploymentItem); ok {
+				a.selectedDeployName = item.deployment.Name
+				a.selectedDeployNamespace = item.deployment.Namespace
+				a.viewState = ViewDeploymentDetails
+				a.loading = true
+				return a, a.fetchDeploymentDetails(item.deployment.Namespace, item.deployment.Name)
+			}
+		case ViewStatefulSets:
+			if item, ok := a.statefulSetList.SelectedItem().(statefulSetItem); ok {
+				a.selectedStatefulSetName = item.statefulSet.Name
+				a.selectedStatefulSetNamespace = item.statefulSet.Namespace
+				a.viewState = ViewStatefulSetDetails
+				a.loading = true
+				return a, a.fetchStatefulSetDetails(item.statefulSet.Namespace, item.statefulSet.Name)
+			}
+		case ViewDaemonSets:
+			if item, ok := a.daemonSetList.SelectedItem().(daemonSetItem); ok {
+				a.selectedDaemonSetName = item.daemonSet.Name
+				a.selectedDaemonSetNamespace = item.daemonSet.Namespace
+				a.viewState = ViewDaemonSetDetails
+				a.loading = true
+				return a, a.fetchDaemonSetDetails(item.daemonSet.Namespace, item.daemonSet.Name)
+			}
+		case ViewReplicaSets:
+			if item, ok := a.replicaSetList.SelectedItem().(replicaSetItem); ok {
+				a.selectedReplicaSetName = item.replicaSet.Name
+				a.selectedReplicaSetNamespace = item.replicaSet.Namespace
+				a.viewState = ViewReplicaSetDetails
+				a.loading = true
+				return a, a.fetchReplicaSetDetails(item.replicaSet.Namespace, item.replicaSet.Name)
+			}
+		case ViewJobs:
+			if item, ok := a.jobList.SelectedItem().(jobItem); ok {
+				a.selectedJobName = item.job.Name
+				a.selectedJobNamespace = item.job.Namespace
+				a.viewState = ViewJobDetails
+				a.loading = true
+				return a, a.fetchJobDetails(item.job.Namespace, item.job.Name)
+			}
+		case ViewCronJobs:
+			if item, ok := a.cronJobList.SelectedItem().(cronJobItem); ok {
+				a.selectedCronJobName = item.cronJob.Name
+				a.selectedCronJobNamespace = item.cronJob.Namespace
+				a.viewState = ViewCronJobDetails
+				a.loading = true
+				return a, a.fetchCronJobDetails(item.cronJob.Namespace, item.cronJob.Name)
+			}
+		case ViewNodes:
+			if item, ok := a.nodeList.SelectedItem().(nodeItem); ok {
+				a.selectedNodeName = item.node.Name
+				a.viewState = ViewNodeDetails
+				a.loading = true
+				return a, a.fetchNodeDetails(item.node.Name)
+			}
+		case ViewConfigMaps:
+			if item, ok := a.configMapList.SelectedItem().(configMapItem); ok {
+				a.selectedConfigMapName = item.configMap.Name
+				a.selectedConfigMapNamespace = item.configMap.Namespace
+				a.configData.Clear()
+				a.viewState = ViewConfigMapDetails
+				a.loading = true
+				return a, a.fetchConfigMapDetails(item.configMap.Namespace, item.configMap.Name)
+			}
+		case ViewSecrets:
+			if item, ok := a.secretList.SelectedItem().(secretItem); ok {
+				a.selectedSecretName = item.secret.Name
+				a.selectedSecretNamespace = item.secret.Namespace
+				a.configData.Clear()
+				a.viewState = ViewSecretDetails
+				a.loading = true
+				return a, a.fetchSecretDetails(item.secret.Namespace, item.secret.Name)
+			}
+		case ViewServices:
+			if item, ok := a.serviceList.SelectedItem().(serviceItem); ok {
+				a.selectedServiceName = item.service.Name
+				a.selectedServiceNamespace = item.service.Namespace
+				a.serviceSourceView = ViewServices
+				a.viewState = ViewServiceDetails
+				a.loading = true
+				return a, a.fetchServiceDetails(item.service.Namespace, item.service.Name)
+			}
+		case ViewIngresses:
+			if item, ok := a.ingressList.SelectedItem().(ingressItem); ok {
+				a.selectedIngressName = item.ingress.Name
+				a.selectedIngressNamespace = item.ingress.Namespace
+				a.viewState = ViewIngressDetails
+				a.loading = true
+				return a, a.fetchIngressDetails(item.ingress.Namespace, item.ingress.Name)
+			}
+		case ViewIngressRoutes:
+			if item, ok := a.ingressRouteList.SelectedItem().(ingressRouteItem); ok {
+				a.selectedIngressRouteName = item.route.Name
+				a.selectedIngressRouteNamespace = item.route.Namespace
+				a.viewState = ViewIngressRouteDetails
+				a.loading = true
+				return a, a.fetchIngressRouteDetails(item.route.Namespace, item.route.Name)
+			}
+		case ViewIngressDetails:
+			if a.ingressDetails.Ingress() != nil {
+				return a, a.openRouteBackend(a.ingressDetails.SelectedBackend())
+			}
+		case ViewIngressRouteDetails:
+			if a.ingressRouteDetails.IngressRoute() != nil {
+				return a, a.openRouteBackend(a.ingressRouteDetails.SelectedBackend())
+			}
+		case ViewPVCs:
+			if item, ok := a.pvcList.SelectedItem().(pvcItem); ok {
+				a.selectedPVCName = item.claim.Name
+				a.selectedPVCNamespace = item.claim.Namespace
+				a.viewState = ViewPVCDetails
+				a.loading = true
+				return a, a.fetchPVCDetails(item.claim.Namespace, item.claim.Name)
+			}
+		case ViewPVs:
+			if item, ok := a.pvList.SelectedItem().(pvItem); ok {
+				a.selectedPVName = item.volume.Name
+				a.pvSourceView = ViewPVs
+				a.viewState = ViewPVDetails
+				a.loading = true
+				return a, a.fetchPVDetails(item.volume.Name)
+			}
+		case ViewAPIResources:
+			if item, ok := a.apiResourceList.SelectedItem().(apiResourceItem); ok {
+				return a, a.openResource(item.resource)
+			}
+		case ViewResources:
+			return a, a.openYAML()
+		case ViewHelmCharts:
+			if item, ok := a.helmChartList.SelectedItem().(helmChartItem); ok {
+				a.selectedHelmChartName = item.chart.Name
+				a.selectedHelmChartNamespace = item.chart.Namespace
+				a.viewState = ViewHelmChartDetails
+				a.loading = true
+				return a, a.fetchHelmChartDetails(item.chart.Namespace, item.chart.Name)
+			}
+		}
+
+	case "v", "V":
+		// Edit the values of a HelmChart, or of its HelmChartConfig (Shift+V)
+		if chart := a.selectedHelmChart(); chart != nil {
+			return a, a.startHelmValuesEdit(chart, msg.String() == "V")
+		}
+		// Open the volume bound to a claim
+		if msg.String() == "v" && a.viewState == ViewPVCDetails && a.pvcDetails.Claim() != nil {
+			pvc := a.pvcDetails.Claim()
+			if pvc.VolumeName == "" {
+				return a, a.notification.Show(fmt.Sprintf("Claim '%s' is not bound", pvc.Name), NotificationWarning)
+			}
+			a.selectedPVName = pvc.VolumeName
+			a.pvSourceView = ViewPVCDetails
+			a.viewState = ViewPVDetails
+			a.loading = true
+			return a, a.fetchPVDetails(pvc.VolumeName)
+		}
+
+	case "o":
+		// Show only the volumes no claim uses any more
+		if a.viewState == ViewPVs {
+			a.pvOrphansOnly = !a.pvOrphansOnly
+			a.pvList.ResetFilter()
+			a.pvList.Select(0)
+			return a, updatePVList(&a.pvList, a.pvs, a.pvOrphansOnly)
+		}
+
+	case "tab":
+		// Select the next backend of an ingress or route
+		switch a.viewState {
+		case ViewIngressDetails:
+			a.ingressDetails.NextBackend()
+			return a, nil
+		case ViewIngressRouteDetails:
+			a.ingressRouteDetails.NextBackend()
+			return a, nil
+		}
+
+	case "r":
+		// Refresh
+		switch a.viewState {
+		case ViewNamespaces:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchNamespaces()
+			}
+		case ViewPods:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchPods()
+			}
+		case ViewPodDetails:
+			if a.k8sClient != nil && a.selectedPodName != "" {
+				a.loading = true
+				return a, a.fetchPodDetails(a.selectedPodNamespace, a.selectedPodName)
+			}
+		case ViewLogs:
+			if a.k8sClient != nil {
+				a.stopLogStream()
+				a.loading = true
+				return a, a.fetchLogs(
+					a.logViewer.Namespace(),
+					a.logViewer.PodName(),
+					a.logViewer.Container(),
+					a.logViewer.TailLines(),
+					a.logViewer.Timestamps(),
+				)
+			}
+		case ViewMain:
+			if a.selectedConfig != nil && a.connectionStatus != domain.StatusConnected {
+				return a, a.connectWithContext(a.selectedContext)
+			}
+		case ViewCrictlContainers:
+			if a.sshClient != nil {
+				a.loading = true
+				return a, a.fetchCrictlContainers()
+			}
+		case ViewCrictlStats:
+			if a.sshClient != nil {
+				return a, a.fetchCrictlStats()
+			}
+		case ViewSSHRun:
+			if !a.sshRunViewer.Running() {
+				return a, a.startSSHRun(a.sshRunViewer.Command())
+			}
+		case ViewCrictlImages:
+			if a.sshClient != nil {
+				a.loading = true
+				return a, a.fetchCrictlImages()
+			}
+		case ViewCrictlSandboxes:
+			if a.sshClient != nil {
+				a.loading = true
+				return a, a.fetchCrictlSandboxes()
+			}
+		case ViewEtcdSnapshots:
+			if a.sshClient != nil {
+				a.loading = true
+				return a, a.fetchEtcdSnapshots()
+			}
+		case ViewCrictlInspect:
+			if a.sshClient != nil && a.selectedCrictlContainer != nil {
+				a.loading = true
+				return a, a.fetchCrictlInspect(*a.selectedCrictlContainer)
+			}
+		case ViewCrictlLogs:
+			if a.sshClient != nil && a.selectedCrictlContainer != nil {
+				a.stopCrictlLogStream()
+				a.loading = true
+				return a, a.fetchCrictlLogs()
+			}
+		case ViewK3sJournal:
+			if a.sshClient != nil {
+				a.stopJournalStream()
+				a.loading = true
+				return a, a.fetchJournal()
+			}
+		case ViewDeployments:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchDeployments()
+			}
+		case ViewDeploymentDetails:
+			if a.k8sClient != nil && a.selectedDeployName != "" {
+				a.loading = true
+				return a, a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName)
+			}
+		case ViewStatefulSets:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchStatefulSets()
+			}
+		case ViewStatefulSetDetails:
+			if a.k8sClient != nil && a.selectedStatefulSetName != "" {
+				a.loading = true
+				return a, a.fetchStatefulSetDetails(a.selectedStatefulSetNamespace, a.selectedStatefulSetName)
+			}
+		case ViewDaemonSets:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchDaemonSets()
+			}
+		case ViewDaemonSetDetails:
+			if a.k8sClient != nil && a.selectedDaemonSetName != "" {
+				a.loading = true
+				return a, a.fetchDaemonSetDetails(a.selectedDaemonSetNamespace, a.selectedDaemonSetName)
+			}
+		case ViewReplicaSets:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchReplicaSets()
+			}
+		case ViewReplicaSetDetails:
+			if a.k8sClient != nil && a.selectedReplicaSetName != "" {
+				a.loading = true
+				return a, a.fetchReplicaSetDetails(a.selectedReplicaSetNamespace, a.selectedReplicaSetName)
+			}
+		case ViewJobs:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchJobs()
+			}
+		case ViewJobDetails:
+			if a.k8sClient != nil && a.selectedJobName != "" {
+				a.loading = true
+				return a, a.fetchJobDetails(a.selectedJobNamespace, a.selectedJobName)
+			}
+		case ViewCronJobs:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchCronJobs()
+			}
+		case ViewCronJobDetails:
+			if a.k8sClient != nil && a.selectedCronJobName != "" {
+				a.loading = true
+				return a, a.fetchCronJobDetails(a.selectedCronJobNamespace, a.selectedCronJobName)
+			}
+		case ViewNodes:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchNodes()
+			}
+		case ViewNodeDetails:
+			if a.k8sClient != nil && a.selectedNodeName != "" {
+				a.loading = true
+				return a, a.fetchNodeDetails(a.selectedNodeName)
+			}
+		case ViewYAML:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchResourceYAML()
+			}
+		case ViewDeploymentHistory:
+			if a.k8sClient != nil && a.selectedDeployName != "" {
+				return a, a.fetchDeploymentDetails(a.selectedDeployNamespace, a.selectedDeployName)
+			}
+		case ViewServices:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchServices()
+			}
+		case ViewServiceDetails:
+			if a.k8sClient != nil && a.selectedServiceName != "" {
+				a.loading = true
+				return a, a.fetchServiceDetails(a.selectedServiceNamespace, a.selectedServiceName)
+			}
+		case ViewConfigMaps:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchConfigMaps()
+			}
+		case ViewConfigMapDetails:
+			if a.k8sClient != nil && a.selectedConfigMapName != "" {
+				a.loading = true
+				return a, a.fetchConfigMapDetails(a.selectedConfigMapNamespace, a.selectedConfigMapName)
+			}
+		case ViewSecrets:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchSecrets()
+			}
+		case ViewSecretDetails:
+			if a.k8sClient != nil && a.selectedSecretName != "" {
+				a.loading = true
+				return a, a.fetchSecretDetails(a.selectedSecretNamespace, a.selectedSecretName)
+			}
+		case ViewIngresses:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchIngresses()
+			}
+		case ViewIngressDetails:
+			if a.k8sClient != nil && a.selectedIngressName != "" {
+				a.loading = true
+				return a, a.fetchIngressDetails(a.selectedIngressNamespace, a.selectedIngressName)
+			}
+		case ViewIngressRoutes:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchIngressRoutes()
+			}
+		case ViewIngressRouteDetails:
+			if a.k8sClient != nil && a.selectedIngressRouteName != "" {
+				a.loading = true
+				return a, a.fetchIngressRouteDetails(a.selectedIngressRouteNamespace, a.selectedIngressRouteName)
+			}
+		case ViewPVCs:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchPVCs()
+			}
+		case ViewPVCDetails:
+			if a.k8sClient != nil && a.selectedPVCName != "" {
+				a.loading = true
+				return a, a.fetchPVCDetails(a.selectedPVCNamespace, a.selectedPVCName)
+			}
+		case ViewPVs:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchPVs()
+			}
+		case ViewPVDetails:
+			if a.k8sClient != nil && a.selectedPVName != "" {
+				a.loading = true
+				return a, a.fetchPVDetails(a.selectedPVName)
+			}
+		case ViewStorageClasses:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchStorageClasses()
+			}
+		case ViewAPIResources:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchAPIResources("")
+			}
+		case ViewResources:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchResources()
+			}
+		case ViewHelmCharts:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, a.fetchHelmCharts()
+			}
+		case ViewHelmChartDetails:
+			if a.k8sClient != nil && a.selectedHelmChartName != "" {
+				a.loading = true
+				return a, a.fetchHelmChartDetails(a.selectedHelmChartNamespace, a.selectedHelmChartName)
+			}
+		case ViewEvents:
+			if a.k8sClient != nil {
+				a.loading = true
+				return a, tea.Batch(a.fetchEvents(), a.scheduleEventRefresh())
+			}
+		case ViewPortForwards:
+			updatePortForwardList(&a.portForwardList, a.portForwardInfos())
+			return a, nil
+		}
+
+	case "l":
+		// Go to logs
+		if a.viewState == ViewPodDetails && a.selectedPodName != "" {
+			// From pod details - pod already selected
+			logger.Debug("Opening logs from pod details", "pod", a.selectedPodName)
+			a.logSourceView = ViewPodDetails
+			a.loading = true
+			return a, a.fetchContainers(a.selectedPodNamespace, a.selectedPodName)
+		}
+		if a.viewState == ViewPods {
+			// From pods list - get selected pod
+			if item, ok := a.podList.SelectedItem().(podItem); ok {
+				a.selectedPodName = item.pod.Name
+				a.selectedPodNamespace = item.pod.Namespace
+				logger.Debug("Opening logs from pods list", "pod", a.selectedPodName)
+				a.logSourceView = ViewPods
+				a.loading = true
+				return a, a.fetchContainers(item.pod.Namespace, item.pod.Name)
+			}
+			logger.Warn("No pod selected in pods list")
+		}
+		if a.viewState == ViewJobDetails && a.jobDetails.Job() != nil {
+			// From job details - newest pod of the job
+			job := a.jobDetails.Job()
+			if len(job.Pods) == 0 {
+				return a, a.notification.Show(fmt.Sprintf("Job '%s' has no pods", job.Name), NotificationWarning)
+			}
+			pod := job.Pods[0]
+			a.selectedPodName = pod.Name
+			a.selectedPodNamespace = pod.Namespace
+			a.logSourceView = ViewJobDetails
+			a.loading = true
+			return a, a.fetchContainers(pod.Namespace, pod.Name)
+		}
+		if a.viewState == ViewHelmChartDetails && a.helmChartDetails.Chart() != nil {
+			// From HelmChart details - newest pod of the install job
+			chart := a.helmChartDetails.Chart()
+			if chart.Job == nil || len(chart.Job.Pods) == 0 {
+				return a, a.notification.Show(fmt.Sprintf("Install job of chart '%s' has no pods", chart.Name), NotificationWarning)
+			}
+			pod := chart.Job.Pods[0]
+			a.selectedPodName = pod.Name
+			a.selectedPodNamespace = pod.Namespace
+			a.logSourceView = ViewHelmChartDetails
+			a.loading = true
+			return a, a.fetchContainers(pod.Namespace, pod.Name)
+		}
+
+	case "L":
+		// Multi-pod log streaming (Shift+L)
+		if a.viewState == ViewPods && a.k8sClient != nil {
+			items := a.podList.Items()
+			pods := make([]domain.Pod, 0, len(items))
+			for _, item := range items {
+				if pi, ok := item.(podItem); ok {
+					pods = append(pods, pi.pod)
+				}
+			}
+			if len(pods) > 0 {
+				return a, a.podMultiSelector.Show(pods, a.allNamespaces)
+			}
+		}
+
+	case "d":
+		// Compare a host's output with the majority
+		if a.viewState == ViewSSHRun {
+			return a, a.showSSHRunDiff()
+		}
+		// Remove a crictl container, image or pod sandbox
+		if a.viewState == ViewCrictlContainers {
+			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok {
+				return a, a.confirmCrictlContainerAction(ConfirmActionRemoveContainer, item.container)
+			}
+			return a, nil
+		}
+		if a.viewState == ViewCrictlImages {
+			if item, ok := a.crictlImageList.SelectedItem().(crictlImageItem); ok {
+				return a, a.confirmRemoveCrictlImage(item)
+			}
+			return a, nil
+		}
+		if a.viewState == ViewCrictlSandboxes {
+			if item, ok := a.crictlSandboxList.SelectedItem().(crictlSandboxItem); ok {
+				return a, a.confirmRemoveCrictlSandbox(item.pod)
+			}
+			return a, nil
+		}
+		if a.viewState == ViewEtcdSnapshots {
+			if item, ok := a.etcdSnapshotList.SelectedItem().(etcdSnapshotItem); ok {
+				return a, a.confirmDeleteEtcdSnapshot(item.snapshot)
+			}
+			return a, nil
+		}
+		// Delete an object in the resource browser
+		if a.viewState == ViewResources {
+			return a, a.confirmDeleteResource()
+		}
+		// Stop port-forward
+		if a.viewState == ViewPortForwards {
+			if item, ok := a.portForwardList.SelectedItem().(portForwardItem); ok {
+				return a, a.stopPortForward(item.forward.ID)
+			}
+			return a, nil
+		}
+		// Delete pod
+		if a.viewState == ViewPodDetails && a.selectedPodName != "" {
+			return a, a.confirmDialog.Show(ConfirmActionDeletePod, a.selectedPodNamespace, a.selectedPodName)
+		}
+		// Also allow deletion from pods list view
+		if a.viewState == ViewPods {
+			if item, ok := a.podList.SelectedItem().(podItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionDeletePod, item.pod.Namespace, item.pod.Name)
+			}
+		}
+		// Delete deployment
+		if a.viewState == ViewDeployments {
+			if item, ok := a.deploymentList.SelectedItem().(deploymentItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionDeleteDeployment, item.deployment.Namespace, item.deployment.Name)
+			}
+		}
+		if a.viewState == ViewDeploymentDetails && a.deploymentDetails.Deployment() != nil {
+			dep := a.deploymentDetails.Deployment()
+			return a, a.confirmDialog.Show(ConfirmActionDeleteDeployment, dep.Namespace, dep.Name)
+		}
+
+	case "R":
+		// Run a command on the marked SSH hosts, or on the hosts of the last run
+		if a.viewState == ViewSSHHosts {
+			return a, a.showSSHCommandDialog(a.sshRunHosts())
+		}
+		if a.viewState == ViewSSHRun && !a.sshRunViewer.Running() {
+			return a, a.showSSHCommandDialog(a.sshRunTargets)
+		}
+		// Restart pod (Shift+R)
+		if a.viewState == ViewPodDetails && a.selectedPodName != "" {
+			return a, a.confirmDialog.Show(ConfirmActionRestartPod, a.selectedPodNamespace, a.selectedPodName)
+		}
+		// Also allow restart from pods list view
+		if a.viewState == ViewPods {
+			if item, ok := a.podList.SelectedItem().(podItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionRestartPod, item.pod.Namespace, item.pod.Name)
+			}
+		}
+		// Rollout restart of workloads
+		switch a.viewState {
+		case ViewDeployments:
+			if item, ok := a.deploymentList.SelectedItem().(deploymentItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionRestartDeployment, item.deployment.Namespace, item.deployment.Name)
+			}
+		case ViewDeploymentDetails:
+			if dep := a.deploymentDetails.Deployment(); dep != nil {
+				return a, a.confirmDialog.Show(ConfirmActionRestartDeployment, dep.Namespace, dep.Name)
+			}
+		case ViewStatefulSets:
+			if item, ok := a.statefulSetList.SelectedItem().(statefulSetItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionRestartStatefulSet, item.statefulSet.Namespace, item.statefulSet.Name)
+			}
+		case ViewStatefulSetDetails:
+			if sts := a.statefulSetDetails.StatefulSet(); sts != nil {
+				return a, a.confirmDialog.Show(ConfirmActionRestartStatefulSet, sts.Namespace, sts.Name)
+			}
+		case ViewDaemonSets:
+			if item, ok := a.daemonSetList.SelectedItem().(daemonSetItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionRestartDaemonSet, item.daemonSet.Namespace, item.daemonSet.Name)
+			}
+		case ViewDaemonSetDetails:
+			if ds := a.daemonSetDetails.DaemonSet(); ds != nil {
+				return a, a.confirmDialog.Show(ConfirmActionRestartDaemonSet, ds.Namespace, ds.Name)
+			}
+		}
+
+	case "f":
+		// Toggle follow mode in log viewer
+		if a.viewState == ViewLogs {
+			following := a.logViewer.ToggleFollowing()
+			if following {
+				// Start streaming
+				return a, a.startLogStreaming()
+			} else {
+				// Stop streaming
+				a.stopLogStream()
+			}
+			return a, nil
+		}
+		// Follow the k3s journal
+		if a.viewState == ViewK3sJournal {
+			if a.journalViewer.ToggleFollowing() {
+				return a, a.startJournalStreaming()
+			}
+			a.stopJournalStream()
+			return a, nil
+		}
+		// Toggle follow mode in crictl log viewer
+		if a.viewState == ViewCrictlLogs {
+			following := a.crictlLogViewer.ToggleFollowing()
+			if following {
+				return a, a.startCrictlLogStreaming()
+			} else {
+				a.stopCrictlLogStream()
+			}
+			return a, nil
+		}
+		// Toggle follow mode in event viewer
+		if a.viewState == ViewEvents {
+			if a.eventViewer.ToggleFollowing() && a.events != nil {
+				a.eventViewer.SetEvents(a.events)
+			}
+			return a, nil
+		}
+		// Toggle follow mode in multi-pod log viewer
+		if a.viewState == ViewMultiPodLogs {
+			a.multiPodLogViewer.ToggleFollowing()
+			return a, nil
+		}
+
+	case "t":
+		// Toggle timestamps in log viewer
+		if a.viewState == ViewLogs {
+			a.logViewer.ToggleTimestamps()
+			a.stopLogStream()
+			a.loading = true
+			return a, a.fetchLogs(
+				a.logViewer.Namespace(),
+				a.logViewer.PodName(),
+				a.logViewer.Container(),
+				a.logViewer.TailLines(),
+				a.logViewer.Timestamps(),
+			)
+		}
+		// Toggle timestamps in crictl log viewer
+		if a.viewState == ViewCrictlLogs && a.selectedCrictlContainer != nil {
+			a.crictlLogViewer.ToggleTimestamps()
+			a.stopCrictlLogStream()
+			a.loading = true
+			return a, a.fetchCrictlLogs()
+		}
+		// Run a cronjob now
+		if a.viewState == ViewCronJobs {
+			if item, ok := a.cronJobList.SelectedItem().(cronJobItem); ok {
+				return a, a.confirmDialog.Show(ConfirmActionTriggerCronJob, item.cronJob.Namespace, item.cronJob.Name)
+			}
+		}
+		if a.viewState == ViewCronJobDetails && a.cronJobDetails.CronJob() != nil {
+			cj := a.cronJobDetails.CronJob()
+			return a, a.confirmDialog.Show(ConfirmActionTriggerCronJob, cj.Namespace, cj.Name)
+		}
+
+	case "J":
+		// Journal of the node's k3s service
+		if a.viewState == ViewCrictlContainers && a.sshClient != nil {
+			nodeName := ""
+			if a.selectedSSHHost != nil {
+				nodeName = a.selectedSSHHost.Name
+			}
+			a.journalViewer.SetNode(nodeName)
+			a.searchInput.Hide()
+			a.err = nil
+			a.viewState = ViewK3sJournal
+			a.loading = true
+			return a, a.fetchJournal()
+		}
+
+	case "p":
+		// Priority filter of the k3s journal
+		if a.viewState == ViewK3sJournal {
+			a.journalViewer.CyclePriority()
+			if a.searchInput.Query() != "" {
+				a.searchInput.SetMatchCount(a.journalViewer.MatchCount())
+			}
+			return a, nil
+		}
+		// Show the pods of a job
+		if a.viewState == ViewJobs {
+			if item, ok := a.jobList.SelectedItem().(jobItem); ok {
+				job := item.job
+				return a, a.showJobPods(&job, ViewJobs)
+			}
+		}
+		if a.viewState == ViewJobDetails && a.jobDetails.Job() != nil {
+			return a, a.showJobPods(a.jobDetails.Job(), ViewJobDetails)
+		}
+		// Show the pods of a service
+		if a.viewState == ViewServices {
+			if item, ok := a.serviceList.SelectedItem().(serviceItem); ok {
+				svc := item.service
+				return a, a.showServicePods(&svc, ViewServices)
+			}
+		}
+		if a.viewState == ViewServiceDetails && a.serviceDetails.Service() != nil {
+			return a, a.showServicePods(a.serviceDetails.Service(), ViewServiceDetails)
+		}
+		// Open the Kubernetes pod of a crictl container
+		if a.viewState == ViewCrictlContainers && a.connectionStatus == domain.StatusConnected {
+			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok && item.container.PodName != "" {
+				a.selectedPodName = item.container.PodName
+				a.selectedPodNamespace = item.container.Namespace
+				a.podDetailsFromCrictl = true
+				a.viewState = ViewPodDetails
+				a.loading = true
+				return a, a.fetchPodDetails(item.container.Namespace, item.container.PodName)
+			}
+		}
+
+	case "S":
+		// Open the containers of a node (or of a pod's node) over SSH
+		switch a.viewState {
+		case ViewNodes:
+			if item, ok := a.nodeList.SelectedItem().(nodeItem); ok {
+				return a, a.openNodeContainers(item.node.Name, nil)
+			}
+		case ViewNodeDetails:
+			if node := a.nodeDetails.Node(); node != nil {
+				return a, a.openNodeContainers(node.Name, nil)
+			}
+		case ViewPodDetails:
+			if pod := a.podDetails.Pod(); pod != nil {
+				if pod.Node == "" {
+					return a, a.notification.Show("Pod is not scheduled on a node", NotificationWarning)
+				}
+				return a, a.openNodeContainers(pod.Node, &crictlFocus{namespace: pod.Namespace, pod: pod.Name})
+			}
+		}
+
+	case ":":
+		// Jump to a resource view by name
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewConnecting && a.viewState != ViewContextSelect {
+			// Discover the API resources in the background so the prompt
+			// can complete them
+			if a.apiResources == nil {
+				return a, tea.Batch(a.resourcePrompt.Show(), a.fetchAPIResources(""))
+			}
+			return a, a.resourcePrompt.Show()
+		}
+
+	case "h":
+		// Rollout history of a deployment
+		if a.viewState == ViewDeploymentDetails && a.deploymentDetails.Deployment() != nil {
+			a.markedRevision = 0
+			updateRevisionList(&a.revisionList, a.deploymentDetails.Deployment().History, a.markedRevision)
+			a.revisionList.Select(0)
+			a.viewState = ViewDeploymentHistory
+			return a, nil
+		}
+
+	case " ":
+		// Mark an SSH host for running a command on several hosts
+		if a.viewState == ViewSSHHosts {
+			if item, ok := a.sshHostList.SelectedItem().(sshHostItem); ok {
+				if a.markedSSHHosts[item.host.Name] {
+					delete(a.markedSSHHosts, item.host.Name)
+				} else {
+					a.markedSSHHosts[item.host.Name] = true
+				}
+				updateSSHHostList(&a.sshHostList, a.config.SSHHosts, a.nodes, a.markedSSHHosts)
+				a.sshHostList.CursorDown()
+			}
+			return a, nil
+		}
+		// Mark a revision as the base of a diff
+		if a.viewState == ViewDeploymentHistory && a.deploymentDetails.Deployment() != nil {
+			if item, ok := a.revisionList.SelectedItem().(revisionItem); ok {
+				if a.markedRevision == item.revision.Revision {
+					a.markedRevision = 0
+				} else {
+					a.markedRevision = item.revision.Revision
+				}
+				updateRevisionList(&a.revisionList, a.deploymentDetails.Deployment().History, a.markedRevision)
+			}
+			return a, nil
+		}
+
+	case "u":
+		// Uncordon a node
+		if a.viewState == ViewNodes {
+			if item, ok := a.nodeList.SelectedItem().(nodeItem); ok {
+				return a, a.setNodeUnschedulable(item.node.Name, false)
+			}
+		}
+		if a.viewState == ViewNodeDetails && a.nodeDetails.Node() != nil {
+			return a, a.setNodeUnschedulable(a.nodeDetails.Node().Name, false)
+		}
+		// Roll back to the selected revision
+		if a.viewState == ViewDeploymentHistory && a.deploymentDetails.Deployment() != nil {
+			if item, ok := a.revisionList.SelectedItem().(revisionItem); ok {
+				if item.revision.Current {
+					return a, a.notification.Show(fmt.Sprintf("Revision %d is already current", item.revision.Revision), NotificationInfo)
+				}
+				dep := a.deploymentDetails.Deployment()
+				a.rollbackRevision = item.revision.Revision
+				detail := fmt.Sprintf("(Revision %d: %s)", item.revision.Revision, strings.Join(item.revision.Images, ", "))
+				return a, a.confirmDialog.ShowDetail(ConfirmActionRollbackDeployment, dep.Namespace, dep.Name, detail)
+			}
+		}
+
+	case "P":
+		// Prune unused images or NotReady pod sandboxes (Shift+P)
+		if a.viewState == ViewCrictlImages {
+			return a, a.confirmPruneCrictlImages()
+		}
+		if a.viewState == ViewCrictlSandboxes {
+			return a, a.confirmPruneCrictlSandboxes()
+		}
+		// Prune etcd snapshots beyond the node's retention (Shift+P)
+		if a.viewState == ViewEtcdSnapshots && a.sshClient != nil {
+			a.crictlAction = &crictlTarget{name: a.crictlHostName()}
+			return a, a.confirmDialog.ShowDetail(ConfirmActionPruneSnapshots, "", a.crictlHostName(), "")
+		}
+		// Pause/resume a deployment rollout (Shift+P)
+		if (a.viewState == ViewDeploymentDetails || a.viewState == ViewDeploymentHistory) && a.deploymentDetails.Deployment() != nil {
+			dep := a.deploymentDetails.Deployment()
+			return a, a.setDeploymentPaused(dep.Namespace, dep.Name, !dep.Paused)
+		}
+
+	case "e":
+		// Exec into a crictl container
+		if a.viewState == ViewCrictlContainers {
+			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok {
+				if item.container.State != "running" {
+					return a, a.notification.Show(fmt.Sprintf("Container '%s' is not running", item.container.Name), NotificationWarning)
+				}
+				return a, a.execCrictlContainer(item.container)
+			}
+			return a, nil
+		}
+		// Edit the selected key of a configmap or secret
+		if a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails {
+			return a, a.startKeyEdit()
+		}
+		// Edit the live object in $EDITOR
+		if kind, namespace, name, ok := a.detailsTarget(); ok {
+			return a, a.startEdit(kind, namespace, name)
+		}
+		if a.viewState == ViewYAML && a.yamlViewer.Content() != "" {
+			return a, a.startEdit(a.yamlViewer.Kind(), a.yamlViewer.Namespace(), a.yamlViewer.Name())
+		}
+		// Back to the editor from the diff
+		if a.viewState == ViewEditDiff && a.edit != nil {
+			return a, a.openEditor()
+		}
+
+	case "y":
+		// Show the live object as YAML
+		if _, _, _, ok := a.detailsTarget(); ok {
+			return a, a.openYAML()
+		}
+		// Copy the output of a multi-host run to the clipboard
+		if a.viewState == ViewSSHRun && !a.sshRunViewer.Running() {
+			if err := copyToClipboard(a.sshRunViewer.Output()); err != nil {
+				return a, a.notification.Show(fmt.Sprintf("Failed to copy: %v", err), NotificationError)
+			}
+			return a, a.notification.Show("Output copied to clipboard", NotificationSuccess)
+		}
+		// Copy the crictl inspect JSON to the clipboard
+		if a.viewState == ViewCrictlInspect && a.crictlInspect.Content() != "" {
+			if err := copyToClipboard(a.crictlInspect.Content()); err != nil {
+				return a, a.notification.Show(fmt.Sprintf("Failed to copy: %v", err), NotificationError)
+			}
+			return a, a.notification.Show("JSON copied to clipboard", NotificationSuccess)
+		}
+		// Copy the YAML to the clipboard
+		if a.viewState == ViewYAML && a.yamlViewer.Content() != "" {
+			if err := copyToClipboard(a.yamlViewer.Content()); err != nil {
+				return a, a.notification.Show(fmt.Sprintf("Failed to copy: %v", err), NotificationError)
+			}
+			return a, a.notification.Show("YAML copied to clipboard", NotificationSuccess)
+		}
+
+	case "m":
+		// Live resource usage of the node's containers
+		if a.viewState == ViewCrictlContainers && a.sshClient != nil {
+			a.crictlStatsViewer.Clear()
+			a.err = nil
+			a.viewState = ViewCrictlStats
+			return a, a.fetchCrictlStats()
+		}
+		// Toggle managedFields in the YAML viewer
+		if a.viewState == ViewYAML {
+			a.yamlViewer.ToggleManagedFields()
+			a.loading = true
+			return a, a.fetchResourceYAML()
+		}
+		// Toggle metrics display in pod list
+		if a.viewState == ViewPods {
+			if a.metricsClient != nil {
+				a.metricsEnabled = !a.metricsEnabled
+				// Rebuild pod list with new metrics setting
+				a.podList = newPodList(
+					nil,
+					a.podList.Width(),
+					a.podList.Height(),
+					a.styles,
+					a.metricsEnabled,
+					a.podMetrics,
+					a.allNamespaces,
+				)
+				// Restore items
+				if a.pods != nil {
+					updatePodList(&a.podList, a.scopePods(a.pods))
+				}
+				// Fetch metrics if enabling
+				if a.metricsEnabled && a.podMetrics == nil {
+					return a, a.fetchMetrics()
+				}
+			} else {
+				a.notification.Show("Metrics not available (metrics-server not installed)", NotificationWarning)
+			}
+			return a, nil
+		}
+
+	case "D":
+		// Drain a node (Shift+D)
+		if a.viewState == ViewNodes {
+			if item, ok := a.nodeList.SelectedItem().(nodeItem); ok {
+				return a, a.drainDialog.Show(item.node.Name)
+			}
+		}
+		if a.viewState == ViewNodeDetails && a.nodeDetails.Node() != nil {
+			return a, a.drainDialog.Show(a.nodeDetails.Node().Name)
+		}
+
+	case "c":
+		// Change container in log viewer
+		if a.viewState == ViewLogs && len(a.logViewer.Containers()) > 1 {
+			return a, a.containerSelector.Show(ContainerSelectLogs, a.logViewer.Containers(), a.logViewer.Container())
+		}
+		// Cordon a node
+		if a.viewState == ViewNodes {
+			if item, ok := a.nodeList.SelectedItem().(nodeItem); ok {
+				return a, a.setNodeUnschedulable(item.node.Name, true)
+			}
+		}
+		if a.viewState == ViewNodeDetails && a.nodeDetails.Node() != nil {
+			return a, a.setNodeUnschedulable(a.nodeDetails.Node().Name, true)
+		}
+
+	case "F":
+		// Start a port-forward (Shift+F)
+		if a.viewState == ViewPodDetails && a.podDetails.Pod() != nil {
+			pod := a.podDetails.Pod()
+			return a, a.portForwardDialog.Show(domain.PortForwardKindPod, pod.Namespace, pod.Name, podPortOptions(pod))
+		}
+		if a.viewState == ViewPods {
+			if item, ok := a.podList.SelectedItem().(podItem); ok {
+				return a, a.portForwardDialog.Show(domain.PortForwardKindPod, item.pod.Namespace, item.pod.Name, podPortOptions(&item.pod))
+			}
+		}
+		if a.viewState == ViewServiceDetails && a.serviceDetails.Service() != nil {
+			svc := a.serviceDetails.Service()
+			return a, a.showServicePortForward(svc)
+		}
+		if a.viewState == ViewServices {
+			if item, ok := a.serviceList.SelectedItem().(serviceItem); ok {
+				svc := item.service
+				return a, a.showServicePortForward(&svc)
+			}
+		}
+
+	case "x":
+		// Exec into a pod container
+		if a.viewState == ViewPodDetails && a.podDetails.Pod() != nil {
+			return a, a.startExec(a.podDetails.Pod())
+		}
+		if a.viewState == ViewPods {
+			if item, ok := a.podList.SelectedItem().(podItem); ok {
+				pod := item.pod
+				return a, a.startExec(&pod)
+			}
+		}
+		// Shell on a node, connecting first unless already connected to it
+		if a.viewState == ViewSSHHosts {
+			if item, ok := a.sshHostList.SelectedItem().(sshHostItem); ok {
+				if a.sshClient != nil && a.selectedSSHHost != nil && a.selectedSSHHost.Name == item.host.Name {
+					return a, a.openSSHShell()
+				}
+				a.viewState = ViewSSHConnecting
+				a.loading = true
+				a.err = nil
+				a.crictlFocus = nil
+				a.crictlReturnView = ViewSSHHosts
+				a.sshShellPending = true
+				return a, a.connectToSSHHost(item.host)
+			}
+		}
+		if a.viewState == ViewCrictlContainers || a.viewState == ViewNodeInfo {
+			return a, a.openSSHShell()
+		}
+		// Reveal or mask secret values
+		if a.viewState == ViewSecretDetails {
+			a.configData.ToggleReveal()
+			a.searchInput.SetMatchCount(a.configData.MatchCount())
+			return a, nil
+		}
+
+	case "i":
+		// Inspect a crictl container
+		if a.viewState == ViewCrictlContainers {
+			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok {
+				container := item.container
+				a.selectedCrictlContainer = &container
+				a.crictlInspect.Clear()
+				a.err = nil
+				a.viewState = ViewCrictlInspect
+				a.loading = true
+				return a, a.fetchCrictlInspect(container)
+			}
+		}
+
+	case "I":
+		// Images of the node (Shift+I)
+		if a.viewState == ViewCrictlContainers && a.sshClient != nil {
+			a.err = nil
+			a.viewState = ViewCrictlImages
+			a.loading = true
+			return a, a.fetchCrictlImages()
+		}
+
+	case "E":
+		// etcd snapshots and members of a server node (Shift+E)
+		if a.viewState == ViewCrictlContainers && a.sshClient != nil {
+			a.err = nil
+			a.viewState = ViewEtcdSnapshots
+			a.loading = true
+			return a, a.fetchEtcdSnapshots()
+		}
+		// Edit the whole configmap or secret (Shift+E)
+		if a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails {
+			if kind, namespace, name, ok := a.detailsTarget(); ok {
+				return a, a.startEdit(kind, namespace, name)
+			}
+		}
+
+	case "B":
+		// Pod sandboxes of the node (Shift+B)
+		if a.viewState == ViewCrictlContainers && a.sshClient != nil {
+			a.err = nil
+			a.viewState = ViewCrictlSandboxes
+			a.loading = true
+			return a, a.fetchCrictlSandboxes()
+		}
+
+	case "A":
+		// Cluster-scoped resources have no namespaces to toggle
+		if a.viewState == ViewResources && !a.selectedResource.Namespaced {
+			return a, a.notification.Show(fmt.Sprintf("%s is cluster-scoped", a.selectedResource.FullName()), NotificationInfo)
+		}
+		// Toggle all-namespaces mode (Shift+A)
+		switch a.viewState {
+		case ViewPods, ViewDeployments, ViewStatefulSets, ViewDaemonSets, ViewReplicaSets, ViewJobs, ViewCronJobs, ViewServices, ViewConfigMaps, ViewSecrets, ViewIngresses, ViewIngressRoutes, ViewPVCs, ViewResources, ViewEvents:
+			if a.k8sClient == nil {
+				return a, nil
+			}
+			a.setAllNamespaces(!a.allNamespaces)
+			a.loading = true
+
+			scope := fmt.Sprintf("Showing namespace '%s'", a.k8sClient.CurrentNamespace())
+			if a.allNamespaces {
+				scope = "Showing all namespaces"
+			}
+			cmds := []tea.Cmd{a.startWatcher(), a.notification.Show(scope, NotificationInfo)}
+			switch a.viewState {
+			case ViewPods:
+				cmds = append(cmds, a.fetchPods())
+			case ViewDeployments:
+				cmds = append(cmds, a.fetchDeployments())
+			case ViewStatefulSets:
+				cmds = append(cmds, a.fetchStatefulSets())
+			case ViewDaemonSets:
+				cmds = append(cmds, a.fetchDaemonSets())
+			case ViewReplicaSets:
+				cmds = append(cmds, a.fetchReplicaSets())
+			case ViewJobs:
+				cmds = append(cmds, a.fetchJobs())
+			case ViewCronJobs:
+				cmds = append(cmds, a.fetchCronJobs())
+			case ViewServices:
+				cmds = append(cmds, a.fetchServices())
+			case ViewConfigMaps:
+				cmds = append(cmds, a.fetchConfigMaps())
+			case ViewSecrets:
+				cmds = append(cmds, a.fetchSecrets())
+			case ViewIngresses:
+				cmds = append(cmds, a.fetchIngresses())
+			case ViewIngressRoutes:
+				cmds = append(cmds, a.fetchIngressRoutes())
+			case ViewPVCs:
+				cmds = append(cmds, a.fetchPVCs())
+			case ViewResources:
+				cmds = append(cmds, a.fetchResources())
+			case ViewEvents:
+				cmds = append(cmds, a.fetchEvents())
+			}
+			if a.metricsEnabled {
+				cmds = append(cmds, a.fetchMetrics())
+			}
+			return a, tea.Batch(cmds...)
+		}
+
+	case "/":
+		// Start search in log, YAML and config data views
+		if a.viewState == ViewLogs || a.viewState == ViewCrictlLogs || a.viewState == ViewK3sJournal || a.viewState == ViewYAML ||
+			a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails {
+			a.searchInput.Show()
+			return a, nil
+		}
+
+	case "s":
+		// Resize a persistent volume claim
+		if a.viewState == ViewPVCs {
+			if item, ok := a.pvcList.SelectedItem().(pvcItem); ok {
+				pvc := item.claim
+				return a, a.showPVCResize(&pvc)
+			}
+		}
+		if a.viewState == ViewPVCDetails && a.pvcDetails.Claim() != nil {
+			return a, a.showPVCResize(a.pvcDetails.Claim())
+		}
+		// Take an on-demand etcd snapshot
+		if a.viewState == ViewEtcdSnapshots && a.sshClient != nil {
+			return a, a.etcdSnapshotDialog.Show(a.crictlHostName())
+		}
+		// Time range of the k3s journal
+		if a.viewState == ViewK3sJournal && a.sshClient != nil {
+			a.journalViewer.CycleSince()
+			a.stopJournalStream()
+			a.loading = true
+			return a, a.fetchJournal()
+		}
+		// Stop a crictl container
+		if a.viewState == ViewCrictlContainers {
+			if item, ok := a.crictlContainerList.SelectedItem().(crictlContainerItem); ok {
+				return a, a.confirmCrictlContainerAction(ConfirmActionStopContainer, item.container)
+			}
+			return a, nil
+		}
+		// Scale deployment
+		if a.viewState == ViewDeployments {
+			if item, ok := a.deploymentList.SelectedItem().(deploymentItem); ok {
+				return a, a.scaleDialog.Show("Deployment", item.deployment.Namespace, item.deployment.Name, item.deployment.Replicas)
+			}
+		}
+		if a.viewState == ViewDeploymentDetails && a.deploymentDetails.Deployment() != nil {
+			dep := a.deploymentDetails.Deployment()
+			return a, a.scaleDialog.Show("Deployment", dep.Namespace, dep.Name, dep.Replicas)
+		}
+		// Scale statefulset
+		if a.viewState == ViewStatefulSets {
+			if item, ok := a.statefulSetList.SelectedItem().(statefulSetItem); ok {
+				return a, a.scaleDialog.Show("StatefulSet", item.statefulSet.Namespace, item.statefulSet.Name, item.statefulSet.Replicas)
+			}
+		}
+		if a.viewState == ViewStatefulSetDetails && a.statefulSetDetails.StatefulSet() != nil {
+			sts := a.statefulSetDetails.StatefulSet()
+			return a, a.scaleDialog.Show("StatefulSet", sts.Namespace, sts.Name, sts.Replicas)
+		}
+		// Suspend/resume cronjob
+		if a.viewState == ViewCronJobs {
+			if item, ok := a.cronJobList.SelectedItem().(cronJobItem); ok {
+				return a, a.setCronJobSuspend(item.cronJob.Namespace, item.cronJob.Name, !item.cronJob.Suspend)
+			}
+		}
+		if a.viewState == ViewCronJobDetails && a.cronJobDetails.CronJob() != nil {
+			cj := a.cronJobDetails.CronJob()
+			return a, a.setCronJobSuspend(cj.Namespace, cj.Name, !cj.Suspend)
+		}
+
+	case "w":
+		// Toggle warnings filter in events view
+		if a.viewState == ViewEvents {
+			a.eventViewer.ToggleWarningsOnly()
+			return a, nil
+		}
+
+	case "k":
+		// Cycle kind filter in events view
+		if a.viewState == ViewEvents {
+			a.eventViewer.CycleKindFilter()
+			return a, nil
+		}
+
+	case "n":
+		// Next search match
+		if a.viewState == ViewLogs && a.logViewer.SearchQuery() != "" {
+			a.searchInput.NextMatch()
+			return a, nil
+		}
+		if a.viewState == ViewCrictlLogs && a.crictlLogViewer.searchQuery != "" {
+			a.searchInput.NextMatch()
+			return a, nil
+		}
+		if a.viewState == ViewK3sJournal && a.journalViewer.searchQuery != "" {
+			a.searchInput.NextMatch()
+			return a, nil
+		}
+		if a.viewState == ViewYAML && a.yamlViewer.SearchQuery() != "" {
+			a.yamlViewer.GotoMatch(a.searchInput.NextMatch())
+			return a, nil
+		}
+		if (a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails) && a.configData.SearchQuery() != "" {
+			a.configData.GotoMatch(a.searchInput.NextMatch())
+			return a, nil
+		}
+
+	case "N":
+		// Previous search match
+		if a.viewState == ViewLogs && a.logViewer.SearchQuery() != "" {
+			a.searchInput.PrevMatch()
+			return a, nil
+		}
+		if a.viewState == ViewCrictlLogs && a.crictlLogViewer.searchQuery != "" {
+			a.searchInput.PrevMatch()
+			return a, nil
+		}
+		if a.viewState == ViewK3sJournal && a.journalViewer.searchQuery != "" {
+			a.searchInput.PrevMatch()
+			return a, nil
+		}
+		if a.viewState == ViewYAML && a.yamlViewer.SearchQuery() != "" {
+			a.yamlViewer.GotoMatch(a.searchInput.PrevMatch())
+			return a, nil
+		}
+		if (a.viewState == ViewConfigMapDetails || a.viewState == ViewSecretDetails) && a.configData.SearchQuery() != "" {
+			a.configData.GotoMatch(a.searchInput.PrevMatch())
+			return a, nil
+		}
+
+	case "1":
+		// Go to namespaces view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewNamespaces {
+			return a, a.gotoView(ViewNamespaces)
+		}
+
+	case "2":
+		// Go to pods view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewPods {
+			return a, a.gotoView(ViewPods)
+		}
+
+	case "3":
+		// Go to deployments view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewDeployments {
+			return a, a.gotoView(ViewDeployments)
+		}
+
+	case "4":
+		// Go to services view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewServices {
+			return a, a.gotoView(ViewServices)
+		}
+
+	case "5":
+		// Go to events view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewEvents {
+			return a, a.gotoView(ViewEvents)
+		}
+
+	case "6":
+		// Go to statefulsets view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewStatefulSets {
+			return a, a.gotoView(ViewStatefulSets)
+		}
+
+	case "7":
+		// Go to daemonsets view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewDaemonSets {
+			return a, a.gotoView(ViewDaemonSets)
+		}
+
+	case "8":
+		// Go to replicasets view
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewReplicaSets {
+			return a, a.gotoView(ViewReplicaSets)
+		}
+
+	case "0":
+		// Go to port-forwards panel
+		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewPortForwards {
+			return a, a.gotoView(ViewPortForwards)
+		}
+
+	case "C":
+		// Switch context within the current kubeconfig (Shift+C)
+		if a.selectedConfig != nil && a.viewState != ViewKubeConfigSelect && a.viewState != ViewContextSelect {
+			return a, a.fetchContexts(a.selectedConfig.Path, true)
+		}
+
+	case "9":
+		// Go to SSH hosts view
+		if len(a.config.SSHHosts) > 0 {
+			a.viewState = ViewSSHHosts
+			a.err = nil
+			// Refresh the nodes the hosts are linked to
+			if a.connectionStatus == domain.StatusConnected {
+				return a, a.fetchNodes()
+			}
+			return a, nil
+		}
+
+	case "esc":
+		switch a.viewState {
+		case ViewMain:
+			// Go back to pods
+			if a.connectionStatus == domain.StatusConnected {
+				a.viewState = ViewPods
+				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+			}
+			// Or go back to kubeconfig selection
+			if len(a.config.KubeConfigs) > 1 {
+				a.viewState = ViewKubeConfigSelect
+				a.disconnect()
+				return a, nil
+			}
+		case ViewLogs:
+			// Stop streaming and go back to where we came from
+			a.stopLogStream()
+			a.logViewer.Clear()
+			if a.logSourceView == ViewPods {
+				// Came from pods list - go back to pods
+				a.viewState = ViewPods
+				a.selectedPodName = ""
+				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+			}
+			if a.logSourceView == ViewJobDetails {
+				// Came from job details - go back to job details
+				a.viewState = ViewJobDetails
+				a.loading = true
+				return a, a.fetchJobDetails(a.selectedJobNamespace, a.selectedJobName)
+			}
+			if a.logSourceView == ViewHelmChartDetails {
+				// Came from HelmChart details - go back to the chart
+				a.viewState = ViewHelmChartDetails
+				a.loading = true
+				return a, a.fetchHelmChartDetails(a.selectedHelmChartNamespace, a.selectedHelmChartName)
+			}
+			// Came from pod details - go back to pod details
+			a.viewState = ViewPodDetails
+			a.loading = true
+			return a, a.fetchPodDetails(a.selectedPodNamespace, a.selectedPodName)
+		case ViewMultiPodLogs:
+			a.stopMultiPodStreams()
+			a.multiPodLogViewer.Clear()
+			a.viewState = ViewPods
+			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+		case ViewPodDetails:
+			// Go back to the crictl containers the pod was opened from
+			if a.podDetailsFromCrictl && a.sshClient != nil {
+				a.podDetailsFromCrictl = false
+				a.selectedPodName = ""
+				a.viewState = ViewCrictlContainers
+				return a, a.fetchCrictlContainers()
+			}
+			// Go back to pods
+			a.viewState = ViewPods
+			a.selectedPodName = ""
+			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+		case ViewPods:
+			// Leave a scoped pods view for the view it was opened from
+			if a.podScope != nil {
+				returnView := a.podScope.returnView
+				a.podScope = nil
+				a.viewState = returnView
+				a.loading = true
+				switch returnView {
+				case ViewJobs:
+					return a, a.fetchJobs()
+				case ViewServices:
+					return a, a.fetchServices()
+				}
+				return a, a.reloadView(returnView)
+			}
+			// Go back to namespaces
+			a.viewState = ViewNamespaces
+			return a, a.fetchNamespaces()
+		case ViewNamespaces:
+			// Go back to kubeconfig selection if multiple configs
+			if len(a.config.KubeConfigs) > 1 {
+				a.viewState = ViewKubeConfigSelect
+				a.disconnect()
+				return a, nil
+			}
+		case ViewContextSelect:
+			// Back to where we were if still connected, otherwise to kubeconfig selection
+			if a.connectionStatus == domain.StatusConnected {
+				a.viewState = ViewPods
+				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+			}
+			if len(a.config.KubeConfigs) > 1 {
+				a.viewState = ViewKubeConfigSelect
+				return a, nil
+			}
+			a.viewState = ViewMain
+			return a, nil
+		case ViewSSHHosts:
+			// Go back to pods view (or namespaces if not connected)
+			if a.connectionStatus == domain.StatusConnected {
+				a.viewState = ViewPods
+				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+			}
+			a.viewState = ViewNamespaces
+			return a, a.fetchNamespaces()
+		case ViewCrictlContainers, ViewNodeInfo:
+			// Go back to SSH hosts, or to the node or pod the containers were opened from
+			a.closeSSHConnection()
+			a.viewState = a.crictlReturnView
+			switch a.viewState {
+			case ViewNodes:
+				return a, a.fetchNodes()
+			case ViewNodeDetails, ViewPodDetails:
+				return a, a.reloadView(a.viewState)
+			}
+			a.viewState = ViewSSHHosts
+			return a, nil
+		case ViewCrictlImages, ViewCrictlSandboxes, ViewEtcdSnapshots:
+			a.err = nil
+			a.viewState = ViewCrictlContainers
+			return a, nil
+		case ViewSSHRun:
+			// Cancel a running command first, then go back to the hosts.
+			// Results still arriving from the cancelled run are dropped.
+			if a.sshRunViewer.Running() {
+				a.stopSSHRun()
+				a.sshRunID++
+				a.sshRunViewer.Finish()
+				return a, nil
+			}
+			a.viewState = ViewSSHHosts
+			return a, nil
+		case ViewSSHRunDiff:
+			a.viewState = ViewSSHRun
+			return a, nil
+		case ViewCrictlStats:
+			// The pending tick stops sampling once the view is left
+			a.err = nil
+			a.viewState = ViewCrictlContainers
+			return a, nil
+		case ViewCrictlInspect:
+			a.err = nil
+			a.crictlInspect.Clear()
+			a.selectedCrictlContainer = nil
+			a.viewState = ViewCrictlContainers
+			return a, nil
+		case ViewCrictlLogs:
+			// Stop streaming and go back to containers
+			a.stopCrictlLogStream()
+			a.crictlLogViewer.Clear()
+			a.selectedCrictlContainer = nil
+			a.viewState = ViewCrictlContainers
+			return a, nil
+		case ViewK3sJournal:
+			a.stopJournalStream()
+			a.journalViewer.Clear()
+			a.searchInput.Hide()
+			a.err = nil
+			a.viewState = ViewCrictlContainers
+			return a, nil
+		case ViewDeployments:
+			// Go back to pods
+			a.viewState = ViewPods
+			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+		case ViewDeploymentDetails:
+			// Go back to deployments
+			a.viewState = ViewDeployments
+			a.selectedDeployName = ""
+			return a, a.fetchDeployments()
+		case ViewStatefulSets, ViewDaemonSets, ViewReplicaSets, ViewJobs, ViewCronJobs, ViewNodes, ViewServices, ViewConfigMaps, ViewSecrets, ViewIngresses, ViewIngressRoutes,
+			ViewPVCs, ViewPVs, ViewStorageClasses, ViewAPIResources, ViewHelmCharts:
+			// Go back to pods
+			a.viewState = ViewPods
+			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+		case ViewStatefulSetDetails:
+			// Go back to statefulsets
+			a.viewState = ViewStatefulSets
+			a.selectedStatefulSetName = ""
+			return a, a.fetchStatefulSets()
+		case ViewDaemonSetDetails:
+			// Go back to daemonsets
+			a.viewState = ViewDaemonSets
+			a.selectedDaemonSetName = ""
+			return a, a.fetchDaemonSets()
+		case ViewReplicaSetDetails:
+			// Go back to replicasets
+			a.viewState = ViewReplicaSets
+			a.selectedReplicaSetName = ""
+			return a, a.fetchReplicaSets()
+		case ViewJobDetails:
+			// Go back to jobs
+			a.viewState = ViewJobs
+			a.selectedJobName = ""
+			return a, a.fetchJobs()
+		case ViewCronJobDetails:
+			// Go back to cronjobs
+			a.viewState = ViewCronJobs
+			a.selectedCronJobName = ""
+			return a, a.fetchCronJobs()
+		case ViewNodeDetails:
+			// Go back to nodes
+			a.viewState = ViewNodes
+			a.selectedNodeName = ""
+			return a, a.fetchNodes()
+		case ViewNodeDrain:
+			// Cancel a running drain first, then go back to where it was started
+			if a.drainProgress.Running() {
+				a.stopDrain()
+				return a, nil
+			}
+			a.viewState = a.drainSourceView
+			return a, a.refreshNodeView()
+		case ViewDeploymentHistory:
+			// Go back to deployment details
+			a.viewState = ViewDeploymentDetails
+			return a, nil
+		case ViewRevisionDiff:
+			// Go back to the rollout history
+			a.viewState = ViewDeploymentHistory
+			return a, nil
+		case ViewEditDiff:
+			// Discard the edit
+			a.finishEdit()
+			return a, a.notification.Show("Edit discarded", NotificationInfo)
+		case ViewYAML:
+			// Go back to the details view, which still holds its content
+			a.searchInput.Hide()
+			a.err = nil
+			a.viewState = a.yamlSourceView
+			return a, nil
+		case ViewServiceDetails:
+			// Go back to the route the service was opened from
+			if a.serviceSourceView == ViewIngressDetails || a.serviceSourceView == ViewIngressRouteDetails {
+				a.viewState = a.serviceSourceView
+				a.serviceSourceView = ViewServices
+				a.selectedServiceName = ""
+				a.loading = true
+				return a, a.reloadView(a.viewState)
+			}
+			// Go back to services
+			a.viewState = ViewServices
+			a.selectedServiceName = ""
+			return a, a.fetchServices()
+		case ViewIngressDetails:
+			// Go back to ingresses
+			a.viewState = ViewIngresses
+			a.selectedIngressName = ""
+			return a, a.fetchIngresses()
+		case ViewIngressRouteDetails:
+			// Go back to ingressroutes
+			a.viewState = ViewIngressRoutes
+			a.selectedIngressRouteName = ""
+			return a, a.fetchIngressRoutes()
+		case ViewPVCDetails:
+			// Go back to claims
+			a.viewState = ViewPVCs
+			a.selectedPVCName = ""
+			return a, a.fetchPVCs()
+		case ViewHelmChartDetails:
+			// Go back to HelmCharts
+			a.viewState = ViewHelmCharts
+			a.selectedHelmChartName = ""
+			return a, a.fetchHelmCharts()
+		case ViewResources:
+			// Go back to the API resources
+			a.viewState = ViewAPIResources
+			a.resourceData = nil
+			a.err = nil
+			if a.apiResources == nil {
+				a.loading = true
+				return a, a.fetchAPIResources("")
+			}
+			return a, nil
+		case ViewPVDetails:
+			a.selectedPVName = ""
+			// Go back to the claim the volume was opened from
+			if a.pvSourceView == ViewPVCDetails {
+				a.viewState = ViewPVCDetails
+				a.loading = true
+				return a, a.fetchPVCDetails(a.selectedPVCNamespace, a.selectedPVCName)
+			}
+			// Go back to volumes
+			a.viewState = ViewPVs
+			return a, a.fetchPVs()
+		case ViewConfigMapDetails:
+			// Go back to configmaps
+			a.searchInput.Hide()
+			a.viewState = ViewConfigMaps
+			a.selectedConfigMapName = ""
+			return a, a.fetchConfigMaps()
+		case ViewSecretDetails:
+			// Go back to secrets
+			a.searchInput.Hide()
+			a.viewState = ViewSecrets
+			a.selectedSecretName = ""
+			return a, a.fetchSecrets()
+		case ViewEvents, ViewPortForwards:
+			// Go back to pods
+			a.viewState = ViewPods
+			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+		}
+	}
+
+	// Pass key to child components
+	switch a.viewState {
+	case ViewKubeConfigSelect:
+		var cmd tea.Cmd
+		a.kubeConfigList, cmd = a.kubeConfigList.Update(msg)
+		return a, cmd
+	case ViewContextSelect:
+		var cmd tea.Cmd
+		a.contextList, cmd = a.contextList.Update(msg)
+		return a, cmd
+	case ViewNamespaces:
+		var cmd tea.Cmd
+		a.namespaceList, cmd = a.namespaceList.Update(msg)
+		return a, cmd
+	case ViewPods:
+		var cmd tea.Cmd
+		a.podList, cmd = a.podList.Update(msg)
+		return a, cmd
+	case ViewPodDetails:
+		var cmd tea.Cmd
+		a.podDetails, cmd = a.podDetails.Update(msg)
+		return a, cmd
+	case ViewLogs:
+		var cmd tea.Cmd
+		a.logViewer, cmd = a.logViewer.Update(msg)
+		return a, cmd
+	case ViewMultiPodLogs:
+		var cmd tea.Cmd
+		a.multiPodLogViewer, cmd = a.multiPodLogViewer.Update(msg)
+		return a, cmd
+	case ViewSSHHosts:
+		var cmd tea.Cmd
+		a.sshHostList, cmd = a.sshHostList.Update(msg)
+		return a, cmd
+	case ViewCrictlContainers:
+		var cmd tea.Cmd
+		a.crictlContainerList, cmd = a.crictlContainerList.Update(msg)
+		return a, cmd
+	case ViewCrictlLogs:
+		var cmd tea.Cmd
+		a.crictlLogViewer, cmd = a.crictlLogViewer.Update(msg)
+		return a, cmd
+	case ViewK3sJournal:
+		var cmd tea.Cmd
+		a.journalViewer, cmd = a.journalViewer.Update(msg)
+		return a, cmd
+	case ViewCrictlStats:
+		var cmd tea.Cmd
+		a.crictlStatsViewer, cmd = a.crictlStatsViewer.Update(msg)
+		return a, cmd
+	case ViewCrictlInspect:
+		var cmd tea.Cmd
+		a.crictlInspect, cmd = a.crictlInspect.Update(msg)
+		return a, cmd
+	case ViewCrictlImages:
+		var cmd tea.Cmd
+		a.crictlImageList, cmd = a.crictlImageList.Update(msg)
+		return a, cmd
+	case ViewCrictlSandboxes:
+		var cmd tea.Cmd
+		a.crictlSandboxList, cmd = a.crictlSandboxList.Update(msg)
+		return a, cmd
+	case ViewEtcdSnapshots:
+		var cmd tea.Cmd
+		a.etcdSnapshotList, cmd = a.etcdSnapshotList.Update(msg)
+		return a, cmd
+	case ViewSSHRun:
+		var cmd tea.Cmd
+		a.sshRunViewer, cmd = a.sshRunViewer.Update(msg)
+		return a, cmd
+	case ViewDeployments:
+		var cmd tea.Cmd
+		a.deploymentList, cmd = a.deploymentList.Update(msg)
+		return a, cmd
+	case ViewDeploymentDetails:
+		var cmd tea.Cmd
+		a.deploymentDetails, cmd = a.deploymentDetails.Update(msg)
+		return a, cmd
+	case ViewStatefulSets:
+		var cmd tea.Cmd
+		a.statefulSetList, cmd = a.statefulSetList.Update(msg)
+		return a, cmd
+	case ViewStatefulSetDetails:
+		var cmd tea.Cmd
+		a.statefulSetDetails, cmd = a.statefulSetDetails.Update(msg)
+		return a, cmd
+	case ViewDaemonSets:
+		var cmd tea.Cmd
+		a.daemonSetList, cmd = a.daemonSetList.Update(msg)
+		return a, cmd
+	case ViewDaemonSetDetails:
+		var cmd tea.Cmd
+		a.daemonSetDetails, cmd = a.daemonSetDetails.Update(msg)
+		return a, cmd
+	case ViewReplicaSets:
+		var cmd tea.Cmd
+		a.replicaSetList, cmd = a.replicaSetList.Update(msg)
+		return a, cmd
+	case ViewReplicaSetDetails:
+		var cmd tea.Cmd
+		a.replicaSetDetails, cmd = a.replicaSetDetails.Update(msg)
+		return a, cmd
+	case ViewJobs:
+		var cmd tea.Cmd
+		a.jobList, cmd = a.jobList.Update(msg)
+		return a, cmd
+	case ViewJobDetails:
+		var cmd tea.Cmd
+		a.jobDetails, cmd = a.jobDetails.Update(msg)
+		return a, cmd
+	case ViewCronJobs:
+		var cmd tea.Cmd
+		a.cronJobList, cmd = a.cronJobList.Update(msg)
+		return a, cmd
+	case ViewCronJobDetails:
+		var cmd tea.Cmd
+		a.cronJobDetails, cmd = a.cronJobDetails.Update(msg)
+		return a, cmd
+	case ViewNodes:
+		var cmd tea.Cmd
+		a.nodeList, cmd = a.nodeList.Update(msg)
+		return a, cmd
+	case ViewNodeDetails:
+		var cmd tea.Cmd
+		a.nodeDetails, cmd = a.nodeDetails.Update(msg)
+		return a, cmd
+	case ViewNodeDrain:
+		var cmd tea.Cmd
+		a.drainProgress, cmd = a.drainProgress.Update(msg)
+		return a, cmd
+	case ViewYAML:
+		var cmd tea.Cmd
+		a.yamlViewer, cmd = a.yamlViewer.Update(msg)
+		return a, cmd
+	case ViewEditDiff, ViewRevisionDiff, ViewSSHRunDiff:
+		var cmd tea.Cmd
+		a.diffViewer, cmd = a.diffViewer.Update(msg)
+		return a, cmd
+	case ViewDeploymentHistory:
+		var cmd tea.Cmd
+		a.revisionList, cmd = a.revisionList.Update(msg)
+		return a, cmd
+	case ViewServices:
+		var cmd tea.Cmd
+		a.serviceList, cmd = a.serviceList.Update(msg)
+		return a, cmd
+	case ViewServiceDetails:
+		var cmd tea.Cmd
+		a.serviceDetails, cmd = a.serviceDetails.Update(msg)
+		return a, cmd
+	case ViewConfigMaps:
+		var cmd tea.Cmd
+		a.configMapList, cmd = a.configMapList.Update(msg)
+		return a, cmd
+	case ViewSecrets:
+		var cmd tea.Cmd
+		a.secretList, cmd = a.secretList.Update(msg)
+		return a, cmd
+	case ViewIngresses:
+		var cmd tea.Cmd
+		a.ingressList, cmd = a.ingressList.Update(msg)
+		return a, cmd
+	case ViewIngressDetails:
+		var cmd tea.Cmd
+		a.ingressDetails, cmd = a.ingressDetails.Update(msg)
+		return a, cmd
+	case ViewIngressRoutes:
+		var cmd tea.Cmd
+		a.ingressRouteList, cmd = a.ingressRouteList.Update(msg)
+		return a, cmd
+	case ViewIngressRouteDetails:
+		var cmd tea.Cmd
+		a.ingressRouteDetails, cmd = a.ingressRouteDetails.Update(msg)
+		return a, cmd
+	case ViewPVCs:
+		var cmd tea.Cmd
+		a.pvcList, cmd = a.pvcList.Update(msg)
+		return a, cmd
+	case ViewPVCDetails:
+		var cmd tea.Cmd
+		a.pvcDetails, cmd = a.pvcDetails.Update(msg)
+		return a, cmd
+	case ViewPVs:
+		var cmd tea.Cmd
+		a.pvList, cmd = a.pvList.Update(msg)
+		return a, cmd
+	case ViewPVDetails:
+		var cmd tea.Cmd
+		a.pvDetails, cmd = a.pvDetails.Update(msg)
+		return a, cmd
+	case ViewStorageClasses:
+		var cmd tea.Cmd
+		a.storageClassList, cmd = a.storageClassList.Update(msg)
+		return a, cmd
+	case ViewAPIResources:
+		var cmd tea.Cmd
+		a.apiResourceList, cmd = a.apiResourceList.Update(msg)
+		return a, cmd
+	case ViewResources:
+		var cmd tea.Cmd
+		a.resourceList, cmd = a.resourceList.Update(msg)
+		return a, cmd
+	case ViewHelmCharts:
+		var cmd tea.Cmd
+		a.helmChartList, cmd = a.helmChartList.Update(msg)
+		return a, cmd
+	case ViewHelmChartDetails:
+		var cmd tea.Cmd
+		a.helmChartDetails, cmd = a.helmChartDetails.Update(msg)
+		return a, cmd
+	case ViewConfigMapDetails, ViewSecretDetails:
+		var cmd tea.Cmd
+		a.configData, cmd = a.configData.Update(msg)
+		// Matches are counted in the value of the selected key
+		a.searchInput.SetMatchCount(a.configData.MatchCount())
+		return a, cmd
+	case ViewEvents:
+		var cmd tea.Cmd
+		a.eventViewer, cmd = a.eventViewer.Update(msg)
+		return a, cmd
+	case ViewPortForwards:
+		var cmd tea.Cmd
+		a.portForwardList, cmd = a.portForwardList.Update(msg)
+		return a, cmd
+	}
+
+	return a, nil
+}
+
+// View implements tea.Model
+func (a *App) View() string {
+	if !a.ready {
+		return "Loading..."
+	}
+
+	var view string
+	switch a.viewState {
+	case ViewKubeConfigSelect:
+		view = a.renderKubeConfigSelect()
+	case ViewContextSelect:
+		view = a.renderContextSelect()
+	case ViewConnecting:
+		view = a.renderConnecting()
+	case ViewNamespaces:
+		view = a.renderNamespacesView()
+	case ViewPods:
+		view = a.renderPodsView()
+	case ViewPodDetails:
+		view = a.renderPodDetailsView()
+	case ViewLogs:
+		view = a.renderLogsView()
+	case ViewMain:
+		view = a.renderMainView()
+	case ViewSSHHosts:
+		view = a.renderSSHHostsView()
+	case ViewSSHConnecting:
+		view = a.renderSSHConnecting()
+	case ViewCrictlContainers:
+		view = a.renderCrictlContainersView()
+	case ViewCrictlLogs:
+		view = a.renderCrictlLogsView()
+	case ViewK3sJournal:
+		view = a.renderK3sJournalView()
+	case ViewCrictlStats:
+		view = a.renderCrictlStatsView()
+	case ViewCrictlInspect:
+		view = a.renderCrictlInspectView()
+	case ViewCrictlImages:
+		view = a.renderCrictlImagesView()
+	case ViewCrictlSandboxes:
+		view = a.renderCrictlSandboxesView()
+	case ViewEtcdSnapshots:
+		view = a.renderEtcdSnapshotsView()
+	case ViewSSHRun:
+		view = a.renderSSHRunView()
+	case ViewSSHRunDiff:
+		view = a.renderSSHRunDiffView()
+	case ViewDeployments:
+		view = a.renderDeploymentsView()
+	case ViewDeploymentDetails:
+		view = a.renderDeploymentDetailsView()
+	case ViewStatefulSets:
+		view = a.renderStatefulSetsView()
+	case ViewStatefulSetDetails:
+		view = a.renderStatefulSetDetailsView()
+	case ViewDaemonSets:
+		view = a.renderDaemonSetsView()
+	case ViewDaemonSetDetails:
+		view = a.renderDaemonSetDetailsView()
+	case ViewReplicaSets:
+		view = a.renderReplicaSetsView()
+	case ViewReplicaSetDetails:
+		view = a.renderReplicaSetDetailsView()
+	case ViewJobs:
+		view = a.renderJobsView()
+	case ViewJobDetails:
+		view = a.renderJobDetailsView()
+	case ViewCronJobs:
+		view = a.renderCronJobsView()
+	case ViewCronJobDetails:
+		view = a.renderCronJobDetailsView()
+	case ViewConfigMaps:
+		view = a.renderConfigMapsView()
+	case ViewSecrets:
+		view = a.renderSecretsView()
+	case ViewConfigMapDetails, ViewSecretDetails:
+		view = a.renderConfigDataView()
+	case ViewIngresses:
+		view = a.renderIngressesView()
+	case ViewIngressDetails:
+		view = a.renderIngressDetailsView()
+	case ViewIngressRoutes:
+		view = a.renderIngressRoutesView()
+	case ViewIngressRouteDetails:
+		view = a.renderIngressRouteDetailsView()
+	case ViewPVCs:
+		view = a.renderPVCsView()
+	case ViewPVCDetails:
+		view = a.renderPVCDetailsView()
+	case ViewPVs:
+		view = a.renderPVsView()
+	case ViewPVDetails:
+		view = a.renderPVDetailsView()
+	case ViewStorageClasses:
+		view = a.renderStorageClassesView()
+	case ViewAPIResources:
+		view = a.renderAPIResourcesView()
+	case ViewResources:
+		view = a.renderResourcesView()
+	case ViewHelmCharts:
+		view = a.renderHelmChartsView()
+	case ViewHelmChartDetails:
+		view = a.renderHelmChartDetailsView()
+	case ViewYAML:
+		view = a.renderYAMLView()
+	case ViewEditDiff:
+		view = a.renderEditDiffView()
+	case ViewDeploymentHistory:
+		view = a.renderDeploymentHistoryView()
+	case ViewRevisionDiff:
+		view = a.renderRevisionDiffView()
+	case ViewNodes:
+		view = a.renderNodesView()
+	case ViewNodeDetails:
+		view = a.renderNodeDetailsView()
+	case ViewNodeDrain:
+		view = a.renderNodeDrainView()
+	case ViewServices:
+		view = a.renderServicesView()
+	case ViewServiceDetails:
+		view = a.renderServiceDetailsView()
+	case ViewEvents:
+		view = a.renderEventsView()
+	case ViewMultiPodLogs:
+		view = a.renderMultiPodLogsView()
+	case ViewPortForwards:
+		view = a.renderPortForwardsView()
+	default:
+		view = ""
+	}
+
+	// Overlay scale dialog if visible
+	if a.scaleDialog.IsVisible() {
+		view = a.overlayScaleDialog(view)
+	}
+
+	// Overlay port-forward dialog if visible
+	if a.portForwardDialog.IsVisible() {
+		view = a.placeOverlay(view, a.portForwardDialog.View())
+	}
+
+	// Overlay SSH command dialog if visible
+	if a.sshCommandDialog.IsVisible() {
+		view = a.placeOverlay(view, a.sshCommandDialog.View())
+	}
+
+	// Overlay etcd snapshot dialog if visible
+	if a.etcdSnapshotDialog.IsVisible() {
+		view = a.placeOverlay(view, a.etcdSnapshotDialog.View())
+	}
+
+	// Overlay claim resize dialog if visible
+	if a.pvcResizeDialog.IsVisible() {
+		view = a.placeOverlay(view, a.pvcResizeDialog.View())
+	}
+
+	// Overlay drain dialog if visible
+	if a.drainDialog.IsVisible() {
+		view = a.placeOverlay(view, a.drainDialog.View())
+	}
+
+	// Overlay help screen if visible
+	if a.helpScreen.IsVisible() {
+		return a.overlayHelpScreen(view)
+	}
+
+	return view
+}
+
+// overlayHelpScreen overlays the help screen centered on the view
+func (a *App) overlayHelpScreen(view string) string {
+	if !a.helpScreen.IsVisible() {
+		return view
+	}
+	return a.placeOverlay(view, a.helpScreen.View())
+}
+
+func (a *App) renderKubeConfigSelect() string {
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(a.kubeConfigList.View())
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderContextSelect() string {
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(a.contextList.View())
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderConnecting() string {
+	spinnerView := a.spinner.View()
+	connectingText := fmt.Sprintf("%s Connecting to cluster...", spinnerView)
+	if a.selectedConfig != nil {
+		connectingText = fmt.Sprintf("%s Connecting to %s...", spinnerView, a.selectedConfig.Name)
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(connectingText)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderNamespacesView() string {
+	var contentStr string
+	if a.loading && a.namespaceCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading namespaces...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("Namespaces (%d)", a.namespaceCount)
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-45s %-12s %s", "NAME", "STATUS", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.namespaceList.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderPodsView() string {
+	var contentStr string
+	if a.loading && a.podCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading pods...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("Pods (%d)", a.podCount)
+		if a.podScope != nil {
+			title += fmt.Sprintf(" [%s]", a.podScope.label)
+		} else if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		if a.metricsEnabled {
+			title += " [metrics]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+
+		var headerLine string
+		if a.metricsEnabled {
+			headerLine = lipgloss.NewStyle().Foreground(colorMuted).
+				Render(fmt.Sprintf("  %s%-45s %-7s %-12s %-8s %-8s %-10s %s", a.namespaceHeader(), "NAME", "READY", "STATUS", "RESTARTS", "CPU", "MEMORY", "AGE"))
+		} else {
+			headerLine = lipgloss.NewStyle().Foreground(colorMuted).
+				Render(fmt.Sprintf("  %s%-45s %-7s %-12s %-8s %s", a.namespaceHeader(), "NAME", "READY", "STATUS", "RESTARTS", "AGE"))
+		}
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.podList.View()
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	var view string
+	view = a.assembleView(content, footer)
+
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	if a.podMultiSelector.IsVisible() {
+		view = a.overlayPodMultiSelector(view)
+	}
+	if a.containerSelector.IsVisible() {
+		view = a.overlayContainerSelector(view)
+	}
+	return view
+}
+
+func (a *App) renderPodDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading pod details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("Pod: %s", a.selectedPodName)
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		scrollInfo := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf(" (%.0f%%)", a.podDetails.ScrollPercent()*100))
+		contentStr = titleLine + scrollInfo + "\n" + a.podDetails.View()
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	var view string
+	view = a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	if a.containerSelector.IsVisible() {
+		view = a.overlayContainerSelector(view)
+	}
+	return view
+}
+
+func (a *App) renderYAMLView() string {
+	var contentStr string
+	if a.loading && a.yamlViewer.Content() == "" {
+		contentStr = fmt.Sprintf("%s Loading YAML...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		header := a.yamlViewer.RenderHeader()
+		if a.searchInput.IsVisible() {
+			header += "\n" + a.searchInput.View()
 		}
+		contentStr = header + "\n" + a.yamlViewer.View()
+	}
 
-	case "r":
-		// Refresh
-		switch a.viewState {
-		case ViewNamespaces:
-			if a.k8sClient != nil {
-				a.loading = true
-				return a, a.fetchNamespaces()
-			}
-		case ViewPods:
-			if a.k8sClient != nil {
-				a.loading = true
-				return a, a.fetchPods()
-			}
-		case ViewPodDetails:
-			if a.k8sClient != nil && a.selectedPodName != "" {
-				a.loading = true
-				return a, a.fetchPodDetails(a.selectedPodName)
-			}
-		case ViewLogs:
-			if a.k8sClient != nil {
-				a.stopLogStream()
-				a.loading = true
-				return a, a.fetchLogs(
-					a.logViewer.PodName(),
-					a.logViewer.Container(),
-					a.logViewer.TailLines(),
-					a.logViewer.Timestamps(),
-				)
-			}
-		case ViewMain:
-			if a.selectedConfig != nil && a.connectionStatus != domain.StatusConnected {
-				a.viewState = ViewConnecting
-				a.connectionStatus = domain.StatusConnecting
-				a.err = nil
-				return a, a.connectToCluster(a.selectedConfig.Path)
-			}
-		case ViewCrictlContainers:
-			if a.sshClient != nil {
-				a.loading = true
-				return a, a.fetchCrictlContainers()
-			}
-		case ViewCrictlLogs:
-			if a.sshClient != nil && a.selectedCrictlContainer != nil {
-				a.stopCrictlLogStream()
-				a.loading = true
-				return a, a.fetchCrictlLogs()
-			}
-		case ViewDeployments:
-			if a.k8sClient != nil {
-				a.loading = true
-				return a, a.fetchDeployments()
-			}
-		case ViewDeploymentDetails:
-			if a.k8sClient != nil && a.selectedDeployName != "" {
-				a.loading = true
-				return a, a.fetchDeploymentDetails(a.selectedDeployName)
-			}
-		case ViewServices:
-			if a.k8sClient != nil {
-				a.loading = true
-				return a, a.fetchServices()
-			}
-		case ViewServiceDetails:
-			if a.k8sClient != nil && a.selectedServiceName != "" {
-				a.loading = true
-				return a, a.fetchServiceDetails(a.selectedServiceName)
-			}
-		case ViewEvents:
-			if a.k8sClient != nil {
-				a.loading = true
-				return a, tea.Batch(a.fetchEvents(), a.scheduleEventRefresh())
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderDeploymentHistoryView() string {
+	var contentStr string
+	if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := "Rollout History"
+		if dep := a.deploymentDetails.Deployment(); dep != nil {
+			title = fmt.Sprintf("Rollout History: %s/%s", dep.Namespace, dep.Name)
+			if dep.Paused {
+				title += " [paused]"
 			}
 		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-5s %-36s %-7s %-6s %-40s %s", "REV", "REPLICASET", "READY", "AGE", "IMAGES", "CHANGE-CAUSE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.revisionList.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	return view
+}
+
+func (a *App) renderRevisionDiffView() string {
+	contentStr := a.diffViewer.RenderHeader() + "\n" + a.diffViewer.View()
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderEditDiffView() string {
+	contentStr := a.diffViewer.RenderHeader() + "\n" + a.diffViewer.View()
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	return view
+}
+
+func (a *App) renderLogsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading logs...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		logHeader := a.logViewer.RenderHeader()
+		if a.searchInput.IsVisible() {
+			logHeader += "\n" + a.searchInput.View()
+		}
+		contentStr = logHeader + "\n" + a.logViewer.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	var view string
+	view = a.assembleView(content, footer)
+	if a.containerSelector.IsVisible() {
+		view = a.overlayContainerSelector(view)
+	}
+	return view
+}
+
+func (a *App) renderMainView() string {
+	content := a.renderContent()
+	footer := a.renderFooter()
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderHeader() string {
+	title := a.styles.Title.Render("k4s")
+
+	// Compact fallback header for narrow terminals
+	parts := []string{title}
+
+	if a.clusterInfo != nil && a.connectionStatus == domain.StatusConnected {
+		ctx := lipgloss.NewStyle().Foreground(colorMuted).Render(a.clusterInfo.Context)
+		ns := lipgloss.NewStyle().Foreground(colorMuted).Render(a.namespaceLabel())
+		parts = append(parts, ctx, ns)
+	} else if a.selectedConfig != nil {
+		cfg := lipgloss.NewStyle().Foreground(colorMuted).Render(a.selectedConfig.Name)
+		parts = append(parts, cfg)
+	}
+
+	sep := lipgloss.NewStyle().Foreground(colorDim).Render(" · ")
+	headerContent := ""
+	for i, p := range parts {
+		if i > 0 {
+			headerContent += sep
+		}
+		headerContent += p
+	}
+
+	return a.styles.Header.
+		Width(a.contentWidth).
+		Render(headerContent)
+}
+
+func (a *App) renderContent() string {
+	if a.err != nil {
+		return a.renderError()
+	}
+
+	if a.selectedConfig == nil {
+		return a.styles.Content.Render("No kubeconfig selected")
+	}
+
+	var content string
+	if a.clusterInfo != nil && a.connectionStatus == domain.StatusConnected {
+		content = fmt.Sprintf(`
+  Cluster:    %s
+  Context:    %s
+  Namespace:  %s
+  Status:     Connected
+
+Navigation:
+  0 - Namespaces view
+  1 - Pods view
+
+Upcoming features:
+  • Pod operations (Step 7)
+  • Real-time streaming logs (Step 8)
+`, a.clusterInfo.Name, a.clusterInfo.Context, a.clusterInfo.Namespace)
+	} else {
+		content = fmt.Sprintf(`
+  Kubeconfig: %s
+  Path:       %s
+  Status:     %s
+
+Press 'r' to retry connection
+`, a.selectedConfig.Name, a.selectedConfig.Path, a.connectionStatus)
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	return a.styles.Content.
+		Width(a.contentWidth).
+		Height(h).
+		Render(content)
+}
+
+// renderSeparator renders a thin horizontal line
+func (a *App) renderSeparator() string {
+	return lipgloss.NewStyle().Foreground(colorDim).Render(strings.Repeat("─", a.contentWidth))
+}
+
+// assembleView combines content and footer, adding sidebar or header as needed
+func (a *App) assembleView(content, footer string) string {
+	if a.showSidebar {
+		sidebar := a.renderSidebar()
+		topRow := lipgloss.JoinHorizontal(lipgloss.Top, content, sidebar)
+		return lipgloss.JoinVertical(lipgloss.Left, topRow, footer)
+	}
+	header := a.renderHeader()
+	return lipgloss.JoinVertical(lipgloss.Left, header, content, footer)
+}
+
+// namespaceHeader returns the NAMESPACE column header of the resource lists,
+// which is only shown in all-namespaces mode
+func (a *App) namespaceHeader() string {
+	if !a.allNamespaces {
+		return ""
+	}
+	return fmt.Sprintf("%-20s ", "NAMESPACE")
+}
 
-	case "l":
-		// Go to logs
-		if a.viewState == ViewPodDetails && a.selectedPodName != "" {
-			// From pod details - pod already selected
-			logger.Debug("Opening logs from pod details", "pod", a.selectedPodName)
-			a.logSourceView = ViewPodDetails
-			a.loading = true
-			return a, a.fetchContainers(a.selectedPodName)
-		}
-		if a.viewState == ViewPods {
-			// From pods list - get selected pod
-			if item, ok := a.podList.SelectedItem().(podItem); ok {
-				a.selectedPodName = item.pod.Name
-				logger.Debug("Opening logs from pods list", "pod", a.selectedPodName)
-				a.logSourceView = ViewPods
-				a.loading = true
-				return a, a.fetchContainers(item.pod.Name)
-			}
-			logger.Warn("No pod selected in pods list")
-		}
+func (a *App) renderFooter() string {
+	// Show notification if visible
+	if a.notification.IsVisible() {
+		return a.styles.Footer.Width(a.width - 4).Render(a.notification.View())
+	}
 
-	case "L":
-		// Multi-pod log streaming (Shift+L)
-		if a.viewState == ViewPods && a.k8sClient != nil {
-			items := a.podList.Items()
-			pods := make([]domain.Pod, 0, len(items))
-			for _, item := range items {
-				if pi, ok := item.(podItem); ok {
-					pods = append(pods, pi.pod)
-				}
-			}
-			if len(pods) > 0 {
-				return a, a.podMultiSelector.Show(pods)
-			}
-		}
+	// Show the resource prompt while it is open
+	if a.resourcePrompt.IsVisible() {
+		return a.styles.Footer.Width(a.width - 4).Render(a.resourcePrompt.View())
+	}
 
-	case "d":
-		// Delete pod
-		if a.viewState == ViewPodDetails && a.selectedPodName != "" {
-			return a, a.confirmDialog.Show(ConfirmActionDeletePod, a.selectedPodName)
-		}
-		// Also allow deletion from pods list view
-		if a.viewState == ViewPods {
-			if item, ok := a.podList.SelectedItem().(podItem); ok {
-				return a, a.confirmDialog.Show(ConfirmActionDeletePod, item.pod.Name)
-			}
-		}
+	// Crush-style help: bold key + subtle action, separated by " · "
+	keyStyle := lipgloss.NewStyle().Bold(true).Foreground(colorMuted)
+	actionStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+	sep := lipgloss.NewStyle().Foreground(colorDim).Render(" · ")
 
-	case "R":
-		// Restart pod (Shift+R)
-		if a.viewState == ViewPodDetails && a.selectedPodName != "" {
-			return a, a.confirmDialog.Show(ConfirmActionRestartPod, a.selectedPodName)
+	renderHelp := func(pairs ...string) string {
+		var parts []string
+		for i := 0; i+1 < len(pairs); i += 2 {
+			parts = append(parts, keyStyle.Render(pairs[i])+" "+actionStyle.Render(pairs[i+1]))
 		}
-		// Also allow restart from pods list view
-		if a.viewState == ViewPods {
-			if item, ok := a.podList.SelectedItem().(podItem); ok {
-				return a, a.confirmDialog.Show(ConfirmActionRestartPod, item.pod.Name)
+		result := ""
+		for i, p := range parts {
+			if i > 0 {
+				result += sep
 			}
+			result += p
 		}
+		return result
+	}
 
-	case "f":
-		// Toggle follow mode in log viewer
-		if a.viewState == ViewLogs {
-			following := a.logViewer.ToggleFollowing()
-			if following {
-				// Start streaming
-				return a, a.startLogStreaming()
-			} else {
-				// Stop streaming
-				a.stopLogStream()
-			}
-			return a, nil
-		}
-		// Toggle follow mode in crictl log viewer
-		if a.viewState == ViewCrictlLogs {
-			following := a.crictlLogViewer.ToggleFollowing()
-			if following {
-				return a, a.startCrictlLogStreaming()
-			} else {
-				a.stopCrictlLogStream()
-			}
-			return a, nil
-		}
-		// Toggle follow mode in event viewer
-		if a.viewState == ViewEvents {
-			a.eventViewer.ToggleFollowing()
-			return a, nil
+	var helpText string
+	switch a.viewState {
+	case ViewKubeConfigSelect:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "select", "/", "filter", "q", "quit")
+	case ViewContextSelect:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "connect", "/", "filter", "esc", "back", "q", "quit")
+	case ViewConnecting:
+		helpText = renderHelp("ctrl+c", "cancel")
+	case ViewNamespaces:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "select", "/", "filter", "2", "pods", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewPods:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "l", "logs", "L", "multi-log", "x", "shell", "F", "forward", "d", "delete", "R", "restart", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewPodDetails:
+		helpText = renderHelp("↑/↓", "scroll", "l", "logs", "x", "shell", "F", "forward", "S", "node containers", "d", "delete", "R", "restart", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewLogs:
+		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "t", "timestamps", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewMain:
+		helpText = renderHelp("1", "namespaces", "2", "pods", "r", "retry", "q", "quit")
+	case ViewSSHHosts:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "connect", "x", "shell", "space", "mark", "R", "run command", "/", "filter", "esc", "back", "q", "quit")
+	case ViewSSHRun:
+		if a.sshRunViewer.Running() {
+			helpText = renderHelp("↑/↓", "scroll", "tab", "next host", "esc", "cancel")
+		} else {
+			helpText = renderHelp("↑/↓", "scroll", "tab", "next host", "d", "diff", "r", "rerun", "R", "new command", "y", "copy", "esc", "back", "q", "quit")
 		}
-		// Toggle follow mode in multi-pod log viewer
-		if a.viewState == ViewMultiPodLogs {
-			a.multiPodLogViewer.ToggleFollowing()
-			return a, nil
+	case ViewSSHRunDiff:
+		helpText = renderHelp("↑/↓", "scroll", "esc", "back", "q", "quit")
+	case ViewSSHConnecting:
+		helpText = renderHelp("ctrl+c", "cancel")
+	case ViewCrictlContainers:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "logs", "e", "exec", "i", "inspect", "s", "stop", "d", "remove", "m", "stats", "I", "images", "B", "sandboxes", "J", "k3s journal", "E", "etcd", "p", "k8s pod", "x", "node shell", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCrictlImages:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "containers", "d", "remove", "P", "prune unused", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCrictlSandboxes:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "containers", "d", "remove", "P", "remove NotReady", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewEtcdSnapshots:
+		helpText = renderHelp("↑/↓", "navigate", "s", "snapshot", "d", "delete", "P", "prune", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCrictlLogs:
+		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "t", "timestamps", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewK3sJournal:
+		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "s", "since", "p", "priority", "/", "search", "n/N", "next/prev", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCrictlStats:
+		helpText = renderHelp("↑/↓", "scroll", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCrictlInspect:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "toggle", "←/→", "collapse/expand", "e/c", "expand/collapse all", "y", "copy", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewDeployments:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "s", "scale", "R", "restart", "d", "delete", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewDeploymentDetails:
+		helpText = renderHelp("↑/↓", "scroll", "s", "scale", "R", "restart", "h", "history", "P", "pause", "d", "delete", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewStatefulSets:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "s", "scale", "R", "restart", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewStatefulSetDetails:
+		helpText = renderHelp("↑/↓", "scroll", "s", "scale", "R", "restart", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewDaemonSets:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "R", "restart", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewDaemonSetDetails:
+		helpText = renderHelp("↑/↓", "scroll", "R", "restart", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewReplicaSets:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewReplicaSetDetails:
+		helpText = renderHelp("↑/↓", "scroll", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewJobs:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "p", "pods", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewJobDetails:
+		helpText = renderHelp("↑/↓", "scroll", "p", "pods", "l", "logs", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCronJobs:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "t", "run now", "s", "suspend/resume", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewCronJobDetails:
+		helpText = renderHelp("↑/↓", "scroll", "t", "run now", "s", "suspend/resume", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewNodes:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "c", "cordon", "u", "uncordon", "D", "drain", "S", "containers", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewNodeDetails:
+		helpText = renderHelp("↑/↓", "scroll", "c", "cordon", "u", "uncordon", "D", "drain", "S", "containers", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewNodeDrain:
+		if a.drainProgress.Running() {
+			helpText = renderHelp("↑/↓", "scroll", "esc", "cancel drain")
+		} else {
+			helpText = renderHelp("↑/↓", "scroll", "esc", "back", "q", "quit")
 		}
+	case ViewDeploymentHistory:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "diff", "space", "mark", "u", "rollback", "P", "pause/resume", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewRevisionDiff:
+		helpText = renderHelp("↑/↓", "scroll", "esc", "back", "q", "quit")
+	case ViewEditDiff:
+		helpText = renderHelp("↑/↓", "scroll", "enter", "apply", "e", "edit again", "esc", "discard")
+	case ViewYAML:
+		helpText = renderHelp("↑/↓", "scroll", "/", "search", "n/N", "next/prev", "m", "managedFields", "y", "copy", "e", "edit", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewServices:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "p", "pods", "F", "forward", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewServiceDetails:
+		helpText = renderHelp("↑/↓", "scroll", "p", "pods", "F", "forward", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewIngresses, ViewIngressRoutes:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewIngressDetails, ViewIngressRouteDetails:
+		helpText = renderHelp("↑/↓", "scroll", "tab", "next backend", "enter", "service", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewPVCs:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "s", "resize", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewPVCDetails:
+		helpText = renderHelp("↑/↓", "scroll", "v", "volume", "s", "resize", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewPVs:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "o", "orphaned", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewPVDetails:
+		helpText = renderHelp("↑/↓", "scroll", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewStorageClasses:
+		helpText = renderHelp("↑/↓", "navigate", "y", "yaml", "e", "edit", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewAPIResources:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "browse", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewHelmCharts:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "v", "values", "V", "config values", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewHelmChartDetails:
+		helpText = renderHelp("↑/↓", "scroll", "v", "values", "V", "config values", "l", "job logs", "e", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewResources:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "yaml", "e", "edit", "d", "delete", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewConfigMaps, ViewSecrets:
+		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "A", "all ns", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewConfigMapDetails:
+		helpText = renderHelp("↑/↓", "select key", "pgup/pgdn", "scroll", "/", "search", "n/N", "next/prev", "e", "edit key", "E", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewSecretDetails:
+		helpText = renderHelp("↑/↓", "select key", "pgup/pgdn", "scroll", "x", "reveal", "/", "search", "n/N", "next/prev", "e", "edit key", "E", "edit", "y", "yaml", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewEvents:
+		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "w", "warnings", "k", "kind", "A", "all ns", "r", "refresh", "esc", "back", "q", "quit")
+	case ViewMultiPodLogs:
+		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "esc", "back", "q", "quit")
+	case ViewPortForwards:
+		helpText = renderHelp("↑/↓", "navigate", "d", "stop", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
+	}
 
-	case "t":
-		// Toggle timestamps in log viewer
-		if a.viewState == ViewLogs {
-			a.logViewer.ToggleTimestamps()
-			a.stopLogStream()
-			a.loading = true
-			return a, a.fetchLogs(
-				a.logViewer.PodName(),
-				a.logViewer.Container(),
-				a.logViewer.TailLines(),
-				a.logViewer.Timestamps(),
-			)
-		}
-		// Toggle timestamps in crictl log viewer
-		if a.viewState == ViewCrictlLogs && a.selectedCrictlContainer != nil {
-			a.crictlLogViewer.ToggleTimestamps()
-			a.stopCrictlLogStream()
-			a.loading = true
-			return a, a.fetchCrictlLogs()
-		}
+	// Thin separator above help
+	sepLine := lipgloss.NewStyle().Foreground(colorDim).Render(strings.Repeat("─", a.width-4))
 
-	case "m":
-		// Toggle metrics display in pod list
-		if a.viewState == ViewPods {
-			if a.metricsClient != nil {
-				a.metricsEnabled = !a.metricsEnabled
-				// Rebuild pod list with new metrics setting
-				a.podList = newPodList(
-					nil,
-					a.podList.Width(),
-					a.podList.Height(),
-					a.styles,
-					a.metricsEnabled,
-					a.podMetrics,
-				)
-				// Restore items
-				if a.pods != nil {
-					updatePodList(&a.podList, a.pods)
-				}
-				// Fetch metrics if enabling
-				if a.metricsEnabled && a.podMetrics == nil {
-					return a, a.fetchMetrics()
-				}
-			} else {
-				a.notification.Show("Metrics not available (metrics-server not installed)", NotificationWarning)
-			}
-			return a, nil
-		}
+	// When sidebar is hidden, also show status badge
+	if !a.showSidebar && a.connectionStatus == domain.StatusConnected && a.clusterInfo != nil {
+		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Background(colorSuccess).Padding(0, 1)
+		statusBadge := statusStyle.Render(fmt.Sprintf("%s · %s", a.clusterInfo.Context, a.namespaceLabel()))
+		return sepLine + "\n" + a.styles.Footer.Width(a.width-4).Render(helpText+"  "+statusBadge)
+	}
 
-	case "c":
-		// Change container in log viewer
-		if a.viewState == ViewLogs && len(a.logViewer.Containers()) > 1 {
-			return a, a.containerSelector.Show(a.logViewer.Containers(), a.logViewer.Container())
-		}
+	return sepLine + "\n" + a.styles.Footer.Width(a.width-4).Render(helpText)
+}
 
-	case "/":
-		// Start search in log views
-		if a.viewState == ViewLogs || a.viewState == ViewCrictlLogs {
-			a.searchInput.Show()
-			return a, nil
-		}
+func (a *App) renderError() string {
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	return a.styles.Content.
+		Width(a.contentWidth).
+		Height(h).
+		Render(renderErrorBox(a.err, a.contentWidth))
+}
 
-	case "s":
-		// Scale deployment
-		if a.viewState == ViewDeployments {
-			if item, ok := a.deploymentList.SelectedItem().(deploymentItem); ok {
-				return a, a.scaleDialog.Show(item.deployment.Name, item.deployment.Replicas)
-			}
-		}
-		if a.viewState == ViewDeploymentDetails && a.deploymentDetails.Deployment() != nil {
-			dep := a.deploymentDetails.Deployment()
-			return a, a.scaleDialog.Show(dep.Name, dep.Replicas)
-		}
+func joinStrings(parts []string, sep string) string {
+	if len(parts) == 0 {
+		return ""
+	}
+	result := parts[0]
+	for i := 1; i < len(parts); i++ {
+		result += sep + parts[i]
+	}
+	return result
+}
 
-	case "w":
-		// Toggle warnings filter in events view
-		if a.viewState == ViewEvents {
-			a.eventViewer.ToggleWarningsOnly()
-			return a, nil
+// placeOverlay places a dialog on top of an existing view, preserving background content
+func (a *App) placeOverlay(background, overlay string) string {
+	bgLines := strings.Split(background, "\n")
+	ovLines := strings.Split(overlay, "\n")
+
+	ovWidth := lipgloss.Width(overlay)
+	ovHeight := len(ovLines)
+
+	// Center the overlay
+	startX := (a.width - ovWidth) / 2
+	startY := (a.height - ovHeight) / 2
+	if startX < 0 {
+		startX = 0
+	}
+	if startY < 0 {
+		startY = 0
+	}
+
+	// Ensure background has enough lines
+	for len(bgLines) < a.height {
+		bgLines = append(bgLines, "")
+	}
+
+	// Overlay each line
+	for i, ovLine := range ovLines {
+		bgIdx := startY + i
+		if bgIdx >= len(bgLines) {
+			break
 		}
 
-	case "k":
-		// Cycle kind filter in events view
-		if a.viewState == ViewEvents {
-			a.eventViewer.CycleKindFilter()
-			return a, nil
+		bgLine := bgLines[bgIdx]
+		// Pad background line to startX if needed
+		bgVisWidth := lipgloss.Width(bgLine)
+		if bgVisWidth < startX {
+			bgLine += strings.Repeat(" ", startX-bgVisWidth)
 		}
 
-	case "n":
-		// Next search match
-		if a.viewState == ViewLogs && a.logViewer.SearchQuery() != "" {
-			a.searchInput.NextMatch()
-			return a, nil
-		}
-		if a.viewState == ViewCrictlLogs && a.crictlLogViewer.searchQuery != "" {
-			a.searchInput.NextMatch()
-			return a, nil
+		// Build: background prefix + overlay line + background suffix
+		// We use ANSI-aware splitting via lipgloss
+		prefix := ansiTruncate(bgLine, startX)
+		suffix := ""
+		endX := startX + ovWidth
+		if bgVisWidth > endX {
+			suffix = ansiCutLeft(bgLine, endX)
 		}
 
-	case "N":
-		// Previous search match
-		if a.viewState == ViewLogs && a.logViewer.SearchQuery() != "" {
-			a.searchInput.PrevMatch()
-			return a, nil
-		}
-		if a.viewState == ViewCrictlLogs && a.crictlLogViewer.searchQuery != "" {
-			a.searchInput.PrevMatch()
-			return a, nil
-		}
+		bgLines[bgIdx] = prefix + ovLine + suffix
+	}
 
-	case "1":
-		// Go to namespaces view
-		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewNamespaces {
-			a.viewState = ViewNamespaces
-			a.loading = true
-			return a, a.fetchNamespaces()
-		}
+	// Trim to screen height
+	if len(bgLines) > a.height {
+		bgLines = bgLines[:a.height]
+	}
 
-	case "2":
-		// Go to pods view
-		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewPods {
-			a.viewState = ViewPods
-			a.loading = true
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-		}
+	return strings.Join(bgLines, "\n")
+}
 
-	case "3":
-		// Go to deployments view
-		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewDeployments {
-			a.viewState = ViewDeployments
-			a.loading = true
-			return a, a.fetchDeployments()
+// ansiTruncate truncates a string to n visible characters, preserving ANSI codes
+func ansiTruncate(s string, n int) string {
+	var result strings.Builder
+	visible := 0
+	i := 0
+	for i < len(s) && visible < n {
+		if s[i] == '\x1b' {
+			match := ansiRegex.FindStringIndex(s[i:])
+			if match != nil && match[0] == 0 {
+				result.WriteString(s[i : i+match[1]])
+				i += match[1]
+				continue
+			}
 		}
+		result.WriteByte(s[i])
+		visible++
+		i++
+	}
+	// Pad if needed
+	for visible < n {
+		result.WriteByte(' ')
+		visible++
+	}
+	return result.String()
+}
 
-	case "4":
-		// Go to services view
-		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewServices {
-			a.viewState = ViewServices
-			a.loading = true
-			return a, a.fetchServices()
+// ansiCutLeft returns the portion of s after n visible characters
+func ansiCutLeft(s string, n int) string {
+	visible := 0
+	i := 0
+	for i < len(s) && visible < n {
+		if s[i] == '\x1b' {
+			match := ansiRegex.FindStringIndex(s[i:])
+			if match != nil && match[0] == 0 {
+				i += match[1]
+				continue
+			}
 		}
+		visible++
+		i++
+	}
+	if i >= len(s) {
+		return ""
+	}
+	return s[i:]
+}
 
-	case "5":
-		// Go to events view
-		if a.connectionStatus == domain.StatusConnected && a.viewState != ViewEvents {
-			a.viewState = ViewEvents
-			a.loading = true
-			return a, tea.Batch(a.fetchEvents(), a.scheduleEventRefresh())
-		}
+// overlayContainerSelector overlays the container selector centered on screen
+func (a *App) overlayContainerSelector(view string) string {
+	selector := a.containerSelector.View()
+	if selector == "" {
+		return view
+	}
+	return a.placeOverlay(view, selector)
+}
 
-	case "9":
-		// Go to SSH hosts view
-		if len(a.config.SSHHosts) > 0 {
-			a.viewState = ViewSSHHosts
-			a.err = nil
-			return a, nil
+// overlayDialog overlays the confirmation dialog centered on screen
+func (a *App) overlayDialog(view string) string {
+	dialog := a.confirmDialog.View()
+	if dialog == "" {
+		return view
+	}
+	return a.placeOverlay(view, dialog)
+}
+
+// overlayScaleDialog overlays the scale dialog centered on screen
+func (a *App) overlayScaleDialog(view string) string {
+	dialog := a.scaleDialog.View()
+	if dialog == "" {
+		return view
+	}
+	return a.placeOverlay(view, dialog)
+}
+
+// Deployments view
+func (a *App) renderDeploymentsView() string {
+	var contentStr string
+	if a.loading && a.deploymentCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading deployments...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("Deployments (%d)", a.deploymentCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
 		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-40s %-10s %-10s %-10s %s", a.namespaceHeader(), "NAME", "READY", "UP-TO-DATE", "AVAILABLE", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.deploymentList.View()
+	}
 
-	case "esc":
-		switch a.viewState {
-		case ViewMain:
-			// Go back to pods
-			if a.connectionStatus == domain.StatusConnected {
-				a.viewState = ViewPods
-				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-			}
-			// Or go back to kubeconfig selection
-			if len(a.config.KubeConfigs) > 1 {
-				a.viewState = ViewKubeConfigSelect
-				a.k8sClient = nil
-				a.clusterInfo = nil
-				a.connectionStatus = domain.StatusDisconnected
-				return a, nil
-			}
-		case ViewLogs:
-			// Stop streaming and go back to where we came from
-			a.stopLogStream()
-			a.logViewer.Clear()
-			if a.logSourceView == ViewPods {
-				// Came from pods list - go back to pods
-				a.viewState = ViewPods
-				a.selectedPodName = ""
-				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-			}
-			// Came from pod details - go back to pod details
-			a.viewState = ViewPodDetails
-			a.loading = true
-			return a, a.fetchPodDetails(a.selectedPodName)
-		case ViewMultiPodLogs:
-			a.stopMultiPodStreams()
-			a.multiPodLogViewer.Clear()
-			a.viewState = ViewPods
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-		case ViewPodDetails:
-			// Go back to pods
-			a.viewState = ViewPods
-			a.selectedPodName = ""
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-		case ViewPods:
-			// Go back to namespaces
-			a.viewState = ViewNamespaces
-			return a, a.fetchNamespaces()
-		case ViewNamespaces:
-			// Go back to kubeconfig selection if multiple configs
-			if len(a.config.KubeConfigs) > 1 {
-				a.viewState = ViewKubeConfigSelect
-				a.k8sClient = nil
-				a.clusterInfo = nil
-				a.connectionStatus = domain.StatusDisconnected
-				return a, nil
-			}
-		case ViewSSHHosts:
-			// Go back to pods view (or namespaces if not connected)
-			if a.connectionStatus == domain.StatusConnected {
-				a.viewState = ViewPods
-				return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-			}
-			a.viewState = ViewNamespaces
-			return a, a.fetchNamespaces()
-		case ViewCrictlContainers, ViewNodeInfo:
-			// Go back to SSH hosts
-			a.closeSSHConnection()
-			a.viewState = ViewSSHHosts
-			return a, nil
-		case ViewCrictlLogs:
-			// Stop streaming and go back to containers
-			a.stopCrictlLogStream()
-			a.crictlLogViewer.Clear()
-			a.selectedCrictlContainer = nil
-			a.viewState = ViewCrictlContainers
-			return a, nil
-		case ViewDeployments:
-			// Go back to pods
-			a.viewState = ViewPods
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-		case ViewDeploymentDetails:
-			// Go back to deployments
-			a.viewState = ViewDeployments
-			a.selectedDeployName = ""
-			return a, a.fetchDeployments()
-		case ViewServices:
-			// Go back to pods
-			a.viewState = ViewPods
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
-		case ViewServiceDetails:
-			// Go back to services
-			a.viewState = ViewServices
-			a.selectedServiceName = ""
-			return a, a.fetchServices()
-		case ViewEvents:
-			// Go back to pods
-			a.viewState = ViewPods
-			return a, tea.Batch(a.fetchPods(), a.schedulePodRefresh())
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	var view string
+	view = a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	if a.scaleDialog.IsVisible() {
+		view = a.overlayScaleDialog(view)
+	}
+	return view
+}
+
+func (a *App) renderDeploymentDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading deployment details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.deploymentDetails.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	return view
+}
+
+// StatefulSets view
+func (a *App) renderStatefulSetsView() string {
+	var contentStr string
+	if a.loading && a.statefulSetCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading statefulsets...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("StatefulSets (%d)", a.statefulSetCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
 		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-40s %-10s %-10s %-24s %s", a.namespaceHeader(), "NAME", "READY", "UP-TO-DATE", "SERVICE", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.statefulSetList.View()
 	}
 
-	// Pass key to child components
-	switch a.viewState {
-	case ViewKubeConfigSelect:
-		var cmd tea.Cmd
-		a.kubeConfigList, cmd = a.kubeConfigList.Update(msg)
-		return a, cmd
-	case ViewNamespaces:
-		var cmd tea.Cmd
-		a.namespaceList, cmd = a.namespaceList.Update(msg)
-		return a, cmd
-	case ViewPods:
-		var cmd tea.Cmd
-		a.podList, cmd = a.podList.Update(msg)
-		return a, cmd
-	case ViewPodDetails:
-		var cmd tea.Cmd
-		a.podDetails, cmd = a.podDetails.Update(msg)
-		return a, cmd
-	case ViewLogs:
-		var cmd tea.Cmd
-		a.logViewer, cmd = a.logViewer.Update(msg)
-		return a, cmd
-	case ViewMultiPodLogs:
-		var cmd tea.Cmd
-		a.multiPodLogViewer, cmd = a.multiPodLogViewer.Update(msg)
-		return a, cmd
-	case ViewSSHHosts:
-		var cmd tea.Cmd
-		a.sshHostList, cmd = a.sshHostList.Update(msg)
-		return a, cmd
-	case ViewCrictlContainers:
-		var cmd tea.Cmd
-		a.crictlContainerList, cmd = a.crictlContainerList.Update(msg)
-		return a, cmd
-	case ViewCrictlLogs:
-		var cmd tea.Cmd
-		a.crictlLogViewer, cmd = a.crictlLogViewer.Update(msg)
-		return a, cmd
-	case ViewDeployments:
-		var cmd tea.Cmd
-		a.deploymentList, cmd = a.deploymentList.Update(msg)
-		return a, cmd
-	case ViewDeploymentDetails:
-		var cmd tea.Cmd
-		a.deploymentDetails, cmd = a.deploymentDetails.Update(msg)
-		return a, cmd
-	case ViewServices:
-		var cmd tea.Cmd
-		a.serviceList, cmd = a.serviceList.Update(msg)
-		return a, cmd
-	case ViewServiceDetails:
-		var cmd tea.Cmd
-		a.serviceDetails, cmd = a.serviceDetails.Update(msg)
-		return a, cmd
-	case ViewEvents:
-		var cmd tea.Cmd
-		a.eventViewer, cmd = a.eventViewer.Update(msg)
-		return a, cmd
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	return a, nil
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	return view
 }
 
-// View implements tea.Model
-func (a *App) View() string {
-	if !a.ready {
-		return "Loading..."
+func (a *App) renderStatefulSetDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading statefulset details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.statefulSetDetails.View()
 	}
 
-	var view string
-	switch a.viewState {
-	case ViewKubeConfigSelect:
-		view = a.renderKubeConfigSelect()
-	case ViewConnecting:
-		view = a.renderConnecting()
-	case ViewNamespaces:
-		view = a.renderNamespacesView()
-	case ViewPods:
-		view = a.renderPodsView()
-	case ViewPodDetails:
-		view = a.renderPodDetailsView()
-	case ViewLogs:
-		view = a.renderLogsView()
-	case ViewMain:
-		view = a.renderMainView()
-	case ViewSSHHosts:
-		view = a.renderSSHHostsView()
-	case ViewSSHConnecting:
-		view = a.renderSSHConnecting()
-	case ViewCrictlContainers:
-		view = a.renderCrictlContainersView()
-	case ViewCrictlLogs:
-		view = a.renderCrictlLogsView()
-	case ViewDeployments:
-		view = a.renderDeploymentsView()
-	case ViewDeploymentDetails:
-		view = a.renderDeploymentDetailsView()
-	case ViewServices:
-		view = a.renderServicesView()
-	case ViewServiceDetails:
-		view = a.renderServiceDetailsView()
-	case ViewEvents:
-		view = a.renderEventsView()
-	case ViewMultiPodLogs:
-		view = a.renderMultiPodLogsView()
-	default:
-		view = ""
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	// Overlay scale dialog if visible
-	if a.scaleDialog.IsVisible() {
-		view = a.overlayScaleDialog(view)
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
 	}
+	return view
+}
 
-	// Overlay help screen if visible
-	if a.helpScreen.IsVisible() {
-		return a.overlayHelpScreen(view)
+// DaemonSets view
+func (a *App) renderDaemonSetsView() string {
+	var contentStr string
+	if a.loading && a.daemonSetCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading daemonsets...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("DaemonSets (%d)", a.daemonSetCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-32s %-8s %-8s %-8s %-10s %-10s %-24s %s", a.namespaceHeader(), "NAME", "DESIRED", "CURRENT", "READY", "UP-TO-DATE", "AVAILABLE", "NODE SELECTOR", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.daemonSetList.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
+	return view
+}
+
+func (a *App) renderDaemonSetDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading daemonset details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.daemonSetDetails.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
+	view := a.assembleView(content, footer)
+	if a.confirmDialog.IsVisible() {
+		view = a.overlayDialog(view)
+	}
 	return view
 }
 
-// overlayHelpScreen overlays the help screen centered on the view
-func (a *App) overlayHelpScreen(view string) string {
-	if !a.helpScreen.IsVisible() {
-		return view
+// ReplicaSets view
+func (a *App) renderReplicaSetsView() string {
+	var contentStr string
+	if a.loading && a.replicaSetCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading replicasets...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("ReplicaSets (%d)", a.replicaSetCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-45s %-8s %-8s %-8s %-32s %s", a.namespaceHeader(), "NAME", "DESIRED", "CURRENT", "READY", "OWNER", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.replicaSetList.View()
 	}
-	return a.placeOverlay(view, a.helpScreen.View())
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-func (a *App) renderKubeConfigSelect() string {
-	h := a.height - 10
+func (a *App) renderReplicaSetDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading replicaset details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.replicaSetDetails.View()
+	}
+
+	h := a.height - 12
 	if a.showSidebar {
 		h = a.height - 4
 	}
-	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(a.kubeConfigList.View())
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
 	footer := a.renderFooter()
 
 	return a.assembleView(content, footer)
 }
 
-func (a *App) renderConnecting() string {
-	spinnerView := a.spinner.View()
-	connectingText := fmt.Sprintf("%s Connecting to cluster...", spinnerView)
-	if a.selectedConfig != nil {
-		connectingText = fmt.Sprintf("%s Connecting to %s...", spinnerView, a.selectedConfig.Name)
+// Jobs view
+func (a *App) renderJobsView() string {
+	var contentStr string
+	if a.loading && a.jobCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading jobs...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("Jobs (%d)", a.jobCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-40s %-10s %-12s %-7s %-10s %s", a.namespaceHeader(), "NAME", "STATUS", "COMPLETIONS", "FAILED", "DURATION", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.jobList.View()
 	}
 
-	h := a.height - 10
+	h := a.height - 12
 	if a.showSidebar {
 		h = a.height - 4
 	}
-	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(connectingText)
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
 	footer := a.renderFooter()
 
 	return a.assembleView(content, footer)
 }
 
-func (a *App) renderNamespacesView() string {
+func (a *App) renderJobDetailsView() string {
 	var contentStr string
-	if a.loading && a.namespaceCount == 0 {
-		contentStr = fmt.Sprintf("%s Loading namespaces...", a.spinner.View())
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading job details...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		title := fmt.Sprintf("Namespaces (%d)", a.namespaceCount)
-		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
-		sep := a.renderSeparator()
-		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
-			Render(fmt.Sprintf("  %-45s %-12s %s", "NAME", "STATUS", "AGE"))
-		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.namespaceList.View()
+		contentStr = a.jobDetails.View()
 	}
 
 	h := a.height - 12
@@ -2481,91 +9702,77 @@ func (a *App) renderNamespacesView() string {
 	return a.assembleView(content, footer)
 }
 
-func (a *App) renderPodsView() string {
+// CronJobs view
+func (a *App) renderCronJobsView() string {
 	var contentStr string
-	if a.loading && a.podCount == 0 {
-		contentStr = fmt.Sprintf("%s Loading pods...", a.spinner.View())
+	if a.loading && a.cronJobCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading cronjobs...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		title := fmt.Sprintf("Pods (%d)", a.podCount)
-		if a.metricsEnabled {
-			title += " [metrics]"
+		title := fmt.Sprintf("CronJobs (%d)", a.cronJobCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
 		}
 		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
 		sep := a.renderSeparator()
-
-		var headerLine string
-		if a.metricsEnabled {
-			headerLine = lipgloss.NewStyle().Foreground(colorMuted).
-				Render(fmt.Sprintf("  %-45s %-7s %-12s %-8s %-8s %-10s %s", "NAME", "READY", "STATUS", "RESTARTS", "CPU", "MEMORY", "AGE"))
-		} else {
-			headerLine = lipgloss.NewStyle().Foreground(colorMuted).
-				Render(fmt.Sprintf("  %-45s %-7s %-12s %-8s %s", "NAME", "READY", "STATUS", "RESTARTS", "AGE"))
-		}
-		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.podList.View()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-36s %-18s %-8s %-7s %-14s %s", a.namespaceHeader(), "NAME", "SCHEDULE", "SUSPEND", "ACTIVE", "LAST SCHEDULE", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.cronJobList.View()
 	}
 
-	h := a.height - 10
+	h := a.height - 12
 	if a.showSidebar {
 		h = a.height - 4
 	}
 	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
 	footer := a.renderFooter()
 
-	var view string
-	view = a.assembleView(content, footer)
-
+	view := a.assembleView(content, footer)
 	if a.confirmDialog.IsVisible() {
 		view = a.overlayDialog(view)
 	}
-	if a.podMultiSelector.IsVisible() {
-		view = a.overlayPodMultiSelector(view)
-	}
 	return view
 }
 
-func (a *App) renderPodDetailsView() string {
+func (a *App) renderCronJobDetailsView() string {
 	var contentStr string
 	if a.loading {
-		contentStr = fmt.Sprintf("%s Loading pod details...", a.spinner.View())
+		contentStr = fmt.Sprintf("%s Loading cronjob details...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		title := fmt.Sprintf("Pod: %s", a.selectedPodName)
-		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
-		scrollInfo := lipgloss.NewStyle().Foreground(colorMuted).
-			Render(fmt.Sprintf(" (%.0f%%)", a.podDetails.ScrollPercent()*100))
-		contentStr = titleLine + scrollInfo + "\n" + a.podDetails.View()
+		contentStr = a.cronJobDetails.View()
 	}
 
-	h := a.height - 10
+	h := a.height - 12
 	if a.showSidebar {
 		h = a.height - 4
 	}
 	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
 	footer := a.renderFooter()
 
-	var view string
-	view = a.assembleView(content, footer)
+	view := a.assembleView(content, footer)
 	if a.confirmDialog.IsVisible() {
 		view = a.overlayDialog(view)
 	}
 	return view
 }
 
-func (a *App) renderLogsView() string {
+// Nodes view
+func (a *App) renderNodesView() string {
 	var contentStr string
-	if a.loading {
-		contentStr = fmt.Sprintf("%s Loading logs...", a.spinner.View())
+	if a.loading && a.nodeCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading nodes...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		logHeader := a.logViewer.RenderHeader()
-		if a.searchInput.IsVisible() {
-			logHeader += "\n" + a.searchInput.View()
-		}
-		contentStr = logHeader + "\n" + a.logViewer.View()
+		title := fmt.Sprintf("Nodes (%d)", a.nodeCount)
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-28s %-26s %-16s %-14s %-18s %-22s %-8s %-10s %-7s %s", "NAME", "STATUS", "ROLES", "VERSION", "CPU REQ", "MEMORY REQ", "PODS", "PRESSURE", "TAINTS", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.nodeList.View()
 	}
 
 	h := a.height - 12
@@ -2575,354 +9782,398 @@ func (a *App) renderLogsView() string {
 	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
 	footer := a.renderFooter()
 
-	var view string
-	view = a.assembleView(content, footer)
-	if a.containerSelector.IsVisible() {
-		view = a.overlayContainerSelector(view)
-	}
-	return view
-}
-
-func (a *App) renderMainView() string {
-	content := a.renderContent()
-	footer := a.renderFooter()
 	return a.assembleView(content, footer)
 }
 
-func (a *App) renderHeader() string {
-	title := a.styles.Title.Render("k4s")
-
-	// Compact fallback header for narrow terminals
-	parts := []string{title}
-
-	if a.clusterInfo != nil && a.connectionStatus == domain.StatusConnected {
-		ctx := lipgloss.NewStyle().Foreground(colorMuted).Render(a.clusterInfo.Context)
-		ns := lipgloss.NewStyle().Foreground(colorMuted).Render(a.clusterInfo.Namespace)
-		parts = append(parts, ctx, ns)
-	} else if a.selectedConfig != nil {
-		cfg := lipgloss.NewStyle().Foreground(colorMuted).Render(a.selectedConfig.Name)
-		parts = append(parts, cfg)
+func (a *App) renderNodeDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading node details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.nodeDetails.View()
 	}
 
-	sep := lipgloss.NewStyle().Foreground(colorDim).Render(" · ")
-	headerContent := ""
-	for i, p := range parts {
-		if i > 0 {
-			headerContent += sep
-		}
-		headerContent += p
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	return a.styles.Header.
-		Width(a.contentWidth).
-		Render(headerContent)
+	return a.assembleView(content, footer)
 }
 
-func (a *App) renderContent() string {
-	if a.err != nil {
-		return a.renderError()
-	}
+func (a *App) renderNodeDrainView() string {
+	contentStr := a.drainProgress.RenderHeader() + "\n" + a.drainProgress.View()
 
-	if a.selectedConfig == nil {
-		return a.styles.Content.Render("No kubeconfig selected")
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	var content string
-	if a.clusterInfo != nil && a.connectionStatus == domain.StatusConnected {
-		content = fmt.Sprintf(`
-  Cluster:    %s
-  Context:    %s
-  Namespace:  %s
-  Status:     Connected
-
-Navigation:
-  0 - Namespaces view
-  1 - Pods view
+	return a.assembleView(content, footer)
+}
 
-Upcoming features:
-  • Pod operations (Step 7)
-  • Real-time streaming logs (Step 8)
-`, a.clusterInfo.Name, a.clusterInfo.Context, a.clusterInfo.Namespace)
+// Services view
+func (a *App) renderServicesView() string {
+	var contentStr string
+	if a.loading && a.serviceCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading services...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
 	} else {
-		content = fmt.Sprintf(`
-  Kubeconfig: %s
-  Path:       %s
-  Status:     %s
-
-Press 'r' to retry connection
-`, a.selectedConfig.Name, a.selectedConfig.Path, a.connectionStatus)
+		title := fmt.Sprintf("Services (%d)", a.serviceCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-30s %-14s %-16s %-20s %-20s %s", a.namespaceHeader(), "NAME", "TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORTS", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.serviceList.View()
 	}
 
-	h := a.height - 10
+	h := a.height - 12
 	if a.showSidebar {
 		h = a.height - 4
 	}
-	return a.styles.Content.
-		Width(a.contentWidth).
-		Height(h).
-		Render(content)
-}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-// renderSeparator renders a thin horizontal line
-func (a *App) renderSeparator() string {
-	return lipgloss.NewStyle().Foreground(colorDim).Render(strings.Repeat("─", a.contentWidth))
+	return a.assembleView(content, footer)
 }
 
-// assembleView combines content and footer, adding sidebar or header as needed
-func (a *App) assembleView(content, footer string) string {
-	if a.showSidebar {
-		sidebar := a.renderSidebar()
-		topRow := lipgloss.JoinHorizontal(lipgloss.Top, content, sidebar)
-		return lipgloss.JoinVertical(lipgloss.Left, topRow, footer)
+func (a *App) renderServiceDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading service details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.serviceDetails.View()
 	}
-	header := a.renderHeader()
-	return lipgloss.JoinVertical(lipgloss.Left, header, content, footer)
-}
 
-func (a *App) renderFooter() string {
-	// Show notification if visible
-	if a.notification.IsVisible() {
-		return a.styles.Footer.Width(a.width - 4).Render(a.notification.View())
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	// Crush-style help: bold key + subtle action, separated by " · "
-	keyStyle := lipgloss.NewStyle().Bold(true).Foreground(colorMuted)
-	actionStyle := lipgloss.NewStyle().Foreground(colorSubtle)
-	sep := lipgloss.NewStyle().Foreground(colorDim).Render(" · ")
+	return a.assembleView(content, footer)
+}
 
-	renderHelp := func(pairs ...string) string {
-		var parts []string
-		for i := 0; i+1 < len(pairs); i += 2 {
-			parts = append(parts, keyStyle.Render(pairs[i])+" "+actionStyle.Render(pairs[i+1]))
-		}
-		result := ""
-		for i, p := range parts {
-			if i > 0 {
-				result += sep
-			}
-			result += p
+// Ingresses view
+func (a *App) renderIngressesView() string {
+	var contentStr string
+	if a.loading && a.ingressCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading ingresses...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("Ingresses (%d)", a.ingressCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
 		}
-		return result
-	}
-
-	var helpText string
-	switch a.viewState {
-	case ViewKubeConfigSelect:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "select", "/", "filter", "q", "quit")
-	case ViewConnecting:
-		helpText = renderHelp("ctrl+c", "cancel")
-	case ViewNamespaces:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "select", "/", "filter", "2", "pods", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewPods:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "l", "logs", "L", "multi-log", "d", "delete", "R", "restart", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewPodDetails:
-		helpText = renderHelp("↑/↓", "scroll", "l", "logs", "d", "delete", "R", "restart", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewLogs:
-		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "t", "timestamps", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewMain:
-		helpText = renderHelp("1", "namespaces", "2", "pods", "r", "retry", "q", "quit")
-	case ViewSSHHosts:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "connect", "/", "filter", "esc", "back", "q", "quit")
-	case ViewSSHConnecting:
-		helpText = renderHelp("ctrl+c", "cancel")
-	case ViewCrictlContainers:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "logs", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewCrictlLogs:
-		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "t", "timestamps", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewDeployments:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "s", "scale", "R", "restart", "d", "delete", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewDeploymentDetails:
-		helpText = renderHelp("↑/↓", "scroll", "s", "scale", "R", "restart", "d", "delete", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewServices:
-		helpText = renderHelp("↑/↓", "navigate", "enter", "details", "/", "filter", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewServiceDetails:
-		helpText = renderHelp("↑/↓", "scroll", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewEvents:
-		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "w", "warnings", "k", "kind", "r", "refresh", "esc", "back", "q", "quit")
-	case ViewMultiPodLogs:
-		helpText = renderHelp("↑/↓", "scroll", "f", "follow", "esc", "back", "q", "quit")
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-30s %-10s %-40s %-16s %s", a.namespaceHeader(), "NAME", "CLASS", "HOSTS", "ADDRESS", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.ingressList.View()
 	}
 
-	// Thin separator above help
-	sepLine := lipgloss.NewStyle().Foreground(colorDim).Render(strings.Repeat("─", a.width-4))
-
-	// When sidebar is hidden, also show status badge
-	if !a.showSidebar && a.connectionStatus == domain.StatusConnected && a.clusterInfo != nil {
-		statusStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF")).Background(colorSuccess).Padding(0, 1)
-		statusBadge := statusStyle.Render(fmt.Sprintf("%s · %s", a.clusterInfo.Context, a.clusterInfo.Namespace))
-		return sepLine + "\n" + a.styles.Footer.Width(a.width-4).Render(helpText+"  "+statusBadge)
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	return sepLine + "\n" + a.styles.Footer.Width(a.width-4).Render(helpText)
+	return a.assembleView(content, footer)
 }
 
-func (a *App) renderError() string {
-	h := a.height - 10
+func (a *App) renderIngressDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading ingress details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.ingressDetails.View()
+	}
+
+	h := a.height - 12
 	if a.showSidebar {
 		h = a.height - 4
 	}
-	return a.styles.Content.
-		Width(a.contentWidth).
-		Height(h).
-		Render(renderErrorBox(a.err, a.contentWidth))
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-func joinStrings(parts []string, sep string) string {
-	if len(parts) == 0 {
-		return ""
-	}
-	result := parts[0]
-	for i := 1; i < len(parts); i++ {
-		result += sep + parts[i]
+// IngressRoutes view
+func (a *App) renderIngressRoutesView() string {
+	var contentStr string
+	if a.loading && a.ingressRouteCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading ingressroutes...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("IngressRoutes (%d)", a.ingressRouteCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-30s %-18s %-4s %-6s %s", a.namespaceHeader(), "NAME", "ENTRYPOINTS", "TLS", "AGE", "MATCH"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.ingressRouteList.View()
 	}
-	return result
-}
 
-// placeOverlay places a dialog on top of an existing view, preserving background content
-func (a *App) placeOverlay(background, overlay string) string {
-	bgLines := strings.Split(background, "\n")
-	ovLines := strings.Split(overlay, "\n")
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	ovWidth := lipgloss.Width(overlay)
-	ovHeight := len(ovLines)
+	return a.assembleView(content, footer)
+}
 
-	// Center the overlay
-	startX := (a.width - ovWidth) / 2
-	startY := (a.height - ovHeight) / 2
-	if startX < 0 {
-		startX = 0
-	}
-	if startY < 0 {
-		startY = 0
+func (a *App) renderIngressRouteDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading ingressroute details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.ingressRouteDetails.View()
 	}
 
-	// Ensure background has enough lines
-	for len(bgLines) < a.height {
-		bgLines = append(bgLines, "")
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	// Overlay each line
-	for i, ovLine := range ovLines {
-		bgIdx := startY + i
-		if bgIdx >= len(bgLines) {
-			break
-		}
+	return a.assembleView(content, footer)
+}
 
-		bgLine := bgLines[bgIdx]
-		// Pad background line to startX if needed
-		bgVisWidth := lipgloss.Width(bgLine)
-		if bgVisWidth < startX {
-			bgLine += strings.Repeat(" ", startX-bgVisWidth)
+// PersistentVolumeClaims view
+func (a *App) renderPVCsView() string {
+	var contentStr string
+	if a.loading && a.pvcCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading persistentvolumeclaims...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("PersistentVolumeClaims (%d)", a.pvcCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
 		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-30s %-11s %-12s %-6s %-16s %-6s %s", a.namespaceHeader(), "NAME", "STATUS", "CAPACITY", "ACCESS", "CLASS", "AGE", "USED BY"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.pvcList.View()
+	}
 
-		// Build: background prefix + overlay line + background suffix
-		// We use ANSI-aware splitting via lipgloss
-		prefix := ansiTruncate(bgLine, startX)
-		suffix := ""
-		endX := startX + ovWidth
-		if bgVisWidth > endX {
-			suffix = ansiCutLeft(bgLine, endX)
-		}
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-		bgLines[bgIdx] = prefix + ovLine + suffix
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderPVCDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading claim details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.pvcDetails.View()
 	}
 
-	// Trim to screen height
-	if len(bgLines) > a.height {
-		bgLines = bgLines[:a.height]
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
 
-	return strings.Join(bgLines, "\n")
+	return a.assembleView(content, footer)
 }
 
-// ansiTruncate truncates a string to n visible characters, preserving ANSI codes
-func ansiTruncate(s string, n int) string {
-	var result strings.Builder
-	visible := 0
-	i := 0
-	for i < len(s) && visible < n {
-		if s[i] == '\x1b' {
-			match := ansiRegex.FindStringIndex(s[i:])
-			if match != nil && match[0] == 0 {
-				result.WriteString(s[i : i+match[1]])
-				i += match[1]
-				continue
-			}
+// PersistentVolumes view
+func (a *App) renderPVsView() string {
+	var contentStr string
+	if a.loading && len(a.pvs) == 0 {
+		contentStr = fmt.Sprintf("%s Loading persistentvolumes...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("PersistentVolumes (%d)", len(a.pvs))
+		if a.pvOrphansOnly {
+			title = fmt.Sprintf("PersistentVolumes (%d orphaned of %d)", len(orphanedVolumes(a.pvs)), len(a.pvs))
 		}
-		result.WriteByte(s[i])
-		visible++
-		i++
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-40s %-9s %-6s %-8s %-11s %-30s %-16s %s", "NAME", "CAPACITY", "ACCESS", "RECLAIM", "STATUS", "CLAIM", "CLASS", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.pvList.View()
 	}
-	// Pad if needed
-	for visible < n {
-		result.WriteByte(' ')
-		visible++
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
-	return result.String()
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-// ansiCutLeft returns the portion of s after n visible characters
-func ansiCutLeft(s string, n int) string {
-	visible := 0
-	i := 0
-	for i < len(s) && visible < n {
-		if s[i] == '\x1b' {
-			match := ansiRegex.FindStringIndex(s[i:])
-			if match != nil && match[0] == 0 {
-				i += match[1]
-				continue
-			}
-		}
-		visible++
-		i++
+func (a *App) renderPVDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading volume details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.pvDetails.View()
 	}
-	if i >= len(s) {
-		return ""
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
-	return s[i:]
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-// overlayContainerSelector overlays the container selector centered on screen
-func (a *App) overlayContainerSelector(view string) string {
-	selector := a.containerSelector.View()
-	if selector == "" {
-		return view
+// StorageClasses view
+func (a *App) renderStorageClassesView() string {
+	var contentStr string
+	if a.loading && len(a.storageClasses) == 0 {
+		contentStr = fmt.Sprintf("%s Loading storageclasses...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("StorageClasses (%d)", len(a.storageClasses))
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-30s %-36s %-8s %-22s %-9s %s", "NAME", "PROVISIONER", "RECLAIM", "BINDING", "EXPANSION", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.storageClassList.View()
 	}
-	return a.placeOverlay(view, selector)
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-// overlayDialog overlays the confirmation dialog centered on screen
-func (a *App) overlayDialog(view string) string {
-	dialog := a.confirmDialog.View()
-	if dialog == "" {
-		return view
+// API resources view
+func (a *App) renderAPIResourcesView() string {
+	var contentStr string
+	if a.loading && len(a.apiResources) == 0 {
+		contentStr = fmt.Sprintf("%s Discovering API resources...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("API Resources (%d)", len(a.apiResources))
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-40s %-12s %-36s %-10s %s", "NAME", "SHORTNAMES", "APIVERSION", "NAMESPACED", "KIND"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.apiResourceList.View()
 	}
-	return a.placeOverlay(view, dialog)
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-// overlayScaleDialog overlays the scale dialog centered on screen
-func (a *App) overlayScaleDialog(view string) string {
-	dialog := a.scaleDialog.View()
-	if dialog == "" {
-		return view
+// Resource browser view
+func (a *App) renderResourcesView() string {
+	res := a.selectedResource
+
+	var contentStr string
+	if a.resourceData == nil && a.err == nil {
+		contentStr = fmt.Sprintf("%s Loading %s...", a.spinner.View(), res.FullName())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		title := fmt.Sprintf("%s (%d)", res.Kind, len(a.resourceData.Objects))
+		if res.Namespaced && a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		title += " " + lipgloss.NewStyle().Foreground(colorMuted).Render(res.GroupVersion())
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		nsHeader := ""
+		if res.Namespaced {
+			nsHeader = a.namespaceHeader()
+		}
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render("  " + nsHeader + resourceListHeader(a.resourceData))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.resourceList.View()
 	}
-	return a.placeOverlay(view, dialog)
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-// Deployments view
-func (a *App) renderDeploymentsView() string {
+// HelmCharts view
+func (a *App) renderHelmChartsView() string {
 	var contentStr string
-	if a.loading && a.deploymentCount == 0 {
-		contentStr = fmt.Sprintf("%s Loading deployments...", a.spinner.View())
+	if a.loading && len(a.helmCharts) == 0 {
+		contentStr = fmt.Sprintf("%s Loading helmcharts...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		title := fmt.Sprintf("Deployments (%d)", a.deploymentCount)
+		failing := 0
+		for _, c := range a.helmCharts {
+			if c.Failing() {
+				failing++
+			}
+		}
+		title := fmt.Sprintf("HelmCharts (%d) [all namespaces]", len(a.helmCharts))
+		if failing > 0 {
+			title += lipgloss.NewStyle().Foreground(colorError).Render(fmt.Sprintf(" %d failing", failing))
+		}
 		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
 		sep := a.renderSeparator()
 		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
-			Render(fmt.Sprintf("  %-40s %-10s %-10s %-10s %s", "NAME", "READY", "UP-TO-DATE", "AVAILABLE", "AGE"))
-		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.deploymentList.View()
+			Render(fmt.Sprintf("  %-16s %-26s %-26s %-20s %-16s %-6s %-14s %s", "NAMESPACE", "NAME", "CHART", "VERSION", "TARGET", "CONFIG", "JOB", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.helmChartList.View()
 	}
 
 	h := a.height - 12
@@ -2932,25 +10183,46 @@ func (a *App) renderDeploymentsView() string {
 	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
 	footer := a.renderFooter()
 
-	var view string
-	view = a.assembleView(content, footer)
-	if a.confirmDialog.IsVisible() {
-		view = a.overlayDialog(view)
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderHelmChartDetailsView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading helmchart details...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.helmChartDetails.View()
 	}
-	if a.scaleDialog.IsVisible() {
-		view = a.overlayScaleDialog(view)
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
 	}
-	return view
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
 }
 
-func (a *App) renderDeploymentDetailsView() string {
+// ConfigMaps view
+func (a *App) renderConfigMapsView() string {
 	var contentStr string
-	if a.loading {
-		contentStr = fmt.Sprintf("%s Loading deployment details...", a.spinner.View())
+	if a.loading && a.configMapCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading configmaps...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		contentStr = a.deploymentDetails.View()
+		title := fmt.Sprintf("ConfigMaps (%d)", a.configMapCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %s%-45s %-6s %10s  %s", a.namespaceHeader(), "NAME", "KEYS", "SIZE", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.configMapList.View()
 	}
 
 	h := a.height - 12
@@ -2963,20 +10235,23 @@ func (a *App) renderDeploymentDetailsView() string {
 	return a.assembleView(content, footer)
 }
 
-// Services view
-func (a *App) renderServicesView() string {
+// Secrets view
+func (a *App) renderSecretsView() string {
 	var contentStr string
-	if a.loading && a.serviceCount == 0 {
-		contentStr = fmt.Sprintf("%s Loading services...", a.spinner.View())
+	if a.loading && a.secretCount == 0 {
+		contentStr = fmt.Sprintf("%s Loading secrets...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		title := fmt.Sprintf("Services (%d)", a.serviceCount)
+		title := fmt.Sprintf("Secrets (%d)", a.secretCount)
+		if a.allNamespaces {
+			title += " [all namespaces]"
+		}
 		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
 		sep := a.renderSeparator()
 		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
-			Render(fmt.Sprintf("  %-30s %-14s %-16s %-20s %-20s %s", "NAME", "TYPE", "CLUSTER-IP", "EXTERNAL-IP", "PORTS", "AGE"))
-		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.serviceList.View()
+			Render(fmt.Sprintf("  %s%-40s %-36s %-6s %10s  %s", a.namespaceHeader(), "NAME", "TYPE", "KEYS", "SIZE", "AGE"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.secretList.View()
 	}
 
 	h := a.height - 12
@@ -2989,14 +10264,43 @@ func (a *App) renderServicesView() string {
 	return a.assembleView(content, footer)
 }
 
-func (a *App) renderServiceDetailsView() string {
+// renderConfigDataView renders the keys and values of a configmap or secret
+func (a *App) renderConfigDataView() string {
 	var contentStr string
-	if a.loading {
-		contentStr = fmt.Sprintf("%s Loading service details...", a.spinner.View())
+	if a.loading && a.configData.Name() == "" {
+		contentStr = fmt.Sprintf("%s Loading data...", a.spinner.View())
 	} else if a.err != nil {
 		contentStr = a.renderError()
 	} else {
-		contentStr = a.serviceDetails.View()
+		header := a.configData.RenderHeader()
+		if a.searchInput.IsVisible() {
+			header += "\n" + a.searchInput.View()
+		}
+		contentStr = header + "\n" + a.configData.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+// Port-forwards view
+func (a *App) renderPortForwardsView() string {
+	var contentStr string
+	if len(a.portForwards) == 0 {
+		contentStr = "No active port-forwards.\n\nPress F on a pod or service to start one."
+	} else {
+		title := fmt.Sprintf("Port Forwards (%d)", len(a.portForwards))
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).Render(title)
+		sep := a.renderSeparator()
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-17s %-36s %-7s %-8s %-20s %-6s %s", "LOCAL", "TARGET", "REMOTE", "STATUS", "OUT / IN", "AGE", "ERROR"))
+		contentStr = titleLine + "\n" + sep + "\n" + headerLine + "\n" + a.portForwardList.View()
 	}
 
 	h := a.height - 12
@@ -3089,6 +10393,20 @@ func (a *App) renderSSHConnecting() string {
 		)
 	}
 
+	// Overlay host key prompt if visible
+	if a.hostKeyPrompt.IsVisible() {
+		dialog := a.hostKeyPrompt.View()
+		return lipgloss.Place(
+			a.width,
+			a.height,
+			lipgloss.Center,
+			lipgloss.Center,
+			dialog,
+			lipgloss.WithWhitespaceChars(" "),
+			lipgloss.WithWhitespaceForeground(lipgloss.Color("#1a1a1a")),
+		)
+	}
+
 	return view
 }
 
@@ -3103,6 +10421,9 @@ func (a *App) renderCrictlContainersView() string {
 		if a.selectedSSHHost != nil {
 			titleParts = append(titleParts, fmt.Sprintf("Node: %s", a.selectedSSHHost.Name))
 		}
+		if nodeName := a.crictlNodeName(); nodeName != "" && (a.selectedSSHHost == nil || nodeName != a.selectedSSHHost.Name) {
+			titleParts = append(titleParts, fmt.Sprintf("K8s node: %s", nodeName))
+		}
 		titleParts = append(titleParts, fmt.Sprintf("Containers: %d", len(a.crictlContainers)))
 		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).
 			Render(joinStrings(titleParts, " · "))
@@ -3147,6 +10468,204 @@ func (a *App) renderCrictlContainersView() string {
 	return a.assembleView(content, footer)
 }
 
+func (a *App) renderCrictlImagesView() string {
+	var contentStr string
+	if a.loading && len(a.crictlImages) == 0 {
+		contentStr = fmt.Sprintf("%s Loading images...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		total, unused, unusedCount := crictlImageUsage(a.crictlImageList)
+		var titleParts []string
+		if a.selectedSSHHost != nil {
+			titleParts = append(titleParts, fmt.Sprintf("Node: %s", a.selectedSSHHost.Name))
+		}
+		titleParts = append(titleParts,
+			fmt.Sprintf("Images: %d", len(a.crictlImages)),
+			fmt.Sprintf("Size: %s", formatBytes(int64(total))),
+			fmt.Sprintf("Unused: %d (%s)", unusedCount, formatBytes(int64(unused))),
+		)
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).
+			Render(joinStrings(titleParts, " · "))
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-50s %-13s %10s  %s", "IMAGE", "ID", "SIZE", "IN USE"))
+
+		contentStr = titleLine + "\n" + a.renderSeparator() + "\n" + headerLine + "\n" + a.crictlImageList.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderEtcdSnapshotsView() string {
+	var contentStr string
+	if a.loading && len(a.etcdSnapshots) == 0 {
+		contentStr = fmt.Sprintf("%s Loading etcd snapshots...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		local, s3, s3Count := etcdSnapshotUsage(a.etcdSnapshots)
+		var titleParts []string
+		if a.selectedSSHHost != nil {
+			titleParts = append(titleParts, fmt.Sprintf("Node: %s", a.selectedSSHHost.Name))
+		}
+		titleParts = append(titleParts,
+			fmt.Sprintf("Snapshots: %d", len(a.etcdSnapshots)),
+			fmt.Sprintf("Local: %s", formatBytes(int64(local))),
+			fmt.Sprintf("S3: %d (%s)", s3Count, formatBytes(int64(s3))),
+		)
+		if a.etcdStatus != nil {
+			titleParts = append(titleParts,
+				fmt.Sprintf("etcd %s", a.etcdStatus.Version),
+				fmt.Sprintf("DB: %s", formatBytes(int64(a.etcdStatus.DBSize))),
+			)
+		}
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).
+			Render(joinStrings(titleParts, " · "))
+
+		mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		var members string
+		if a.etcdMembersErr != nil {
+			members = lipgloss.NewStyle().Foreground(colorWarning).
+				Render(truncateString(fmt.Sprintf("  Members unavailable: %v", a.etcdMembersErr), max(a.contentWidth-2, 10)))
+		} else {
+			members = mutedStyle.Render(fmt.Sprintf("  %-45s %-9s %-17s %s", "MEMBER", "ROLE", "ID", "PEER URLS"))
+			if len(a.etcdMembers) > 0 {
+				members += "\n" + renderEtcdMembers(a.etcdMembers, a.etcdStatus, a.contentWidth)
+			}
+		}
+		headerLine := mutedStyle.
+			Render(fmt.Sprintf("  %-45s %-7s %10s  %-19s  %s", "NAME", "STORAGE", "SIZE", "CREATED", "LOCATION"))
+
+		contentStr = titleLine + "\n" + a.renderSeparator() + "\n" + members + "\n\n" + headerLine + "\n" + a.etcdSnapshotList.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderCrictlSandboxesView() string {
+	var contentStr string
+	if a.loading && len(a.crictlSandboxes) == 0 {
+		contentStr = fmt.Sprintf("%s Loading pod sandboxes...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		ready := 0
+		for _, p := range a.crictlSandboxes {
+			if p.Ready() {
+				ready++
+			}
+		}
+		var titleParts []string
+		if a.selectedSSHHost != nil {
+			titleParts = append(titleParts, fmt.Sprintf("Node: %s", a.selectedSSHHost.Name))
+		}
+		titleParts = append(titleParts,
+			fmt.Sprintf("Sandboxes: %d", len(a.crictlSandboxes)),
+			fmt.Sprintf("Ready: %d", ready),
+			fmt.Sprintf("NotReady: %d", len(a.crictlSandboxes)-ready),
+		)
+		titleLine := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary).
+			Render(joinStrings(titleParts, " · "))
+		headerLine := lipgloss.NewStyle().Foreground(colorMuted).
+			Render(fmt.Sprintf("  %-35s %-15s %-10s %-10s %-13s %s", "NAME", "NAMESPACE", "STATE", "CONTAINERS", "ID", "AGE"))
+
+		contentStr = titleLine + "\n" + a.renderSeparator() + "\n" + headerLine + "\n" + a.crictlSandboxList.View()
+	}
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderCrictlStatsView() string {
+	var contentStr string
+	if a.err != nil {
+		contentStr = a.renderError()
+	} else if !a.crictlStatsViewer.HasSamples() {
+		contentStr = fmt.Sprintf("%s Loading stats...", a.spinner.View())
+	} else {
+		nodeName := ""
+		if a.selectedSSHHost != nil {
+			nodeName = a.selectedSSHHost.Name
+		}
+		contentStr = a.crictlStatsViewer.RenderHeader(nodeName) + "\n" + a.crictlStatsViewer.View()
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderSSHRunView() string {
+	contentStr := a.sshRunViewer.RenderHeader() + "\n" + a.sshRunViewer.View()
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderSSHRunDiffView() string {
+	contentStr := a.diffViewer.RenderHeader() + "\n" + a.diffViewer.View()
+
+	h := a.height - 12
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
+func (a *App) renderCrictlInspectView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Inspecting container...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		contentStr = a.crictlInspect.RenderHeader() + "\n" + a.crictlInspect.View()
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
 func (a *App) renderCrictlLogsView() string {
 	var contentStr string
 	if a.loading {
@@ -3171,6 +10690,30 @@ func (a *App) renderCrictlLogsView() string {
 	return a.assembleView(content, footer)
 }
 
+func (a *App) renderK3sJournalView() string {
+	var contentStr string
+	if a.loading {
+		contentStr = fmt.Sprintf("%s Loading journal...", a.spinner.View())
+	} else if a.err != nil {
+		contentStr = a.renderError()
+	} else {
+		header := a.journalViewer.RenderHeader()
+		if a.searchInput.IsVisible() {
+			header += "\n" + a.searchInput.View()
+		}
+		contentStr = header + "\n" + a.journalViewer.View()
+	}
+
+	h := a.height - 10
+	if a.showSidebar {
+		h = a.height - 4
+	}
+	content := a.styles.Content.Width(a.contentWidth).Height(h).Render(contentStr)
+	footer := a.renderFooter()
+
+	return a.assembleView(content, footer)
+}
+
 // renderMultiPodLogsView renders the multi-pod log view
 func (a *App) renderMultiPodLogsView() string {
 	logHeader := a.multiPodLogViewer.RenderHeader()
@@ -3195,27 +10738,40 @@ func (a *App) overlayPodMultiSelector(view string) string {
 	return a.placeOverlay(view, selector)
 }
 
-// startMultiPodStreaming starts log streaming for multiple pods simultaneously
-func (a *App) startMultiPodStreaming(podNames []string) tea.Cmd {
+// multiPodTarget is a pod log stream shown in the multi-pod log view
+type multiPodTarget struct {
+	namespace string
+	pod       string
+	container string
+}
+
+// startMultiPodStreaming starts log streaming for multiple pods simultaneously.
+// Pods are identified by their podLabel in the pod list.
+func (a *App) startMultiPodStreaming(podLabels []string) tea.Cmd {
 	if a.k8sClient == nil {
 		return nil
 	}
 
 	a.stopMultiPodStreams()
 
-	// Build a map from pod name to first container
-	podContainerMap := make(map[string]string)
+	// Resolve each label to the pod's namespace, name and first container
+	targets := make(map[string]multiPodTarget, len(podLabels))
 	for _, item := range a.podList.Items() {
 		if pi, ok := item.(podItem); ok {
-			for _, name := range podNames {
-				if pi.pod.Name == name && len(pi.pod.Containers) > 0 {
-					podContainerMap[name] = pi.pod.Containers[0].Name
+			label := podLabel(pi.pod, a.allNamespaces)
+			for _, l := range podLabels {
+				if label == l {
+					target := multiPodTarget{namespace: pi.pod.Namespace, pod: pi.pod.Name}
+					if len(pi.pod.Containers) > 0 {
+						target.container = pi.pod.Containers[0].Name
+					}
+					targets[l] = target
 				}
 			}
 		}
 	}
 
-	a.multiPodLogViewer.SetPods(podNames)
+	a.multiPodLogViewer.SetPods(podLabels)
 	a.multiPodLogViewer.SetFollowing(true)
 	a.viewState = ViewMultiPodLogs
 
@@ -3223,47 +10779,39 @@ func (a *App) startMultiPodStreaming(podNames []string) tea.Cmd {
 	a.multiPodStreamCancel = cancel
 	a.multiPodStreamCtx = ctx
 	a.multiPodStreamActive = true
-	a.multiPodActiveStreams = len(podNames)
+	a.multiPodActiveStreams = len(podLabels)
 	a.multiPodLineChanMap = make(map[string]<-chan string)
-	a.multiPodContainerMap = make(map[string]string)
+	a.multiPodTargets = targets
 
-	namespace := a.k8sClient.CurrentNamespace()
 	var cmds []tea.Cmd
-
-	for _, podName := range podNames {
-		container := podContainerMap[podName]
-		a.multiPodContainerMap[podName] = container
-
-		cmds = append(cmds, a.startSinglePodStream(ctx, namespace, podName, container, true))
+	for _, label := range podLabels {
+		cmds = append(cmds, a.startSinglePodStream(ctx, label, targets[label], true))
 	}
 
 	return tea.Batch(cmds...)
 }
 
 // startSinglePodStream starts a log stream for a single pod within the multi-pod context
-func (a *App) startSinglePodStream(ctx context.Context, namespace, podName, container string, withHistory bool) tea.Cmd {
+func (a *App) startSinglePodStream(ctx context.Context, label string, target multiPodTarget, withHistory bool) tea.Cmd {
 	lineChan := make(chan string, 100)
-	a.multiPodLineChanMap[podName] = lineChan
+	a.multiPodLineChanMap[label] = lineChan
 
 	var tailLines int64
 	if withHistory {
 		tailLines = 100
 	}
 
-	pn := podName
-	cn := container
-
 	go func() {
 		defer close(lineChan)
-		_ = a.k8sClient.StreamPodLogs(ctx, namespace, pn, k8s.LogOptions{
-			Container:  cn,
+		_ = a.k8sClient.StreamPodLogs(ctx, target.namespace, target.pod, k8s.LogOptions{
+			Container:  target.container,
 			TailLines:  tailLines,
 			Timestamps: false,
 			Follow:     true,
 		}, lineChan)
 	}()
 
-	return a.waitForMultiPodLogLine(pn, cn, lineChan)
+	return a.waitForMultiPodLogLine(label, target.container, lineChan)
 }
 
 // waitForMultiPodLogLine returns a command that reads from a pod's log channel
@@ -3307,11 +10855,10 @@ func (a *App) handleMultiPodLogStreamEnded(msg multiPodLogStreamEndedMsg) (tea.M
 
 	// Auto-restart the stream if still in multi-pod log view and following
 	if a.viewState == ViewMultiPodLogs && a.multiPodStreamActive && a.multiPodStreamCtx != nil {
-		container := a.multiPodContainerMap[msg.podName]
-		namespace := a.k8sClient.CurrentNamespace()
+		target := a.multiPodTargets[msg.podName]
 		logger.Debug("Multi-pod stream ended, auto-restarting", "pod", msg.podName)
 		// Restart with TailLines=0 (only new logs, since we already have history)
-		cmd := a.startSinglePodStream(a.multiPodStreamCtx, namespace, msg.podName, container, false)
+		cmd := a.startSinglePodStream(a.multiPodStreamCtx, msg.podName, target, false)
 		return a, cmd
 	}
 
@@ -3332,5 +10879,5 @@ func (a *App) stopMultiPodStreams() {
 	a.multiPodStreamActive = false
 	a.multiPodActiveStreams = 0
 	a.multiPodLineChanMap = nil
-	a.multiPodContainerMap = nil
+	a.multiPodTargets = nil
 }
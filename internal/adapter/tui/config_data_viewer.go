@@ -0,0 +1,471 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+const (
+	// configDataMaxKeyRows caps the keys table; the rest of the height goes
+	// to the value of the selected key
+	configDataMaxKeyRows = 8
+	// secretMask replaces secret values until they are revealed
+	secretMask = "••••••••"
+	// certExpiryWarning is how close to expiry a certificate is shown in yellow
+	certExpiryWarning = 30 * 24 * time.Hour
+)
+
+// ConfigDataViewer shows the keys of a ConfigMap or Secret and the value of
+// the selected key. Secret values stay masked until revealed.
+type ConfigDataViewer struct {
+	kind            string
+	namespace       string
+	name            string
+	secretType      string
+	resourceVersion string
+	items           []domain.DataItem
+	certs           []domain.Certificate
+	certErr         string
+	masked          bool
+	selected        int
+	lines           []string // lines of the selected value
+	viewport        viewport.Model
+	width           int
+	height          int
+	ready           bool
+	searchQuery     string
+	matches         []int // Indices of value lines matching search
+}
+
+// NewConfigDataViewer creates a new config data viewer
+func NewConfigDataViewer() ConfigDataViewer {
+	return ConfigDataViewer{}
+}
+
+// SetConfigMap shows a ConfigMap
+func (c *ConfigDataViewer) SetConfigMap(cm *domain.ConfigMap) {
+	c.set("ConfigMap", cm.Namespace, cm.Name, cm.ResourceVersion, cm.Data)
+	c.secretType = ""
+	c.certs = nil
+	c.certErr = ""
+	c.masked = false
+	c.update()
+}
+
+// SetSecret shows a Secret. Values are masked when another secret was shown
+// before; a reload of the same secret keeps them revealed.
+func (c *ConfigDataViewer) SetSecret(s *domain.Secret) {
+	if c.kind != "Secret" || c.namespace != s.Namespace || c.name != s.Name {
+		c.masked = true
+	}
+	c.set("Secret", s.Namespace, s.Name, s.ResourceVersion, s.Data)
+	c.secretType = s.Type
+	c.certs = s.Certificates
+	c.certErr = s.CertError
+	c.update()
+}
+
+// set replaces the object shown, keeping the selected key on a reload
+func (c *ConfigDataViewer) set(kind, namespace, name, resourceVersion string, items []domain.DataItem) {
+	same := c.kind == kind && c.namespace == namespace && c.name == name
+	selectedKey := ""
+	if same {
+		if item, ok := c.Selected(); ok {
+			selectedKey = item.Key
+		}
+	} else {
+		c.searchQuery = ""
+	}
+
+	c.kind = kind
+	c.namespace = namespace
+	c.name = name
+	c.resourceVersion = resourceVersion
+	c.items = items
+	c.selected = 0
+	for i, item := range items {
+		if item.Key == selectedKey {
+			c.selected = i
+		}
+	}
+}
+
+// Clear clears the viewer
+func (c *ConfigDataViewer) Clear() {
+	c.kind = ""
+	c.namespace = ""
+	c.name = ""
+	c.items = nil
+	c.certs = nil
+	c.certErr = ""
+	c.searchQuery = ""
+	c.update()
+}
+
+// SetSize sets the size of the keys table and value viewport together
+func (c *ConfigDataViewer) SetSize(width, height int) {
+	c.width = width
+	c.height = height
+	c.viewport = viewport.New(width, 1)
+	c.viewport.Style = lipgloss.NewStyle()
+	c.ready = true
+	c.update()
+}
+
+// Kind returns "ConfigMap" or "Secret"
+func (c *ConfigDataViewer) Kind() string {
+	return c.kind
+}
+
+// Namespace returns the namespace of the shown object
+func (c *ConfigDataViewer) Namespace() string {
+	return c.namespace
+}
+
+// Name returns the name of the shown object
+func (c *ConfigDataViewer) Name() string {
+	return c.name
+}
+
+// ResourceVersion returns the resource version the values were read at
+func (c *ConfigDataViewer) ResourceVersion() string {
+	return c.resourceVersion
+}
+
+// Selected returns the selected key
+func (c *ConfigDataViewer) Selected() (domain.DataItem, bool) {
+	if c.selected < 0 || c.selected >= len(c.items) {
+		return domain.DataItem{}, false
+	}
+	return c.items[c.selected], true
+}
+
+// Masked returns whether secret values are hidden
+func (c *ConfigDataViewer) Masked() bool {
+	return c.masked
+}
+
+// ToggleReveal shows or hides secret values
+func (c *ConfigDataViewer) ToggleReveal() {
+	if c.kind != "Secret" {
+		return
+	}
+	c.masked = !c.masked
+	c.update()
+}
+
+// SetSearchQuery sets the search query and jumps to the first match in the
+// value of the selected key
+func (c *ConfigDataViewer) SetSearchQuery(query string) {
+	c.searchQuery = query
+	c.update()
+	c.GotoMatch(1)
+}
+
+// SearchQuery returns the current search query
+func (c *ConfigDataViewer) SearchQuery() string {
+	return c.searchQuery
+}
+
+// MatchCount returns the number of value lines matching the search
+func (c *ConfigDataViewer) MatchCount() int {
+	return len(c.matches)
+}
+
+// GotoMatch scrolls to the n-th match (1-based)
+func (c *ConfigDataViewer) GotoMatch(n int) {
+	if !c.ready || n < 1 || n > len(c.matches) {
+		return
+	}
+	// Keep some context above the match
+	offset := c.matches[n-1] - c.viewport.Height/3
+	if offset < 0 {
+		offset = 0
+	}
+	c.viewport.SetYOffset(offset)
+}
+
+// selectKey moves the key selection and shows the value from the top
+func (c *ConfigDataViewer) selectKey(index int) {
+	if index < 0 || index >= len(c.items) || index == c.selected {
+		return
+	}
+	c.selected = index
+	c.update()
+	c.viewport.GotoTop()
+	c.GotoMatch(1)
+}
+
+// valueLines returns the lines shown for an item
+func (c *ConfigDataViewer) valueLines(item domain.DataItem) []string {
+	switch {
+	case c.kind == "Secret" && c.masked:
+		return []string{secretMask}
+	case item.Binary:
+		return []string{fmt.Sprintf("(binary, %s)", formatBytes(int64(len(item.Value))))}
+	case len(item.Value) == 0:
+		return []string{"(empty)"}
+	}
+	return strings.Split(strings.TrimRight(string(item.Value), "\n"), "\n")
+}
+
+// searchable reports whether the value of the selected key is plain text
+func (c *ConfigDataViewer) searchable() bool {
+	item, ok := c.Selected()
+	return ok && !item.Binary && !(c.kind == "Secret" && c.masked)
+}
+
+// tableHeight returns the lines used above the value viewport
+func (c *ConfigDataViewer) tableHeight() int {
+	// Keys header, at least one row and the value title
+	return len(c.certLines()) + max(min(len(c.items), configDataMaxKeyRows), 1) + 2
+}
+
+func (c *ConfigDataViewer) update() {
+	c.lines = nil
+	c.matches = nil
+	if item, ok := c.Selected(); ok {
+		c.lines = c.valueLines(item)
+	}
+
+	if c.searchQuery != "" && c.searchable() {
+		query := strings.ToLower(c.searchQuery)
+		for i, line := range c.lines {
+			if strings.Contains(strings.ToLower(line), query) {
+				c.matches = append(c.matches, i)
+			}
+		}
+	}
+
+	if !c.ready {
+		return
+	}
+	c.viewport.Height = max(c.height-c.tableHeight(), 1)
+
+	if len(c.items) == 0 {
+		c.viewport.SetContent(lipgloss.NewStyle().Foreground(colorMuted).Render("No data"))
+		return
+	}
+
+	valueStyle := lipgloss.NewStyle().Foreground(colorText)
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	query := strings.ToLower(c.searchQuery)
+	var sb strings.Builder
+	for i, line := range c.lines {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		switch {
+		case !c.searchable():
+			sb.WriteString(mutedStyle.Render(line))
+		case query != "" && strings.Contains(strings.ToLower(line), query):
+			sb.WriteString(highlightMatch(line, query))
+		default:
+			sb.WriteString(valueStyle.Render(line))
+		}
+	}
+	c.viewport.SetContent(sb.String())
+}
+
+// Update handles messages. Up/down select the key; the value scrolls with
+// page keys and g/G.
+func (c ConfigDataViewer) Update(msg tea.Msg) (ConfigDataViewer, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "up", "k":
+			c.selectKey(c.selected - 1)
+			return c, nil
+		case "down", "j":
+			c.selectKey(c.selected + 1)
+			return c, nil
+		case "g", "home":
+			c.viewport.GotoTop()
+			return c, nil
+		case "G", "end":
+			c.viewport.GotoBottom()
+			return c, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	c.viewport, cmd = c.viewport.Update(msg)
+	return c, cmd
+}
+
+// View renders the certificate block, the keys table and the selected value
+func (c ConfigDataViewer) View() string {
+	if !c.ready {
+		return "Loading..."
+	}
+
+	var sections []string
+	if certs := c.certLines(); len(certs) > 0 {
+		sections = append(sections, strings.Join(certs, "\n"))
+	}
+	sections = append(sections, c.renderKeys())
+
+	item, _ := c.Selected()
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(colorSecondary)
+	title := titleStyle.Render("VALUE")
+	if item.Key != "" {
+		title += " " + lipgloss.NewStyle().Foreground(colorText).Render(truncateString(item.Key, max(c.width-10, 10)))
+	}
+	sections = append(sections, title, c.viewport.View())
+
+	return strings.Join(sections, "\n")
+}
+
+// renderKeys renders the keys table, scrolled to keep the selection visible
+func (c *ConfigDataViewer) renderKeys() string {
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(colorSecondary)
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	keyWidth := max(c.width-20, 20)
+	lines := []string{headerStyle.Render(fmt.Sprintf("  %-*s %10s", keyWidth, "KEY", "SIZE"))}
+	if len(c.items) == 0 {
+		return strings.Join(append(lines, mutedStyle.Render("  (no keys)")), "\n")
+	}
+
+	rows := min(len(c.items), configDataMaxKeyRows)
+	start := 0
+	if c.selected >= rows {
+		start = c.selected - rows + 1
+	}
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+	for i := start; i < start+rows; i++ {
+		item := c.items[i]
+		size := formatBytes(int64(len(item.Value)))
+		if item.Binary {
+			size = "bin " + size
+		}
+
+		// Pad plain text FIRST, then apply styling
+		keyPadded := fmt.Sprintf("%-*s", keyWidth, truncateString(item.Key, keyWidth))
+		sizeStyled := mutedStyle.Render(fmt.Sprintf("%10s", size))
+
+		if i == c.selected {
+			keyStyled := lipgloss.NewStyle().Bold(true).Foreground(colorText).Render(keyPadded)
+			line := fmt.Sprintf("%s %s %s", prefix, keyStyled, sizeStyled)
+			lines = append(lines, lipgloss.NewStyle().Background(colorBgHighlight).Render(line))
+		} else {
+			keyStyled := lipgloss.NewStyle().Foreground(colorText).Render(keyPadded)
+			lines = append(lines, fmt.Sprintf("  %s %s", keyStyled, sizeStyled))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// certLines renders the certificates of a TLS secret, leaf first
+func (c *ConfigDataViewer) certLines() []string {
+	if c.secretType != domain.SecretTypeTLS {
+		return nil
+	}
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(colorSecondary)
+	labelStyle := lipgloss.NewStyle().Foreground(colorMuted).Width(10)
+	valueStyle := lipgloss.NewStyle().Foreground(colorText)
+
+	lines := []string{headerStyle.Render("CERTIFICATE")}
+	if c.certErr != "" {
+		lines = append(lines, "  "+lipgloss.NewStyle().Foreground(colorError).Render(c.certErr))
+		return lines
+	}
+
+	valueWidth := max(c.width-14, 10)
+	for i, cert := range c.certs {
+		if i > 0 {
+			// Only the leaf is shown in full; intermediates by subject
+			lines = append(lines, "  "+labelStyle.Render("Chain")+valueStyle.Render(truncateString(cert.Subject, valueWidth)))
+			continue
+		}
+		lines = append(lines, "  "+labelStyle.Render("Subject")+valueStyle.Render(truncateString(cert.Subject, valueWidth)))
+		lines = append(lines, "  "+labelStyle.Render("Issuer")+valueStyle.Render(truncateString(cert.Issuer, valueWidth)))
+		sans := append(append([]string{}, cert.DNSNames...), cert.IPAddresses...)
+		if len(sans) > 0 {
+			lines = append(lines, "  "+labelStyle.Render("SANs")+valueStyle.Render(truncateString(strings.Join(sans, ", "), valueWidth)))
+		}
+		lines = append(lines, "  "+labelStyle.Render("Expires")+renderCertExpiry(cert))
+	}
+	lines = append(lines, "")
+	return lines
+}
+
+// renderCertExpiry renders the validity of a certificate, colored by how
+// close it is to expiry
+func renderCertExpiry(cert domain.Certificate) string {
+	remaining := time.Until(cert.NotAfter)
+	date := cert.NotAfter.Local().Format("2006-01-02 15:04")
+
+	var text string
+	style := lipgloss.NewStyle().Foreground(colorSuccess)
+	switch {
+	case time.Now().Before(cert.NotBefore):
+		text = fmt.Sprintf("%s (not valid before %s)", date, cert.NotBefore.Local().Format("2006-01-02 15:04"))
+		style = lipgloss.NewStyle().Foreground(colorWarning)
+	case remaining <= 0:
+		text = fmt.Sprintf("%s (expired %s ago)", date, formatDays(-remaining))
+		style = lipgloss.NewStyle().Foreground(colorError)
+	case remaining < certExpiryWarning:
+		text = fmt.Sprintf("%s (in %s)", date, formatDays(remaining))
+		style = lipgloss.NewStyle().Foreground(colorWarning)
+	default:
+		text = fmt.Sprintf("%s (in %s)", date, formatDays(remaining))
+	}
+	return style.Render(text)
+}
+
+// formatDays formats a duration in whole days, or hours below one day
+func formatDays(d time.Duration) string {
+	if d < 24*time.Hour {
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
+
+// ScrollPercent returns the scroll percentage of the value
+func (c *ConfigDataViewer) ScrollPercent() float64 {
+	return c.viewport.ScrollPercent()
+}
+
+// RenderHeader returns the config data viewer header
+func (c *ConfigDataViewer) RenderHeader() string {
+	target := c.name
+	if c.namespace != "" {
+		target = c.namespace + "/" + c.name
+	}
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary)
+	header := titleStyle.Render(fmt.Sprintf("%s: %s", c.kind, target))
+
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+	if c.secretType != "" {
+		header += "  " + infoStyle.Render(fmt.Sprintf("Type: %s", c.secretType))
+	}
+	header += "  " + infoStyle.Render(fmt.Sprintf("Keys: %d", len(c.items)))
+
+	if c.kind == "Secret" {
+		if c.masked {
+			header += "  " + lipgloss.NewStyle().Foreground(colorMuted).Render("◌ Masked")
+		} else {
+			indicator := lipgloss.NewStyle().Foreground(colorWarning).Render("◉")
+			header += "  " + indicator + " " + lipgloss.NewStyle().Foreground(colorText).Render("Revealed")
+		}
+	}
+
+	if c.searchQuery != "" {
+		searchStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		header += "  " + searchStyle.Render(fmt.Sprintf("Search: '%s' (%d)", c.searchQuery, c.MatchCount()))
+	}
+
+	header += "  " + infoStyle.Render(fmt.Sprintf("%.0f%%", c.ScrollPercent()*100))
+	return header
+}
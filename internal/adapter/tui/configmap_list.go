@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// configMapItem implements list.Item for configmaps
+type configMapItem struct {
+	configMap domain.ConfigMap
+}
+
+func (i configMapItem) FilterValue() string { return i.configMap.Name }
+
+// configMapDelegate renders configmap list items
+type configMapDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d configMapDelegate) Height() int                             { return 1 }
+func (d configMapDelegate) Spacing() int                            { return 0 }
+func (d configMapDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d configMapDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(configMapItem)
+	if !ok {
+		return
+	}
+
+	cm := item.configMap
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-45s", truncateString(cm.Name, 45))
+	keysPadded := fmt.Sprintf("%-6d", cm.Keys)
+	sizePadded := fmt.Sprintf("%10s", formatBytes(int64(cm.Size)))
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(cm.Namespace, 20))) + " "
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	keysStyled := lipgloss.NewStyle().Foreground(colorText).Render(keysPadded)
+	sizeStyled := mutedStyle.Render(sizePadded)
+	ageStyled := mutedStyle.Render(cm.Age)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s  %s", prefix, nsColumn+nameStyle.Render(namePadded), keysStyled, sizeStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s  %s", nsColumn+nameStyle.Render(namePadded), keysStyled, sizeStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newConfigMapList creates a list model for configmaps. showNamespace adds a
+// NAMESPACE column for lists spanning all namespaces.
+func newConfigMapList(configMaps []domain.ConfigMap, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(configMaps))
+	for i, cm := range configMaps {
+		items[i] = configMapItem{configMap: cm}
+	}
+
+	delegate := configMapDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateConfigMapList updates the configmap list items while preserving selection
+func updateConfigMapList(l *list.Model, configMaps []domain.ConfigMap) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(configMapItem); ok {
+		currentName = item.configMap.Name
+		currentNamespace = item.configMap.Namespace
+	}
+
+	items := make([]list.Item, len(configMaps))
+	newIndex := 0
+	for i, cm := range configMaps {
+		items[i] = configMapItem{configMap: cm}
+		if cm.Name == currentName && cm.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -17,6 +17,20 @@ const (
 	ConfirmActionRestartPod
 	ConfirmActionDeleteDeployment
 	ConfirmActionRestartDeployment
+	ConfirmActionRestartStatefulSet
+	ConfirmActionRestartDaemonSet
+	ConfirmActionTriggerCronJob
+	ConfirmActionApplyEdit
+	ConfirmActionRollbackDeployment
+	ConfirmActionStopContainer
+	ConfirmActionRemoveContainer
+	ConfirmActionRemoveImage
+	ConfirmActionPruneImages
+	ConfirmActionRemoveSandbox
+	ConfirmActionPruneSandboxes
+	ConfirmActionDeleteSnapshot
+	ConfirmActionPruneSnapshots
+	ConfirmActionDeleteResource
 )
 
 // ConfirmDialog is a confirmation dialog model
@@ -24,6 +38,7 @@ type ConfirmDialog struct {
 	action     ConfirmAction
 	title      string
 	message    string
+	namespace  string
 	targetName string
 	visible    bool
 	width      int
@@ -37,29 +52,87 @@ func NewConfirmDialog() ConfirmDialog {
 }
 
 // Show displays the confirmation dialog and returns a tea.Cmd to initialise the form.
-func (d *ConfirmDialog) Show(action ConfirmAction, targetName string) tea.Cmd {
+// The target is named namespace/name so it is unambiguous across namespaces.
+func (d *ConfirmDialog) Show(action ConfirmAction, namespace, targetName string) tea.Cmd {
+	return d.ShowDetail(action, namespace, targetName, "")
+}
+
+// ShowDetail is like Show but adds a line describing the action more
+// precisely, e.g. the revision a rollback goes to
+func (d *ConfirmDialog) ShowDetail(action ConfirmAction, namespace, targetName, detail string) tea.Cmd {
 	d.action = action
+	d.namespace = namespace
 	d.targetName = targetName
 	d.visible = true
 	d.confirmed = false
 
+	target := targetName
+	if namespace != "" {
+		target = namespace + "/" + targetName
+	}
+
 	switch action {
 	case ConfirmActionDeletePod:
 		d.title = "Delete Pod"
-		d.message = fmt.Sprintf("Are you sure you want to delete pod '%s'?", targetName)
+		d.message = fmt.Sprintf("Are you sure you want to delete pod '%s'?", target)
 	case ConfirmActionRestartPod:
 		d.title = "Restart Pod"
-		d.message = fmt.Sprintf("Are you sure you want to restart pod '%s'?\n(This will delete the pod; the controller will recreate it)", targetName)
+		d.message = fmt.Sprintf("Are you sure you want to restart pod '%s'?\n(This will delete the pod; the controller will recreate it)", target)
 	case ConfirmActionDeleteDeployment:
 		d.title = "Delete Deployment"
-		d.message = fmt.Sprintf("Are you sure you want to delete deployment '%s'?\n(All associated pods will be terminated)", targetName)
+		d.message = fmt.Sprintf("Are you sure you want to delete deployment '%s'?\n(All associated pods will be terminated)", target)
 	case ConfirmActionRestartDeployment:
 		d.title = "Restart Deployment"
-		d.message = fmt.Sprintf("Are you sure you want to restart deployment '%s'?\n(This triggers a rolling restart of all pods)", targetName)
+		d.message = fmt.Sprintf("Are you sure you want to restart deployment '%s'?\n(This triggers a rolling restart of all pods)", target)
+	case ConfirmActionRestartStatefulSet:
+		d.title = "Restart StatefulSet"
+		d.message = fmt.Sprintf("Are you sure you want to restart statefulset '%s'?\n(Pods are recreated one at a time in reverse ordinal order)", target)
+	case ConfirmActionRestartDaemonSet:
+		d.title = "Restart DaemonSet"
+		d.message = fmt.Sprintf("Are you sure you want to restart daemonset '%s'?\n(This triggers a rolling restart of the pod on every node)", target)
+	case ConfirmActionTriggerCronJob:
+		d.title = "Run CronJob"
+		d.message = fmt.Sprintf("Run cronjob '%s' now?\n(This creates a job from its job template)", target)
+	case ConfirmActionApplyEdit:
+		d.title = "Apply Edit"
+		d.message = fmt.Sprintf("Apply your changes to '%s'?\n(The server-side dry-run passed)", target)
+	case ConfirmActionRollbackDeployment:
+		d.title = "Roll Back Deployment"
+		d.message = fmt.Sprintf("Are you sure you want to roll back deployment '%s'?", target)
+	case ConfirmActionStopContainer:
+		d.title = "Stop Container"
+		d.message = fmt.Sprintf("Are you sure you want to stop container '%s'?\n(The kubelet restarts it if its pod still exists)", target)
+	case ConfirmActionRemoveContainer:
+		d.title = "Remove Container"
+		d.message = fmt.Sprintf("Are you sure you want to remove container '%s'?\n(Its logs and writable layer are deleted)", target)
+	case ConfirmActionRemoveImage:
+		d.title = "Remove Image"
+		d.message = fmt.Sprintf("Are you sure you want to remove image '%s'?", target)
+	case ConfirmActionPruneImages:
+		d.title = "Prune Images"
+		d.message = fmt.Sprintf("Remove all images not used by a container from '%s'?\n(Pinned images are kept)", target)
+	case ConfirmActionRemoveSandbox:
+		d.title = "Remove Pod Sandbox"
+		d.message = fmt.Sprintf("Are you sure you want to remove pod sandbox '%s'?\n(Its containers are removed too)", target)
+	case ConfirmActionPruneSandboxes:
+		d.title = "Remove NotReady Sandboxes"
+		d.message = fmt.Sprintf("Remove all NotReady pod sandboxes from '%s'?\n(Their containers are removed too)", target)
+	case ConfirmActionDeleteSnapshot:
+		d.title = "Delete etcd Snapshot"
+		d.message = fmt.Sprintf("Are you sure you want to delete snapshot '%s'?", target)
+	case ConfirmActionPruneSnapshots:
+		d.title = "Prune etcd Snapshots"
+		d.message = fmt.Sprintf("Delete the oldest snapshots of '%s' beyond its retention?\n(etcd-snapshot-retention, 5 by default)", target)
+	case ConfirmActionDeleteResource:
+		d.title = "Delete Resource"
+		d.message = fmt.Sprintf("Are you sure you want to delete '%s'?", target)
 	default:
 		d.title = "Confirm"
 		d.message = "Are you sure?"
 	}
+	if detail != "" {
+		d.message += "\n" + detail
+	}
 
 	d.form = huh.NewForm(
 		huh.NewGroup(
@@ -78,6 +151,7 @@ func (d *ConfirmDialog) Show(action ConfirmAction, targetName string) tea.Cmd {
 func (d *ConfirmDialog) Hide() {
 	d.visible = false
 	d.action = ConfirmActionNone
+	d.namespace = ""
 	d.targetName = ""
 	d.form = nil
 }
@@ -92,6 +166,11 @@ func (d *ConfirmDialog) Action() ConfirmAction {
 	return d.action
 }
 
+// Namespace returns the namespace of the target
+func (d *ConfirmDialog) Namespace() string {
+	return d.namespace
+}
+
 // TargetName returns the target name for the action
 func (d *ConfirmDialog) TargetName() string {
 	return d.targetName
@@ -6,8 +6,17 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
+// ContainerSelectPurpose describes what the selected container is used for
+type ContainerSelectPurpose int
+
+const (
+	ContainerSelectLogs ContainerSelectPurpose = iota
+	ContainerSelectExec
+)
+
 // ContainerSelector is a component for selecting a container
 type ContainerSelector struct {
+	purpose    ContainerSelectPurpose
 	containers []string
 	selected   string
 	visible    bool
@@ -21,7 +30,8 @@ func NewContainerSelector() ContainerSelector {
 }
 
 // Show displays the container selector and returns a tea.Cmd.
-func (c *ContainerSelector) Show(containers []string, currentContainer string) tea.Cmd {
+func (c *ContainerSelector) Show(purpose ContainerSelectPurpose, containers []string, currentContainer string) tea.Cmd {
+	c.purpose = purpose
 	c.containers = containers
 	c.visible = true
 	c.selected = currentContainer
@@ -31,6 +41,11 @@ func (c *ContainerSelector) Show(containers []string, currentContainer string) t
 		c.selected = containers[0]
 	}
 
+	title := "Select Container"
+	if purpose == ContainerSelectExec {
+		title = "Open Shell In Container"
+	}
+
 	opts := make([]huh.Option[string], len(containers))
 	for i, name := range containers {
 		opts[i] = huh.NewOption(name, name)
@@ -39,7 +54,7 @@ func (c *ContainerSelector) Show(containers []string, currentContainer string) t
 	c.form = huh.NewForm(
 		huh.NewGroup(
 			huh.NewSelect[string]().
-				Title("Select Container").
+				Title(title).
 				Options(opts...).
 				Value(&c.selected),
 		),
@@ -63,6 +78,11 @@ func (c *ContainerSelector) SetWidth(width int) {
 	c.width = width
 }
 
+// Purpose returns what the selection was requested for
+func (c *ContainerSelector) Purpose() ContainerSelectPurpose {
+	return c.purpose
+}
+
 // SelectedContainer returns the currently selected container name
 func (c *ContainerSelector) SelectedContainer() string {
 	return c.selected
@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// contextItem implements list.Item for kubeconfig contexts
+type contextItem struct {
+	context domain.KubeContext
+}
+
+func (i contextItem) FilterValue() string { return i.context.Name }
+
+// contextDelegate renders context list items
+type contextDelegate struct {
+	styles Styles
+}
+
+func (d contextDelegate) Height() int                             { return 2 }
+func (d contextDelegate) Spacing() int                            { return 1 }
+func (d contextDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d contextDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(contextItem)
+	if !ok {
+		return
+	}
+
+	ctx := item.context
+
+	details := fmt.Sprintf("cluster: %s  user: %s", ctx.Cluster, ctx.User)
+	if ctx.Namespace != "" {
+		details += fmt.Sprintf("  namespace: %s", ctx.Namespace)
+	}
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+	bgStyle := lipgloss.NewStyle().Background(colorBgHighlight)
+
+	currentTag := ""
+	if ctx.Current {
+		currentTag = " (current)"
+	}
+
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		detailStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		line1 := bgStyle.Render(fmt.Sprintf("%s %s%s", prefix, nameStyle.Render(ctx.Name), currentTag))
+		line2 := bgStyle.Render(fmt.Sprintf("  %s", detailStyle.Render(details)))
+		fmt.Fprintf(w, "%s\n%s", line1, line2)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		detailStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		fmt.Fprintf(w, "  %s%s\n  %s", nameStyle.Render(ctx.Name), currentTag, detailStyle.Render(details))
+	}
+}
+
+// newContextList creates a list model for kubeconfig contexts.
+// The active context (or the kubeconfig's current-context) is preselected.
+func newContextList(contexts []domain.KubeContext, active string, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(contexts))
+	selected := 0
+	for i, ctx := range contexts {
+		items[i] = contextItem{context: ctx}
+		if (active == "" && ctx.Current) || (active != "" && ctx.Name == active) {
+			selected = i
+		}
+	}
+
+	delegate := contextDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.Title = "Select Context"
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.Title = styles.Title
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Select(selected)
+
+	return l
+}
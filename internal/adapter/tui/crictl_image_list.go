@@ -0,0 +1,140 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// crictlImageItem implements list.Item for crictl images
+type crictlImageItem struct {
+	image ssh.CrictlImage
+	users int // containers running the image
+}
+
+func (i crictlImageItem) FilterValue() string {
+	return strings.Join(i.image.Tags, " ") + " " + i.image.ImageIDShort
+}
+
+// imageDisplayName returns the first tag of an image, or its repository
+// with "<none>" when it is untagged
+func imageDisplayName(image ssh.CrictlImage) string {
+	if len(image.Tags) > 0 {
+		name := image.Tags[0]
+		if len(image.Tags) > 1 {
+			name += fmt.Sprintf(" +%d", len(image.Tags)-1)
+		}
+		return name
+	}
+	if len(image.Digests) > 0 {
+		repo, _, _ := strings.Cut(image.Digests[0], "@")
+		return repo + ":<none>"
+	}
+	return "<none>"
+}
+
+// crictlImageDelegate renders crictl image list items
+type crictlImageDelegate struct {
+	styles Styles
+}
+
+func (d crictlImageDelegate) Height() int                             { return 1 }
+func (d crictlImageDelegate) Spacing() int                            { return 0 }
+func (d crictlImageDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d crictlImageDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(crictlImageItem)
+	if !ok {
+		return
+	}
+
+	img := item.image
+
+	usage := "unused"
+	usageStyle := lipgloss.NewStyle().Foreground(colorWarning)
+	if item.users > 0 {
+		usage = fmt.Sprintf("%d container", item.users)
+		if item.users > 1 {
+			usage += "s"
+		}
+		usageStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	}
+	if img.Pinned {
+		usage += ", pinned"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: IMAGE(50) ID(13) SIZE(10) IN USE
+	namePadded := fmt.Sprintf("%-50s", truncateString(imageDisplayName(img), 50))
+	idPadded := fmt.Sprintf("%-13s", img.ImageIDShort)
+	sizePadded := fmt.Sprintf("%10s", formatBytes(int64(img.Size)))
+
+	idStyled := lipgloss.NewStyle().Foreground(colorMuted).Render(idPadded)
+	sizeStyled := lipgloss.NewStyle().Foreground(colorText).Render(sizePadded)
+	usageStyled := usageStyle.Render(usage)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s  %s", prefix, nameStyle.Render(namePadded), idStyled, sizeStyled, usageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s  %s", nameStyle.Render(namePadded), idStyled, sizeStyled, usageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newCrictlImageList creates a list model for crictl images
+func newCrictlImageList(width, height int, styles Styles) list.Model {
+	l := list.New(nil, crictlImageDelegate{styles: styles}, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateCrictlImageList updates the crictl image list, counting the
+// containers that use each image
+func updateCrictlImageList(l *list.Model, images []ssh.CrictlImage, containers []ssh.CrictlContainer) {
+	items := make([]list.Item, len(images))
+	for i, img := range images {
+		users := 0
+		for _, c := range containers {
+			if img.UsedBy(c) {
+				users++
+			}
+		}
+		items[i] = crictlImageItem{image: img, users: users}
+	}
+	l.SetItems(items)
+}
+
+// crictlImageUsage sums the size of all images and of the unused ones
+func crictlImageUsage(l list.Model) (total, unused uint64, unusedCount int) {
+	for _, item := range l.Items() {
+		img, ok := item.(crictlImageItem)
+		if !ok {
+			continue
+		}
+		total += img.image.Size
+		if img.users == 0 && !img.image.Pinned {
+			unused += img.image.Size
+			unusedCount++
+		}
+	}
+	return total, unused, unusedCount
+}
@@ -24,6 +24,11 @@ func (i crictlContainerItem) FilterValue() string {
 // crictlContainerDelegate renders crictl container list items
 type crictlContainerDelegate struct {
 	styles Styles
+	// Containers of this pod are highlighted, e.g. after jumping from Pod Details
+	focusNamespace string
+	focusPod       string
+	// Containers running this image are highlighted, e.g. from the Images view
+	focusImage *ssh.CrictlImage
 }
 
 func (d crictlContainerDelegate) Height() int                             { return 1 }
@@ -63,6 +68,8 @@ func (d crictlContainerDelegate) Render(w io.Writer, m list.Model, index int, li
 	stateStyled := statusStyle.Render(statePadded)
 	ageStyled := lipgloss.NewStyle().Foreground(colorMuted).Render(agePadded)
 	nsStyled := lipgloss.NewStyle().Foreground(colorMuted).Render(nsPadded)
+	focused := d.focusPod != "" && c.PodName == d.focusPod && c.Namespace == d.focusNamespace ||
+		d.focusImage != nil && d.focusImage.UsedBy(c)
 
 	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
 
@@ -70,11 +77,17 @@ func (d crictlContainerDelegate) Render(w io.Writer, m list.Model, index int, li
 	if index == m.Index() {
 		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
 		podStyle := lipgloss.NewStyle().Foreground(colorSecondary)
+		if focused {
+			podStyle = podStyle.Bold(true)
+		}
 		line = fmt.Sprintf("%s %s %s %s %s %s", prefix, nameStyle.Render(namePadded), podStyle.Render(podPadded), nsStyled, stateStyled, ageStyled)
 		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
 	} else {
 		nameStyle := lipgloss.NewStyle().Foreground(colorText)
 		podStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		if focused {
+			podStyle = lipgloss.NewStyle().Foreground(colorSecondary)
+		}
 		line = fmt.Sprintf("  %s %s %s %s %s", nameStyle.Render(namePadded), podStyle.Render(podPadded), nsStyled, stateStyled, ageStyled)
 	}
 
@@ -108,3 +121,34 @@ func updateCrictlContainerList(l *list.Model, containers []ssh.CrictlContainer)
 	}
 	l.SetItems(items)
 }
+
+// focusCrictlPod highlights the containers of a pod and selects the first of
+// them. It reports whether the pod has containers in the list.
+func focusCrictlPod(l *list.Model, styles Styles, namespace, pod string) bool {
+	l.SetDelegate(crictlContainerDelegate{styles: styles, focusNamespace: namespace, focusPod: pod})
+	if pod == "" {
+		return false
+	}
+	for i, item := range l.Items() {
+		c, ok := item.(crictlContainerItem)
+		if ok && c.container.PodName == pod && c.container.Namespace == namespace {
+			l.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
+// focusCrictlImage highlights the containers running an image and selects
+// the first of them. It reports whether any container uses the image.
+func focusCrictlImage(l *list.Model, styles Styles, image ssh.CrictlImage) bool {
+	l.SetDelegate(crictlContainerDelegate{styles: styles, focusImage: &image})
+	for i, item := range l.Items() {
+		c, ok := item.(crictlContainerItem)
+		if ok && image.UsedBy(c.container) {
+			l.Select(i)
+			return true
+		}
+	}
+	return false
+}
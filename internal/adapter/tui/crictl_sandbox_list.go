@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// crictlSandboxItem implements list.Item for crictl pod sandboxes
+type crictlSandboxItem struct {
+	pod        ssh.CrictlPod
+	containers int // containers in the sandbox
+}
+
+func (i crictlSandboxItem) FilterValue() string {
+	return i.pod.Name + " " + i.pod.Namespace
+}
+
+// crictlSandboxDelegate renders crictl pod sandbox list items
+type crictlSandboxDelegate struct {
+	styles Styles
+}
+
+func (d crictlSandboxDelegate) Height() int                             { return 1 }
+func (d crictlSandboxDelegate) Spacing() int                            { return 0 }
+func (d crictlSandboxDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d crictlSandboxDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(crictlSandboxItem)
+	if !ok {
+		return
+	}
+
+	p := item.pod
+
+	stateStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	if p.Ready() {
+		stateStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	}
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME(35) NAMESPACE(15) STATE(10) CONTAINERS(10) ID(13) AGE(6)
+	namePadded := fmt.Sprintf("%-35s", truncateString(p.Name, 35))
+	nsPadded := fmt.Sprintf("%-15s", truncateString(p.Namespace, 15))
+	statePadded := fmt.Sprintf("%-10s", p.State)
+	containersPadded := fmt.Sprintf("%-10d", item.containers)
+	idPadded := fmt.Sprintf("%-13s", p.PodIDShort)
+	agePadded := fmt.Sprintf("%-6s", p.Created)
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	nsStyled := mutedStyle.Render(nsPadded)
+	stateStyled := stateStyle.Render(statePadded)
+	containersStyled := lipgloss.NewStyle().Foreground(colorText).Render(containersPadded)
+	idStyled := mutedStyle.Render(idPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s", prefix, nameStyle.Render(namePadded), nsStyled, stateStyled, containersStyled, idStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s", nameStyle.Render(namePadded), nsStyled, stateStyled, containersStyled, idStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newCrictlSandboxList creates a list model for crictl pod sandboxes
+func newCrictlSandboxList(width, height int, styles Styles) list.Model {
+	l := list.New(nil, crictlSandboxDelegate{styles: styles}, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateCrictlSandboxList updates the crictl pod sandbox list, counting the
+// containers of each sandbox
+func updateCrictlSandboxList(l *list.Model, pods []ssh.CrictlPod, containers []ssh.CrictlContainer) {
+	items := make([]list.Item, len(pods))
+	for i, p := range pods {
+		count := 0
+		for _, c := range containers {
+			if c.PodID == p.PodIDShort {
+				count++
+			}
+		}
+		items[i] = crictlSandboxItem{pod: p, containers: count}
+	}
+	l.SetItems(items)
+}
@@ -0,0 +1,200 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// crictlStatsInterval is how often the stats panel samples crictl stats
+const crictlStatsInterval = 2 * time.Second
+
+// crictlStatsRow is the usage of one container computed from its samples
+type crictlStatsRow struct {
+	stats    ssh.CrictlStats
+	milliCPU int64 // -1 until two samples are available
+}
+
+// CrictlStatsViewer shows live CPU and memory usage of the node's containers
+type CrictlStatsViewer struct {
+	rows     []crictlStatsRow
+	previous map[string]ssh.CrictlStats // last sample per container ID
+	updated  time.Time
+	viewport viewport.Model
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewCrictlStatsViewer creates a new stats viewer
+func NewCrictlStatsViewer() CrictlStatsViewer {
+	return CrictlStatsViewer{
+		previous: make(map[string]ssh.CrictlStats),
+	}
+}
+
+// SetSize sets the viewport size
+func (v *CrictlStatsViewer) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.viewport = viewport.New(width, height)
+	v.viewport.Style = lipgloss.NewStyle()
+	v.ready = true
+	v.updateContent()
+}
+
+// Clear drops all samples
+func (v *CrictlStatsViewer) Clear() {
+	v.rows = nil
+	v.previous = make(map[string]ssh.CrictlStats)
+	v.updated = time.Time{}
+	if v.ready {
+		v.viewport.GotoTop()
+	}
+	v.updateContent()
+}
+
+// HasSamples returns true once a sample has been received
+func (v *CrictlStatsViewer) HasSamples() bool {
+	return !v.updated.IsZero()
+}
+
+// AddSample records a crictl stats sample. CPU usage comes from the runtime
+// when it reports it, otherwise from the CPU time used since the last sample.
+func (v *CrictlStatsViewer) AddSample(stats []ssh.CrictlStats) {
+	current := make(map[string]ssh.CrictlStats, len(stats))
+	rows := make([]crictlStatsRow, 0, len(stats))
+	for _, s := range stats {
+		current[s.ContainerID] = s
+		row := crictlStatsRow{stats: s, milliCPU: -1}
+		if s.UsageNanoCores > 0 {
+			row.milliCPU = int64(s.UsageNanoCores / 1_000_000)
+		} else if prev, ok := v.previous[s.ContainerID]; ok &&
+			s.Timestamp > prev.Timestamp && s.UsageCoreNanoSeconds >= prev.UsageCoreNanoSeconds {
+			used := float64(s.UsageCoreNanoSeconds - prev.UsageCoreNanoSeconds)
+			elapsed := float64(s.Timestamp - prev.Timestamp)
+			row.milliCPU = int64(used / elapsed * 1000)
+		}
+		rows = append(rows, row)
+	}
+
+	// Busiest containers first
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].milliCPU != rows[j].milliCPU {
+			return rows[i].milliCPU > rows[j].milliCPU
+		}
+		if rows[i].stats.WorkingSetBytes != rows[j].stats.WorkingSetBytes {
+			return rows[i].stats.WorkingSetBytes > rows[j].stats.WorkingSetBytes
+		}
+		return rows[i].stats.Name < rows[j].stats.Name
+	})
+
+	v.rows = rows
+	v.previous = current
+	v.updated = time.Now()
+	v.updateContent()
+}
+
+func (v *CrictlStatsViewer) updateContent() {
+	if !v.ready {
+		return
+	}
+	if len(v.rows) == 0 {
+		if v.HasSamples() {
+			v.viewport.SetContent("No running containers")
+		} else {
+			v.viewport.SetContent("Waiting for stats...")
+		}
+		return
+	}
+
+	textStyle := lipgloss.NewStyle().Foreground(colorText)
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	var sb strings.Builder
+	for i, row := range v.rows {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		cpu := "-"
+		if row.milliCPU >= 0 {
+			cpu = formatMilliCPU(row.milliCPU)
+		}
+		sb.WriteString(textStyle.Render(fmt.Sprintf("  %-25s ", truncateString(row.stats.Name, 25))))
+		sb.WriteString(mutedStyle.Render(fmt.Sprintf("%-30s %-15s ", truncateString(row.stats.PodName, 30), truncateString(row.stats.Namespace, 15))))
+		sb.WriteString(crictlCPUStyle(row.milliCPU).Render(fmt.Sprintf("%8s", cpu)))
+		sb.WriteString(textStyle.Render(fmt.Sprintf(" %10s %10s", formatBytes(int64(row.stats.WorkingSetBytes)), formatBytes(int64(row.stats.WritableLayerBytes)))))
+	}
+	v.viewport.SetContent(sb.String())
+}
+
+// crictlCPUStyle highlights containers using a lot of CPU
+func crictlCPUStyle(milliCPU int64) lipgloss.Style {
+	switch {
+	case milliCPU >= 1000:
+		return lipgloss.NewStyle().Foreground(colorError)
+	case milliCPU >= 500:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	default:
+		return lipgloss.NewStyle().Foreground(colorText)
+	}
+}
+
+// Update handles messages
+func (v CrictlStatsViewer) Update(msg tea.Msg) (CrictlStatsViewer, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "g", "home":
+			v.viewport.GotoTop()
+			return v, nil
+		case "G", "end":
+			v.viewport.GotoBottom()
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+// View renders the stats table
+func (v CrictlStatsViewer) View() string {
+	if !v.ready {
+		return "Loading..."
+	}
+	return v.viewport.View()
+}
+
+// RenderHeader returns the title line with node totals and the column header
+func (v *CrictlStatsViewer) RenderHeader(nodeName string) string {
+	var milliCPU int64
+	var memory uint64
+	for _, row := range v.rows {
+		if row.milliCPU > 0 {
+			milliCPU += row.milliCPU
+		}
+		memory += row.stats.WorkingSetBytes
+	}
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary)
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+
+	header := titleStyle.Render(fmt.Sprintf("Container Stats: %s", nodeName))
+	header += "  " + infoStyle.Render(fmt.Sprintf("Running: %d  CPU: %s  Memory: %s",
+		len(v.rows), formatMilliCPU(milliCPU), formatBytes(int64(memory))))
+	if v.HasSamples() {
+		header += "  " + infoStyle.Render(fmt.Sprintf("Updated: %s", v.updated.Format("15:04:05")))
+	}
+
+	columns := lipgloss.NewStyle().Foreground(colorMuted).
+		Render(fmt.Sprintf("  %-25s %-30s %-15s %8s %10s %10s", "NAME", "POD", "NAMESPACE", "CPU", "MEMORY", "DISK"))
+	return header + "\n" + columns
+}
@@ -0,0 +1,186 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// CronJobDetailsModel is the model for cronjob details view
+type CronJobDetailsModel struct {
+	cronJob  *domain.CronJob
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewCronJobDetailsModel creates a new cronjob details model
+func NewCronJobDetailsModel(styles Styles) CronJobDetailsModel {
+	return CronJobDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetCronJob sets the cronjob to display
+func (m *CronJobDetailsModel) SetCronJob(cj *domain.CronJob) {
+	m.cronJob = cj
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *CronJobDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.cronJob != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m CronJobDetailsModel) Update(msg tea.Msg) (CronJobDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the cronjob details
+func (m CronJobDetailsModel) View() string {
+	if !m.ready || m.cronJob == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *CronJobDetailsModel) renderContent() string {
+	if m.cronJob == nil {
+		return "No cronjob selected"
+	}
+
+	var sb strings.Builder
+	cj := m.cronJob
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(18)
+
+	valueStyle := lipgloss.NewStyle()
+
+	orNone := func(s string) string {
+		if s == "" {
+			return "<none>"
+		}
+		return s
+	}
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(cj.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(cj.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(cj.Age)))
+
+	// === Schedule Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("SCHEDULE"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Schedule:"), valueStyle.Render(cj.Schedule)))
+	if cj.TimeZone != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Time zone:"), valueStyle.Render(cj.TimeZone)))
+	}
+	suspended := lipgloss.NewStyle().Foreground(colorSuccess).Render("no")
+	if cj.Suspend {
+		suspended = lipgloss.NewStyle().Foreground(colorWarning).Bold(true).Render("yes")
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Suspended:"), suspended))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Concurrency:"), valueStyle.Render(orNone(cj.ConcurrencyPolicy))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Last schedule:"), valueStyle.Render(orNone(cj.LastSchedule))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Last successful:"), valueStyle.Render(orNone(cj.LastSuccessful))))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Active jobs:"), cj.Active))
+	sb.WriteString(fmt.Sprintf("%s %d succeeded, %d failed\n", labelStyle.Render("History limits:"), cj.SuccessfulHistory, cj.FailedHistory))
+
+	// === Jobs Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("JOBS (%d)", len(cj.Jobs))))
+	sb.WriteString("\n")
+	if len(cj.Jobs) == 0 {
+		sb.WriteString(lipgloss.NewStyle().Foreground(colorMuted).Render("  No jobs in history"))
+		sb.WriteString("\n")
+	} else {
+		jobHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-45s %-10s %-12s %-7s %-10s %s", "NAME", "STATUS", "COMPLETIONS", "FAILED", "DURATION", "AGE"))
+		sb.WriteString(jobHeader)
+		sb.WriteString("\n")
+
+		for _, job := range cj.Jobs {
+			sb.WriteString(fmt.Sprintf("  %-45s %s %-12s %-7d %-10s %s\n",
+				truncateString(job.Name, 45),
+				jobStatusStyle(job.Status).Render(fmt.Sprintf("%-10s", job.Status)),
+				job.Completions,
+				job.Failed,
+				job.Duration,
+				job.Age))
+		}
+	}
+
+	// === Images Section ===
+	if len(cj.Images) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("IMAGES (%d)", len(cj.Images))))
+		sb.WriteString("\n")
+		for _, img := range cj.Images {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncateString(img, m.width-6)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(cj.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(cj.Labels))
+		for k := range cj.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := cj.Labels[k]
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(v, 40)))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *CronJobDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// CronJob returns the current cronjob
+func (m *CronJobDetailsModel) CronJob() *domain.CronJob {
+	return m.cronJob
+}
@@ -0,0 +1,144 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// cronJobItem implements list.Item for cronjobs
+type cronJobItem struct {
+	cronJob domain.CronJob
+}
+
+func (i cronJobItem) FilterValue() string { return i.cronJob.Name }
+
+// cronJobDelegate renders cronjob list items
+type cronJobDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d cronJobDelegate) Height() int                             { return 1 }
+func (d cronJobDelegate) Spacing() int                            { return 0 }
+func (d cronJobDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d cronJobDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(cronJobItem)
+	if !ok {
+		return
+	}
+
+	cj := item.cronJob
+
+	suspend := "False"
+	if cj.Suspend {
+		suspend = "True"
+	}
+	lastSchedule := cj.LastSchedule
+	if lastSchedule == "" {
+		lastSchedule = "<none>"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-36s", truncateString(cj.Name, 36))
+	schedulePadded := fmt.Sprintf("%-18s", truncateString(cj.Schedule, 18))
+	suspendPadded := fmt.Sprintf("%-8s", suspend)
+	activePadded := fmt.Sprintf("%-7d", cj.Active)
+	lastSchedulePadded := fmt.Sprintf("%-14s", lastSchedule)
+	agePadded := cj.Age
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(cj.Namespace, 20))) + " "
+	}
+
+	// Apply colors after padding
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	scheduleStyled := lipgloss.NewStyle().Foreground(colorText).Render(schedulePadded)
+	suspendStyled := mutedStyle.Render(suspendPadded)
+	if cj.Suspend {
+		suspendStyled = lipgloss.NewStyle().Foreground(colorWarning).Render(suspendPadded)
+	}
+	activeStyled := mutedStyle.Render(activePadded)
+	if cj.Active > 0 {
+		activeStyled = lipgloss.NewStyle().Foreground(colorSuccess).Render(activePadded)
+	}
+	lastScheduleStyled := mutedStyle.Render(lastSchedulePadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s",
+			prefix, nsColumn+nameStyle.Render(namePadded), scheduleStyled, suspendStyled, activeStyled, lastScheduleStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s",
+			nsColumn+nameStyle.Render(namePadded), scheduleStyled, suspendStyled, activeStyled, lastScheduleStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newCronJobList creates a list model for cronjobs. showNamespace adds a
+// NAMESPACE column for lists spanning all namespaces.
+func newCronJobList(cronJobs []domain.CronJob, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(cronJobs))
+	for i, cj := range cronJobs {
+		items[i] = cronJobItem{cronJob: cj}
+	}
+
+	delegate := cronJobDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateCronJobList updates the cronjob list items while preserving selection
+func updateCronJobList(l *list.Model, cronJobs []domain.CronJob) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(cronJobItem); ok {
+		currentName = item.cronJob.Name
+		currentNamespace = item.cronJob.Namespace
+	}
+
+	items := make([]list.Item, len(cronJobs))
+	newIndex := 0
+	for i, cj := range cronJobs {
+		items[i] = cronJobItem{cronJob: cj}
+		if cj.Name == currentName && cj.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -0,0 +1,218 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// DaemonSetDetailsModel is the model for daemonset details view
+type DaemonSetDetailsModel struct {
+	daemonSet *domain.DaemonSet
+	viewport  viewport.Model
+	styles    Styles
+	width     int
+	height    int
+	ready     bool
+}
+
+// NewDaemonSetDetailsModel creates a new daemonset details model
+func NewDaemonSetDetailsModel(styles Styles) DaemonSetDetailsModel {
+	return DaemonSetDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetDaemonSet sets the daemonset to display
+func (m *DaemonSetDetailsModel) SetDaemonSet(ds *domain.DaemonSet) {
+	m.daemonSet = ds
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *DaemonSetDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.daemonSet != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m DaemonSetDetailsModel) Update(msg tea.Msg) (DaemonSetDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the daemonset details
+func (m DaemonSetDetailsModel) View() string {
+	if !m.ready || m.daemonSet == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *DaemonSetDetailsModel) renderContent() string {
+	if m.daemonSet == nil {
+		return "No daemonset selected"
+	}
+
+	var sb strings.Builder
+	ds := m.daemonSet
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+	readyStyle := replicaStatusStyle(ds.Ready, ds.Desired).Bold(true)
+	upToDateStyle := replicaStatusStyle(ds.UpToDate, ds.Desired)
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(ds.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(ds.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Node selector:"), valueStyle.Render(ds.NodeSelector)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(ds.Age)))
+
+	// === Status Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("STATUS"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Desired:"), ds.Desired))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Current:"), ds.Current))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Ready:"), readyStyle.Render(fmt.Sprintf("%d", ds.Ready))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Up-to-date:"), upToDateStyle.Render(fmt.Sprintf("%d", ds.UpToDate))))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Available:"), ds.Available))
+	if ds.Misscheduled > 0 {
+		warn := lipgloss.NewStyle().Foreground(colorWarning)
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Misscheduled:"), warn.Render(fmt.Sprintf("%d", ds.Misscheduled))))
+	}
+
+	// === Strategy Section ===
+	if ds.UpdateStrategy != "" {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("UPDATE STRATEGY"))
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Type:"), valueStyle.Render(ds.UpdateStrategy)))
+	}
+
+	// === Pods Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("PODS (%d)", len(ds.Pods))))
+	sb.WriteString("\n")
+	if len(ds.Pods) == 0 {
+		sb.WriteString(lipgloss.NewStyle().Foreground(colorMuted).Render("  No pods scheduled"))
+		sb.WriteString("\n")
+	} else {
+		podHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-24s %-40s %-7s %-18s %-9s %-12s %s", "NODE", "NAME", "READY", "STATUS", "RESTARTS", "REVISION", "UP-TO-DATE"))
+		sb.WriteString(podHeader)
+		sb.WriteString("\n")
+
+		for _, pod := range ds.Pods {
+			var statusColor lipgloss.Style
+			switch pod.Status {
+			case "Running", "Succeeded":
+				statusColor = lipgloss.NewStyle().Foreground(colorSuccess)
+			case "Pending", "ContainerCreating", "Terminating":
+				statusColor = lipgloss.NewStyle().Foreground(colorWarning)
+			default:
+				statusColor = lipgloss.NewStyle().Foreground(colorError)
+			}
+
+			upToDate := lipgloss.NewStyle().Foreground(colorSuccess).Render("yes")
+			if !pod.UpToDate {
+				upToDate = lipgloss.NewStyle().Foreground(colorWarning).Render("no")
+			}
+
+			sb.WriteString(fmt.Sprintf("  %-24s %-40s %-7s %s %-9d %-12s %s\n",
+				truncateString(pod.Node, 24),
+				truncateString(pod.Name, 40),
+				pod.Ready,
+				statusColor.Render(fmt.Sprintf("%-18s", truncateString(pod.Status, 18))),
+				pod.Restarts,
+				truncateString(pod.Revision, 12),
+				upToDate))
+		}
+	}
+
+	// === Images Section ===
+	if len(ds.Images) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("IMAGES (%d)", len(ds.Images))))
+		sb.WriteString("\n")
+		for _, img := range ds.Images {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncateString(img, m.width-6)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(ds.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(ds.Labels))
+		for k := range ds.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := ds.Labels[k]
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(v, 40)))
+		}
+	}
+
+	// === Selector Section ===
+	if len(ds.Selector) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("SELECTOR"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(ds.Selector))
+		for k := range ds.Selector {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", k, ds.Selector[k]))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *DaemonSetDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// DaemonSet returns the current daemonset
+func (m *DaemonSetDetailsModel) DaemonSet() *domain.DaemonSet {
+	return m.daemonSet
+}
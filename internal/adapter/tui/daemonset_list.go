@@ -0,0 +1,135 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// daemonSetItem implements list.Item for daemonsets
+type daemonSetItem struct {
+	daemonSet domain.DaemonSet
+}
+
+func (i daemonSetItem) FilterValue() string { return i.daemonSet.Name }
+
+// daemonSetDelegate renders daemonset list items
+type daemonSetDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d daemonSetDelegate) Height() int                             { return 1 }
+func (d daemonSetDelegate) Spacing() int                            { return 0 }
+func (d daemonSetDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d daemonSetDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(daemonSetItem)
+	if !ok {
+		return
+	}
+
+	ds := item.daemonSet
+	readyStyle := replicaStatusStyle(ds.Ready, ds.Desired)
+	upToDateStyle := replicaStatusStyle(ds.UpToDate, ds.Desired)
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-32s", truncateString(ds.Name, 32))
+	desiredPadded := fmt.Sprintf("%-8d", ds.Desired)
+	currentPadded := fmt.Sprintf("%-8d", ds.Current)
+	readyPadded := fmt.Sprintf("%-8d", ds.Ready)
+	upToDatePadded := fmt.Sprintf("%-10d", ds.UpToDate)
+	availablePadded := fmt.Sprintf("%-10d", ds.Available)
+	nodeSelectorPadded := fmt.Sprintf("%-24s", truncateString(ds.NodeSelector, 24))
+	agePadded := ds.Age
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(ds.Namespace, 20))) + " "
+	}
+
+	// Apply colors after padding
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	desiredStyled := mutedStyle.Render(desiredPadded)
+	currentStyled := mutedStyle.Render(currentPadded)
+	readyStyled := readyStyle.Render(readyPadded)
+	upToDateStyled := upToDateStyle.Render(upToDatePadded)
+	availableStyled := mutedStyle.Render(availablePadded)
+	nodeSelectorStyled := mutedStyle.Render(nodeSelectorPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s %s %s",
+			prefix, nsColumn+nameStyle.Render(namePadded), desiredStyled, currentStyled, readyStyled, upToDateStyled, availableStyled, nodeSelectorStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s %s %s",
+			nsColumn+nameStyle.Render(namePadded), desiredStyled, currentStyled, readyStyled, upToDateStyled, availableStyled, nodeSelectorStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newDaemonSetList creates a list model for daemonsets. showNamespace adds a
+// NAMESPACE column for lists spanning all namespaces.
+func newDaemonSetList(daemonSets []domain.DaemonSet, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(daemonSets))
+	for i, ds := range daemonSets {
+		items[i] = daemonSetItem{daemonSet: ds}
+	}
+
+	delegate := daemonSetDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateDaemonSetList updates the daemonset list items while preserving selection
+func updateDaemonSetList(l *list.Model, daemonSets []domain.DaemonSet) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(daemonSetItem); ok {
+		currentName = item.daemonSet.Name
+		currentNamespace = item.daemonSet.Namespace
+	}
+
+	items := make([]list.Item, len(daemonSets))
+	newIndex := 0
+	for i, ds := range daemonSets {
+		items[i] = daemonSetItem{daemonSet: ds}
+		if ds.Name == currentName && ds.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
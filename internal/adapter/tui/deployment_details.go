@@ -29,12 +29,17 @@ func NewDeploymentDetailsModel(styles Styles) DeploymentDetailsModel {
 	}
 }
 
-// SetDeployment sets the deployment to display
+// SetDeployment sets the deployment to display. Refreshing the same
+// deployment (e.g. while a rollout progresses) keeps the scroll position.
 func (m *DeploymentDetailsModel) SetDeployment(dep *domain.Deployment) {
+	same := m.deployment != nil && dep != nil &&
+		m.deployment.Name == dep.Name && m.deployment.Namespace == dep.Namespace
 	m.deployment = dep
 	if m.ready {
 		m.viewport.SetContent(m.renderContent())
-		m.viewport.GotoTop()
+		if !same {
+			m.viewport.GotoTop()
+		}
 	}
 }
 
@@ -111,6 +116,28 @@ func (m *DeploymentDetailsModel) renderContent() string {
 	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Available:"), dep.Available))
 	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Replicas:"), dep.Replicas))
 
+	// === Rollout Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("ROLLOUT"))
+	sb.WriteString("\n")
+	if dep.Revision != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Revision:"), valueStyle.Render(dep.Revision)))
+	}
+	if dep.Paused {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Paused:"), lipgloss.NewStyle().Foreground(colorWarning).Bold(true).Render("yes")))
+	}
+	rolloutStyle := lipgloss.NewStyle().Foreground(colorWarning)
+	if dep.Rollout.Complete {
+		rolloutStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	} else if dep.Rollout.Failed {
+		rolloutStyle = lipgloss.NewStyle().Foreground(colorError)
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), rolloutStyle.Render(dep.Rollout.Message)))
+	if !dep.Rollout.Complete {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Updated:"), renderProgress(dep.Rollout.Updated, dep.Rollout.Desired, 20)))
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Available:"), renderProgress(dep.Rollout.Available, dep.Rollout.Desired, 20)))
+	}
+
 	// === Strategy Section ===
 	if dep.Strategy != "" {
 		sb.WriteString("\n")
@@ -167,6 +194,41 @@ func (m *DeploymentDetailsModel) renderContent() string {
 		}
 	}
 
+	// === History Section ===
+	if len(dep.History) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("HISTORY (%d)", len(dep.History))))
+		sb.WriteString("\n")
+
+		histHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-5s %-36s %-7s %-30s %s", "REV", "REPLICASET", "READY", "IMAGES", "CHANGE-CAUSE"))
+		sb.WriteString(histHeader)
+		sb.WriteString("\n")
+
+		for _, rev := range dep.History {
+			cause := rev.ChangeCause
+			if cause == "" {
+				cause = "<none>"
+			}
+			readyPadded := fmt.Sprintf("%-7s", fmt.Sprintf("%d/%d", rev.Ready, rev.Replicas))
+			line := fmt.Sprintf("  %-5d %-36s %s %-30s %s",
+				rev.Revision,
+				truncateString(rev.ReplicaSet, 36),
+				replicaStatusStyle(rev.Ready, rev.Replicas).Render(readyPadded),
+				truncateString(strings.Join(rev.Images, ","), 30),
+				truncateString(cause, 40))
+			if rev.Current {
+				line += lipgloss.NewStyle().Foreground(colorSuccess).Render(" (current)")
+			}
+			sb.WriteString(line)
+			sb.WriteString("\n")
+		}
+		sb.WriteString(lipgloss.NewStyle().Foreground(colorSubtle).Render("  h: history, diff and rollback"))
+		sb.WriteString("\n")
+	}
+
 	// === Conditions Section ===
 	if len(dep.Conditions) > 0 {
 		sb.WriteString("\n")
@@ -213,3 +275,17 @@ func (m *DeploymentDetailsModel) ScrollPercent() float64 {
 func (m *DeploymentDetailsModel) Deployment() *domain.Deployment {
 	return m.deployment
 }
+
+// renderProgress renders a fixed-width progress bar with a count, e.g. "████░░ 2/3"
+func renderProgress(done, total int32, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = int(done) * width / int(total)
+	}
+	if filled > width {
+		filled = width
+	}
+	bar := lipgloss.NewStyle().Foreground(colorSuccess).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(colorDim).Render(strings.Repeat("░", width-filled))
+	return fmt.Sprintf("%s %d/%d", bar, done, total)
+}
@@ -20,7 +20,8 @@ func (i deploymentItem) FilterValue() string { return i.deployment.Name }
 
 // deploymentDelegate renders deployment list items
 type deploymentDelegate struct {
-	styles Styles
+	styles        Styles
+	showNamespace bool
 }
 
 func (d deploymentDelegate) Height() int                             { return 1 }
@@ -52,6 +53,12 @@ func (d deploymentDelegate) Render(w io.Writer, m list.Model, index int, listIte
 	availablePadded := fmt.Sprintf("%-10d", dep.Available)
 	agePadded := dep.Age
 
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(dep.Namespace, 20))) + " "
+	}
+
 	// Apply colors after padding
 	readyStyled := statusStyle.Render(readyPadded)
 	upToDateStyled := lipgloss.NewStyle().Foreground(colorMuted).Render(upToDatePadded)
@@ -65,25 +72,26 @@ func (d deploymentDelegate) Render(w io.Writer, m list.Model, index int, listIte
 	if index == m.Index() {
 		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
 		line = fmt.Sprintf("%s %s %s %s %s %s",
-			prefix, nameStyle.Render(namePadded), readyStyled, upToDateStyled, availableStyled, ageStyled)
+			prefix, nsColumn+nameStyle.Render(namePadded), readyStyled, upToDateStyled, availableStyled, ageStyled)
 		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
 	} else {
 		nameStyle := lipgloss.NewStyle().Foreground(colorText)
 		line = fmt.Sprintf("  %s %s %s %s %s",
-			nameStyle.Render(namePadded), readyPadded, upToDateStyled, availableStyled, ageStyled)
+			nsColumn+nameStyle.Render(namePadded), readyPadded, upToDateStyled, availableStyled, ageStyled)
 	}
 
 	fmt.Fprint(w, line)
 }
 
-// newDeploymentList creates a list model for deployments
-func newDeploymentList(deployments []domain.Deployment, width, height int, styles Styles) list.Model {
+// newDeploymentList creates a list model for deployments. showNamespace adds
+// a NAMESPACE column for lists spanning all namespaces.
+func newDeploymentList(deployments []domain.Deployment, width, height int, styles Styles, showNamespace bool) list.Model {
 	items := make([]list.Item, len(deployments))
 	for i, dep := range deployments {
 		items[i] = deploymentItem{deployment: dep}
 	}
 
-	delegate := deploymentDelegate{styles: styles}
+	delegate := deploymentDelegate{styles: styles, showNamespace: showNamespace}
 	l := list.New(items, delegate, width, height)
 	l.SetShowTitle(false)
 	l.SetShowStatusBar(false)
@@ -96,27 +104,34 @@ func newDeploymentList(deployments []domain.Deployment, width, height int, style
 }
 
 // updateDeploymentList updates the deployment list items while preserving selection
-func updateDeploymentList(l *list.Model, deployments []domain.Deployment) {
+func updateDeploymentList(l *list.Model, deployments []domain.Deployment) tea.Cmd {
 	currentIndex := l.Index()
-	var currentName string
+	var currentName, currentNamespace string
 	if item, ok := l.SelectedItem().(deploymentItem); ok {
 		currentName = item.deployment.Name
+		currentNamespace = item.deployment.Namespace
 	}
 
 	items := make([]list.Item, len(deployments))
 	newIndex := 0
 	for i, dep := range deployments {
 		items[i] = deploymentItem{deployment: dep}
-		if dep.Name == currentName {
+		if dep.Name == currentName && dep.Namespace == currentNamespace {
 			newIndex = i
 		}
 	}
 
-	l.SetItems(items)
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
 
 	if currentName != "" {
 		l.Select(newIndex)
 	} else if currentIndex < len(items) {
 		l.Select(currentIndex)
 	}
+	return cmd
 }
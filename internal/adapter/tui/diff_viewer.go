@@ -0,0 +1,150 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// DiffViewer shows a unified diff, e.g. of an edit before it is applied
+type DiffViewer struct {
+	title    string
+	note     string // shown next to the title, e.g. "Dry-run passed"
+	lines    []string
+	added    int
+	removed  int
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewDiffViewer creates a new diff viewer
+func NewDiffViewer(styles Styles) DiffViewer {
+	return DiffViewer{
+		styles: styles,
+	}
+}
+
+// SetDiff sets the diff to show
+func (d *DiffViewer) SetDiff(title, note, diff string) {
+	d.title = title
+	d.note = note
+	d.lines = strings.Split(strings.TrimRight(diff, "\n"), "\n")
+	d.added = 0
+	d.removed = 0
+	for _, line := range d.lines {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			d.added++
+		case strings.HasPrefix(line, "-"):
+			d.removed++
+		}
+	}
+	d.updateContent()
+	if d.ready {
+		d.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (d *DiffViewer) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+	d.viewport = viewport.New(width, height)
+	d.viewport.Style = lipgloss.NewStyle()
+	d.ready = true
+	d.updateContent()
+}
+
+// Stats returns the number of added and removed lines
+func (d *DiffViewer) Stats() (added, removed int) {
+	return d.added, d.removed
+}
+
+func (d *DiffViewer) updateContent() {
+	if !d.ready {
+		return
+	}
+
+	headerStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	hunkStyle := lipgloss.NewStyle().Foreground(colorPrimary)
+	addStyle := lipgloss.NewStyle().Foreground(colorSuccess)
+	removeStyle := lipgloss.NewStyle().Foreground(colorError)
+	contextStyle := lipgloss.NewStyle().Foreground(colorText)
+
+	var sb strings.Builder
+	for i, line := range d.lines {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			sb.WriteString(headerStyle.Render(line))
+		case strings.HasPrefix(line, "@@"):
+			sb.WriteString(hunkStyle.Render(line))
+		case strings.HasPrefix(line, "+"):
+			sb.WriteString(addStyle.Render(line))
+		case strings.HasPrefix(line, "-"):
+			sb.WriteString(removeStyle.Render(line))
+		default:
+			sb.WriteString(contextStyle.Render(line))
+		}
+	}
+	d.viewport.SetContent(sb.String())
+}
+
+// Update handles messages
+func (d DiffViewer) Update(msg tea.Msg) (DiffViewer, tea.Cmd) {
+	var cmd tea.Cmd
+	d.viewport, cmd = d.viewport.Update(msg)
+	return d, cmd
+}
+
+// View renders the diff viewport
+func (d DiffViewer) View() string {
+	if !d.ready {
+		return "Loading..."
+	}
+	return d.viewport.View()
+}
+
+// RenderHeader returns the diff viewer header
+func (d *DiffViewer) RenderHeader() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary)
+	addStyle := lipgloss.NewStyle().Foreground(colorSuccess)
+	removeStyle := lipgloss.NewStyle().Foreground(colorError)
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+
+	header := titleStyle.Render(d.title)
+	if d.note != "" {
+		indicator := lipgloss.NewStyle().Foreground(colorSuccess).Render("◉")
+		label := lipgloss.NewStyle().Foreground(colorText).Render(d.note)
+		header += "  " + indicator + " " + label
+	}
+
+	return header +
+		"  " + addStyle.Render(fmt.Sprintf("+%d", d.added)) + " " + removeStyle.Render(fmt.Sprintf("-%d", d.removed)) +
+		"  " + infoStyle.Render(fmt.Sprintf("%.0f%%", d.viewport.ScrollPercent()*100))
+}
+
+// unifiedDiff returns a unified diff with three lines of context, or an
+// empty string when both texts are equal
+func unifiedDiff(from, to, fromName, toName string) string {
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+	return diff
+}
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// Drain options offered in the drain dialog
+const (
+	drainOptionIgnoreDaemonSets   = "ignore-daemonsets"
+	drainOptionDeleteEmptyDirData = "delete-emptydir-data"
+	drainOptionForce              = "force"
+)
+
+// DrainDialog is a dialog for choosing the options of a node drain
+type DrainDialog struct {
+	node     string
+	selected []string
+	visible  bool
+	width    int
+	form     *huh.Form
+}
+
+// NewDrainDialog creates a new drain dialog
+func NewDrainDialog() DrainDialog {
+	return DrainDialog{}
+}
+
+// Show displays the dialog for the given node. DaemonSet pods are ignored by
+// default, since a drain without that option fails on almost every cluster.
+func (d *DrainDialog) Show(node string) tea.Cmd {
+	d.node = node
+	d.visible = true
+	d.selected = []string{drainOptionIgnoreDaemonSets}
+
+	d.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewMultiSelect[string]().
+				Title("Drain node").
+				Description(truncateString(node, 40)+" will be cordoned and its pods evicted").
+				Options(
+					huh.NewOption("Ignore DaemonSets", drainOptionIgnoreDaemonSets).Selected(true),
+					huh.NewOption("Delete emptyDir data", drainOptionDeleteEmptyDirData),
+					huh.NewOption("Force (pods without controller)", drainOptionForce),
+				).
+				Value(&d.selected),
+		),
+	).WithTheme(K4sHuhTheme()).WithShowHelp(false)
+	return d.form.Init()
+}
+
+// Hide hides the dialog
+func (d *DrainDialog) Hide() {
+	d.visible = false
+	d.form = nil
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *DrainDialog) IsVisible() bool {
+	return d.visible
+}
+
+// SetWidth sets the dialog width
+func (d *DrainDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Node returns the node to drain
+func (d *DrainDialog) Node() string {
+	return d.node
+}
+
+// Options returns the chosen drain options
+func (d *DrainDialog) Options() domain.DrainOptions {
+	return domain.DrainOptions{
+		IgnoreDaemonSets:   slices.Contains(d.selected, drainOptionIgnoreDaemonSets),
+		DeleteEmptyDirData: slices.Contains(d.selected, drainOptionDeleteEmptyDirData),
+		Force:              slices.Contains(d.selected, drainOptionForce),
+	}
+}
+
+// Update handles key messages for the dialog
+func (d *DrainDialog) Update(msg tea.Msg) (confirmed bool, cancelled bool, cmd tea.Cmd) {
+	if !d.visible || d.form == nil {
+		return false, false, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" {
+			return false, true, nil
+		}
+	}
+
+	model, formCmd := d.form.Update(msg)
+	if f, ok := model.(*huh.Form); ok {
+		d.form = f
+	}
+
+	if d.form.State == huh.StateCompleted {
+		return true, false, formCmd
+	}
+	if d.form.State == huh.StateAborted {
+		return false, true, formCmd
+	}
+
+	return false, false, formCmd
+}
+
+// View renders the dialog
+func (d *DrainDialog) View() string {
+	if !d.visible || d.form == nil {
+		return ""
+	}
+
+	dialogWidth := 56
+	if d.width > 0 && d.width < 66 {
+		dialogWidth = d.width - 10
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorWarning).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	content := d.form.View() + "\n" + hintStyle.Render("Space: toggle • Enter: drain • Esc: cancel")
+
+	return dialogStyle.Render(content)
+}
@@ -0,0 +1,227 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// drainPod is the latest known state of one pod during a drain
+type drainPod struct {
+	namespace string
+	name      string
+	status    string
+	message   string
+}
+
+// DrainProgress shows the pods of a node being evicted
+type DrainProgress struct {
+	node     string
+	options  domain.DrainOptions
+	pods     []drainPod
+	index    map[string]int // namespace/name -> position in pods
+	running  bool
+	err      error
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewDrainProgress creates a new drain progress view
+func NewDrainProgress(styles Styles) DrainProgress {
+	return DrainProgress{
+		styles: styles,
+	}
+}
+
+// Start resets the view for a new drain of node
+func (d *DrainProgress) Start(node string, opts domain.DrainOptions) {
+	d.node = node
+	d.options = opts
+	d.pods = nil
+	d.index = make(map[string]int)
+	d.running = true
+	d.err = nil
+	d.updateContent()
+}
+
+// Apply records a progress event
+func (d *DrainProgress) Apply(ev domain.DrainEvent) {
+	key := ev.Namespace + "/" + ev.Pod
+	i, ok := d.index[key]
+	if !ok {
+		i = len(d.pods)
+		d.index[key] = i
+		d.pods = append(d.pods, drainPod{namespace: ev.Namespace, name: ev.Pod})
+	}
+	d.pods[i].status = ev.Status
+	d.pods[i].message = ev.Message
+	d.updateContent()
+}
+
+// Finish marks the drain as ended, with the error that stopped it if any
+func (d *DrainProgress) Finish(err error) {
+	d.running = false
+	d.err = err
+	d.updateContent()
+}
+
+// Node returns the node being drained
+func (d *DrainProgress) Node() string {
+	return d.node
+}
+
+// Running returns whether the drain is still in progress
+func (d *DrainProgress) Running() bool {
+	return d.running
+}
+
+// Cancelled returns whether the drain was cancelled before it finished
+func (d *DrainProgress) Cancelled() bool {
+	return errors.Is(d.err, context.Canceled)
+}
+
+// Counts returns how many pods were evicted out of those to evict
+func (d *DrainProgress) Counts() (evicted, total int) {
+	for _, p := range d.pods {
+		if p.status == domain.DrainStatusSkipped {
+			continue
+		}
+		total++
+		if p.status == domain.DrainStatusEvicted {
+			evicted++
+		}
+	}
+	return evicted, total
+}
+
+// SetSize sets the viewport size
+func (d *DrainProgress) SetSize(width, height int) {
+	d.width = width
+	d.height = height
+	d.viewport = viewport.New(width, height)
+	d.viewport.Style = lipgloss.NewStyle()
+	d.ready = true
+	d.updateContent()
+}
+
+func (d *DrainProgress) updateContent() {
+	if !d.ready {
+		return
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	var sb strings.Builder
+	switch {
+	case d.Cancelled():
+		sb.WriteString(lipgloss.NewStyle().Foreground(colorWarning).Render("  Drain cancelled, the node stays cordoned"))
+		sb.WriteString("\n\n")
+	case d.err != nil:
+		sb.WriteString(lipgloss.NewStyle().Foreground(colorError).Render(truncateString("  "+d.err.Error(), d.width)))
+		sb.WriteString("\n\n")
+	}
+
+	if len(d.pods) == 0 {
+		if d.running {
+			sb.WriteString(mutedStyle.Render("  Cordoning node and listing pods..."))
+		} else {
+			sb.WriteString(mutedStyle.Render("  No pods to evict"))
+		}
+		d.viewport.SetContent(sb.String())
+		return
+	}
+
+	header := mutedStyle.Bold(true).Render(fmt.Sprintf("  %-20s %-44s %-10s %s", "NAMESPACE", "POD", "STATUS", "MESSAGE"))
+	sb.WriteString(header)
+	for _, p := range d.pods {
+		sb.WriteString("\n")
+		sb.WriteString(fmt.Sprintf("  %-20s %-44s %s %s",
+			truncateString(p.namespace, 20),
+			truncateString(p.name, 44),
+			drainStatusStyle(p.status).Render(fmt.Sprintf("%-10s", p.status)),
+			mutedStyle.Render(truncateString(p.message, max(d.width-80, 10)))))
+	}
+
+	d.viewport.SetContent(sb.String())
+}
+
+func drainStatusStyle(status string) lipgloss.Style {
+	switch status {
+	case domain.DrainStatusEvicted:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	case domain.DrainStatusEvicting, domain.DrainStatusBlocked:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	case domain.DrainStatusFailed:
+		return lipgloss.NewStyle().Foreground(colorError)
+	default:
+		return lipgloss.NewStyle().Foreground(colorMuted)
+	}
+}
+
+// Update handles messages
+func (d DrainProgress) Update(msg tea.Msg) (DrainProgress, tea.Cmd) {
+	var cmd tea.Cmd
+	d.viewport, cmd = d.viewport.Update(msg)
+	return d, cmd
+}
+
+// View renders the drain progress
+func (d DrainProgress) View() string {
+	if !d.ready {
+		return "Loading..."
+	}
+	return d.viewport.View()
+}
+
+// RenderHeader returns the drain progress header
+func (d *DrainProgress) RenderHeader() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary)
+	header := titleStyle.Render(fmt.Sprintf("Drain: %s", d.node))
+
+	var indicator, label string
+	switch {
+	case d.running:
+		indicator = lipgloss.NewStyle().Foreground(colorWarning).Render("◉")
+		label = "Draining"
+	case d.Cancelled():
+		indicator = lipgloss.NewStyle().Foreground(colorWarning).Render("◉")
+		label = "Cancelled"
+	case d.err != nil:
+		indicator = lipgloss.NewStyle().Foreground(colorError).Render("◉")
+		label = "Failed"
+	default:
+		indicator = lipgloss.NewStyle().Foreground(colorSuccess).Render("◉")
+		label = "Drained"
+	}
+	header += "  " + indicator + " " + lipgloss.NewStyle().Foreground(colorText).Render(label)
+
+	evicted, total := d.Counts()
+	header += "  " + renderProgress(int32(evicted), int32(total), 20)
+
+	var opts []string
+	if d.options.IgnoreDaemonSets {
+		opts = append(opts, "ignore DaemonSets")
+	}
+	if d.options.DeleteEmptyDirData {
+		opts = append(opts, "delete emptyDir data")
+	}
+	if d.options.Force {
+		opts = append(opts, "force")
+	}
+	if len(opts) > 0 {
+		header += "  " + lipgloss.NewStyle().Foreground(colorSubtle).Render(strings.Join(opts, " · "))
+	}
+	return header
+}
@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// editHeader is written above the object in the editor, like kubectl edit.
+// Only this leading comment block is stripped again, see stripEditComments.
+const editHeader = `# Please edit the object below. The comment lines above the object will be
+# ignored, and an empty file will abort the edit. If an error occurs while
+# saving this file will be reopened with the relevant failures.
+#
+`
+
+// editSession tracks a resource being edited in $EDITOR
+type editSession struct {
+	kind       string
+	namespace  string
+	name       string
+	key        string    // ConfigMap or Secret key when editing a single value
+	version    string    // resourceVersion the key was read at
+	original   string    // live YAML the edit started from, or the value of key
+	edited     string    // last content saved by the user, header stripped
+	result     string    // object returned by the server dry-run
+	lastErr    error     // why the last attempt was rejected
+	path       string    // temp file handed to the editor
+	returnView ViewState // view to go back to when the edit ends
+}
+
+// newEditSession writes the live object to a temp file for the editor
+func newEditSession(kind, namespace, name, content string, returnView ViewState) (*editSession, error) {
+	f, err := os.CreateTemp("", fmt.Sprintf("k4s-edit-%s-%s-*.yaml", strings.ToLower(kind), name))
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(editHeader + content); err != nil {
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("write temp file: %w", err)
+	}
+
+	return &editSession{
+		kind:       kind,
+		namespace:  namespace,
+		name:       name,
+		original:   content,
+		path:       f.Name(),
+		returnView: returnView,
+	}, nil
+}
+
+// newKeyEditSession writes the value of one ConfigMap or Secret key to a temp
+// file for the editor. The value is written as is, without the edit header.
+func newKeyEditSession(kind, namespace, name, key, value, resourceVersion string, returnView ViewState) (*editSession, error) {
+	f, err := os.CreateTemp("", fmt.Sprintf("k4s-edit-%s-%s-*-%s", strings.ToLower(kind), name, safeTempName(key)))
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(value); err != nil {
+		os.Remove(f.Name())
+		return nil, fmt.Errorf("write temp file: %w", err)
+	}
+
+	return &editSession{
+		kind:       kind,
+		namespace:  namespace,
+		name:       name,
+		key:        key,
+		version:    resourceVersion,
+		original:   value,
+		path:       f.Name(),
+		returnView: returnView,
+	}, nil
+}
+
+// Target returns the edited object as namespace/name
+func (s *editSession) Target() string {
+	if s.namespace == "" {
+		return s.name
+	}
+	return s.namespace + "/" + s.name
+}
+
+// isKeyEdit returns true when a single ConfigMap or Secret key is edited
+func (s *editSession) isKeyEdit() bool {
+	return s.key != ""
+}
+
+// unchanged reports whether content, as returned by readEdit, is what the
+// edit started from
+func (s *editSession) unchanged(content string) bool {
+	if s.isKeyEdit() {
+		return content == s.original
+	}
+	return content == stripEditComments(s.original)
+}
+
+// editorCommand builds the editor command for the temp file.
+// $KUBE_EDITOR wins over $EDITOR, like kubectl; both may carry arguments.
+func (s *editSession) editorCommand() *exec.Cmd {
+	editor := os.Getenv("KUBE_EDITOR")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	args := strings.Fields(editor)
+	args = append(args, s.path)
+	return exec.Command(args[0], args[1:]...)
+}
+
+// readEdit returns the saved file without the k4s comment header. A key value is
+// returned as is, except for the final newline editors add to files.
+func (s *editSession) readEdit() (string, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", fmt.Errorf("read edited file: %w", err)
+	}
+	if s.isKeyEdit() {
+		content := string(data)
+		if !strings.HasSuffix(s.original, "\n") {
+			content = strings.TrimSuffix(content, "\n")
+		}
+		return content, nil
+	}
+	return stripEditComments(string(data)), nil
+}
+
+// reopenWithError rewrites the temp file with the rejected content and the
+// API server's error on top, so the user can fix it in the next round
+func (s *editSession) reopenWithError(err error) error {
+	s.lastErr = err
+
+	var sb strings.Builder
+	sb.WriteString(editHeader)
+	sb.WriteString(fmt.Sprintf("# %s %q was not valid:\n", strings.ToLower(s.kind), s.Target()))
+	for _, line := range strings.Split(err.Error(), "\n") {
+		sb.WriteString("# * " + line + "\n")
+	}
+	sb.WriteString("#\n")
+	sb.WriteString(s.edited)
+
+	if err := os.WriteFile(s.path, []byte(sb.String()), 0o600); err != nil {
+		return fmt.Errorf("write temp file: %w", err)
+	}
+	return nil
+}
+
+// cleanup removes the temp file
+func (s *editSession) cleanup() {
+	os.Remove(s.path)
+}
+
+// Diff returns a unified diff from the live object to the dry-run result
+func (s *editSession) Diff() string {
+	return unifiedDiff(s.original, s.result, "live", "edited")
+}
+
+// safeTempName keeps the characters of a key that are safe in a file name,
+// so the editor can still pick a mode from the extension
+func safeTempName(key string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, key)
+}
+
+// stripEditComments drops the comment block k4s writes at the top of the
+// file: the edit header and the errors of the last attempt. Other comments are
+// kept, since a "#" line may be part of a block scalar such as a script.
+func stripEditComments(content string) string {
+	lines := strings.Split(content, "\n")
+	start := 0
+	for start < len(lines) && strings.HasPrefix(lines[start], "#") {
+		start++
+	}
+	return strings.TrimSpace(strings.Join(lines[start:], "\n")) + "\n"
+}
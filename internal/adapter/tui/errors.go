@@ -2,6 +2,7 @@ package tui
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -33,6 +34,19 @@ func formatUserError(err error) ErrorInfo {
 		}
 	}
 
+	var changed *ssh.HostKeyChangedError
+	if errors.As(err, &changed) {
+		return ErrorInfo{
+			Title: "Host Key Changed",
+			Message: fmt.Sprintf("The host key of %s does not match the one in known_hosts.\n"+
+				"Someone could be intercepting the connection (man-in-the-middle attack),\n"+
+				"or the host was reinstalled and has a new key.\n\n"+
+				"New key fingerprint: %s", changed.Host, changed.Fingerprint()),
+			Suggestion: fmt.Sprintf("k4s will not connect. If the change is expected, verify the new\nfingerprint with the host's administrator, then remove the old key\n(%s:%d):\n  ssh-keygen -R '%s' -f %s",
+				changed.KnownHostsPath, changed.KnownLine, changed.Host, changed.KnownHostsPath),
+		}
+	}
+
 	if strings.Contains(errStr, "connection refused") {
 		return ErrorInfo{
 			Title:      "Connection Refused",
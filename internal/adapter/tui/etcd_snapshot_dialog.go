@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// defaultEtcdSnapshotName is the name k3s itself uses for on-demand snapshots
+const defaultEtcdSnapshotName = "on-demand"
+
+// EtcdSnapshotDialog asks for the name of an on-demand etcd snapshot
+type EtcdSnapshotDialog struct {
+	node       string
+	inputValue string
+	visible    bool
+	width      int
+	form       *huh.Form
+}
+
+// NewEtcdSnapshotDialog creates a new etcd snapshot dialog
+func NewEtcdSnapshotDialog() EtcdSnapshotDialog {
+	return EtcdSnapshotDialog{}
+}
+
+// Show displays the dialog for a snapshot of node and returns a tea.Cmd to
+// initialise the form
+func (d *EtcdSnapshotDialog) Show(node string) tea.Cmd {
+	d.node = node
+	d.visible = true
+	d.inputValue = defaultEtcdSnapshotName
+
+	d.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Take etcd Snapshot").
+				Description(fmt.Sprintf("%s (node name and time are appended)", truncateString(node, 30))).
+				Placeholder(defaultEtcdSnapshotName).
+				Value(&d.inputValue).
+				Validate(func(s string) error {
+					return ssh.ValidateEtcdSnapshotName(strings.TrimSpace(s))
+				}),
+		),
+	).WithTheme(K4sHuhTheme()).WithShowHelp(false)
+	return d.form.Init()
+}
+
+// Hide hides the dialog
+func (d *EtcdSnapshotDialog) Hide() {
+	d.visible = false
+	d.node = ""
+	d.form = nil
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *EtcdSnapshotDialog) IsVisible() bool {
+	return d.visible
+}
+
+// Name returns the snapshot name entered
+func (d *EtcdSnapshotDialog) Name() string {
+	return strings.TrimSpace(d.inputValue)
+}
+
+// SetWidth sets the dialog width
+func (d *EtcdSnapshotDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Update handles key messages for the dialog
+func (d *EtcdSnapshotDialog) Update(msg tea.Msg) (confirmed bool, cancelled bool, cmd tea.Cmd) {
+	if !d.visible || d.form == nil {
+		return false, false, nil
+	}
+
+	// Handle esc for cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" {
+			return false, true, nil
+		}
+	}
+
+	model, formCmd := d.form.Update(msg)
+	if f, ok := model.(*huh.Form); ok {
+		d.form = f
+	}
+
+	if d.form.State == huh.StateCompleted {
+		return true, false, formCmd
+	}
+	if d.form.State == huh.StateAborted {
+		return false, true, formCmd
+	}
+
+	return false, false, formCmd
+}
+
+// View renders the dialog
+func (d *EtcdSnapshotDialog) View() string {
+	if !d.visible || d.form == nil {
+		return ""
+	}
+
+	dialogWidth := 55
+	if d.width > 0 && d.width < 65 {
+		dialogWidth = d.width - 10
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorBorder).
+		Padding(1, 2).
+		Width(dialogWidth).
+		Align(lipgloss.Center)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	content := d.form.View() + "\n" + hintStyle.Render("Enter: take snapshot • Esc: cancel")
+
+	return dialogStyle.Render(content)
+}
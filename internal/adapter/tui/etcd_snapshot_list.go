@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// etcdSnapshotItem implements list.Item for etcd snapshots
+type etcdSnapshotItem struct {
+	snapshot ssh.EtcdSnapshot
+}
+
+func (i etcdSnapshotItem) FilterValue() string {
+	return i.snapshot.Name + " " + i.snapshot.Location
+}
+
+// etcdSnapshotStorage returns where a snapshot is stored, "local" or "s3"
+func etcdSnapshotStorage(s ssh.EtcdSnapshot) string {
+	if s.S3() {
+		return "s3"
+	}
+	return "local"
+}
+
+// etcdSnapshotDelegate renders etcd snapshot list items
+type etcdSnapshotDelegate struct {
+	styles Styles
+}
+
+func (d etcdSnapshotDelegate) Height() int                             { return 1 }
+func (d etcdSnapshotDelegate) Spacing() int                            { return 0 }
+func (d etcdSnapshotDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d etcdSnapshotDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(etcdSnapshotItem)
+	if !ok {
+		return
+	}
+
+	s := item.snapshot
+
+	storageStyle := lipgloss.NewStyle().Foreground(colorSuccess)
+	if s.S3() {
+		storageStyle = lipgloss.NewStyle().Foreground(colorAccent)
+	}
+
+	created := "-"
+	if !s.Created.IsZero() {
+		created = s.Created.Local().Format("2006-01-02 15:04:05")
+	}
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME(45) STORAGE(7) SIZE(10) CREATED(19) LOCATION
+	namePadded := fmt.Sprintf("%-45s", truncateString(s.Name, 45))
+	storagePadded := fmt.Sprintf("%-7s", etcdSnapshotStorage(s))
+	sizePadded := fmt.Sprintf("%10s", formatBytes(int64(s.Size)))
+	createdPadded := fmt.Sprintf("%-19s", created)
+	location := truncateString(s.Location, max(m.Width()-90, 10))
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	storageStyled := storageStyle.Render(storagePadded)
+	sizeStyled := lipgloss.NewStyle().Foreground(colorText).Render(sizePadded)
+	createdStyled := mutedStyle.Render(createdPadded)
+	locationStyled := mutedStyle.Render(location)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s  %s  %s", prefix, nameStyle.Render(namePadded), storageStyled, sizeStyled, createdStyled, locationStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s  %s  %s", nameStyle.Render(namePadded), storageStyled, sizeStyled, createdStyled, locationStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newEtcdSnapshotList creates a list model for etcd snapshots
+func newEtcdSnapshotList(width, height int, styles Styles) list.Model {
+	l := list.New(nil, etcdSnapshotDelegate{styles: styles}, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateEtcdSnapshotList updates the etcd snapshot list
+func updateEtcdSnapshotList(l *list.Model, snapshots []ssh.EtcdSnapshot) {
+	items := make([]list.Item, len(snapshots))
+	for i, s := range snapshots {
+		items[i] = etcdSnapshotItem{snapshot: s}
+	}
+	l.SetItems(items)
+}
+
+// etcdSnapshotUsage sums the size of the local and the S3 snapshots
+func etcdSnapshotUsage(snapshots []ssh.EtcdSnapshot) (local, s3 uint64, s3Count int) {
+	for _, s := range snapshots {
+		if s.S3() {
+			s3 += s.Size
+			s3Count++
+		} else {
+			local += s.Size
+		}
+	}
+	return local, s3, s3Count
+}
+
+// renderEtcdMembers renders one line per etcd member, marking the leader and
+// the member of the connected node
+func renderEtcdMembers(members []ssh.EtcdMember, status *ssh.EtcdStatus, width int) string {
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	textStyle := lipgloss.NewStyle().Foreground(colorText)
+
+	lines := make([]string, 0, len(members))
+	for _, m := range members {
+		role := "follower"
+		roleStyle := mutedStyle
+		switch {
+		case m.Learner:
+			role = "learner"
+			roleStyle = lipgloss.NewStyle().Foreground(colorWarning)
+		case status != nil && m.ID == status.Leader:
+			role = "leader"
+			roleStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+		}
+
+		marker := "  "
+		if status != nil && m.ID == status.MemberID {
+			marker = lipgloss.NewStyle().Foreground(colorPrimary).Render("● ")
+		}
+
+		name := m.Name
+		if name == "" {
+			// Members that have not started yet have no name
+			name = "(unstarted)"
+		}
+
+		// Pad plain text FIRST, then apply styling
+		// Columns: NAME(45) ROLE(9) ID(17) PEER URLS
+		line := fmt.Sprintf("%s%s %s %s %s", marker,
+			textStyle.Render(fmt.Sprintf("%-45s", truncateString(name, 45))),
+			roleStyle.Render(fmt.Sprintf("%-9s", role)),
+			mutedStyle.Render(fmt.Sprintf("%-17s", m.IDHex())),
+			mutedStyle.Render(truncateString(strings.Join(m.PeerURLs, ","), max(width-78, 10))))
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
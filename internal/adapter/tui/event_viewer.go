@@ -23,6 +23,7 @@ type EventViewer struct {
 	autoScroll    bool
 	warningsOnly  bool
 	kindFilter    string // "", "Pod", "Deployment", "Service", etc.
+	showNamespace bool   // prefix objects with their namespace (all-namespaces mode)
 	searchQuery   string
 	totalEvents   int
 	filteredCount int
@@ -68,6 +69,12 @@ func (e *EventViewer) Clear() {
 	e.updateContent()
 }
 
+// SetShowNamespace sets whether objects are prefixed with their namespace
+func (e *EventViewer) SetShowNamespace(show bool) {
+	e.showNamespace = show
+	e.updateContent()
+}
+
 // SetFollowing sets the follow mode
 func (e *EventViewer) SetFollowing(following bool) {
 	e.following = following
@@ -235,6 +242,9 @@ func (e *EventViewer) formatEvent(evt domain.Event) string {
 
 	// Object (kind/name)
 	objectStr := fmt.Sprintf("%s/%s", evt.ObjectKind, evt.ObjectName)
+	if e.showNamespace {
+		objectStr = evt.Namespace + "/" + objectStr
+	}
 	line.WriteString(objectStyle.Render(fmt.Sprintf("%-40s ", truncateString(objectStr, 40))))
 
 	// Message (rest of line)
@@ -0,0 +1,189 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// HelmChartDetailsModel is the model for the HelmChart details view
+type HelmChartDetailsModel struct {
+	chart    *domain.HelmChart
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewHelmChartDetailsModel creates a new HelmChart details model
+func NewHelmChartDetailsModel(styles Styles) HelmChartDetailsModel {
+	return HelmChartDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetChart sets the chart to display
+func (m *HelmChartDetailsModel) SetChart(chart *domain.HelmChart) {
+	m.chart = chart
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *HelmChartDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.chart != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m HelmChartDetailsModel) Update(msg tea.Msg) (HelmChartDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the chart details
+func (m HelmChartDetailsModel) View() string {
+	if !m.ready || m.chart == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *HelmChartDetailsModel) renderContent() string {
+	if m.chart == nil {
+		return "No chart selected"
+	}
+
+	var sb strings.Builder
+	c := m.chart
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(18)
+
+	valueStyle := lipgloss.NewStyle()
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(c.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(c.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Chart:"), valueStyle.Render(orNone(c.Chart))))
+	if c.Repo != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Repo:"), valueStyle.Render(c.Repo)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Version:"), valueStyle.Render(orNone(c.Version))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Target Namespace:"), valueStyle.Render(c.TargetNamespace)))
+	if c.Bootstrap {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Bootstrap:"), valueStyle.Render("yes")))
+	}
+	if c.Addon {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Managed By:"),
+			lipgloss.NewStyle().Foreground(colorWarning).Render("k3s manifests (edits are reverted, use a HelmChartConfig)")))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(c.Age)))
+
+	// === Install Job Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("INSTALL JOB"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Job:"), valueStyle.Render(c.JobName)))
+	if c.JobStatus == "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), mutedStyle.Render("not found (cleaned up or not created yet)")))
+	} else {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), helmJobStyle(*c).Bold(true).Render(helmJobText(*c))))
+	}
+	if c.Job != nil {
+		for _, cond := range c.Job.Conditions {
+			if cond.Status == "True" && cond.Message != "" {
+				sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render(cond.Type+":"), valueStyle.Render(truncateString(cond.Message, m.width-22))))
+			}
+		}
+		if len(c.Job.Pods) > 0 {
+			sb.WriteString("\n")
+			sb.WriteString(mutedStyle.Bold(true).Render(fmt.Sprintf("  %-50s %-18s %-9s %s", "POD", "STATUS", "RESTARTS", "AGE")))
+			sb.WriteString("\n")
+			for _, pod := range c.Job.Pods {
+				statusColor := lipgloss.NewStyle().Foreground(colorError)
+				switch pod.Status {
+				case "Running", "Succeeded", "Completed":
+					statusColor = lipgloss.NewStyle().Foreground(colorSuccess)
+				case "Pending", "ContainerCreating", "Terminating":
+					statusColor = lipgloss.NewStyle().Foreground(colorWarning)
+				}
+				sb.WriteString(fmt.Sprintf("  %-50s %s %-9d %s\n",
+					truncateString(pod.Name, 50),
+					statusColor.Render(fmt.Sprintf("%-18s", truncateString(pod.Status, 18))),
+					pod.Restarts,
+					pod.Age))
+			}
+		}
+	}
+
+	// === Values Sections ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("VALUES"))
+	sb.WriteString("\n")
+	sb.WriteString(renderHelmValues(c.ValuesContent, "No valuesContent"))
+
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("HELMCHARTCONFIG VALUES"))
+	sb.WriteString("\n")
+	if c.Config == nil {
+		sb.WriteString(mutedStyle.Render("  No HelmChartConfig (V creates one)"))
+		sb.WriteString("\n")
+	} else {
+		sb.WriteString(renderHelmValues(c.Config.ValuesContent, "No valuesContent"))
+	}
+
+	return sb.String()
+}
+
+// renderHelmValues renders a valuesContent document, highlighted like the
+// YAML viewer
+func renderHelmValues(values, empty string) string {
+	values = strings.TrimRight(values, "\n")
+	if strings.TrimSpace(values) == "" {
+		return lipgloss.NewStyle().Foreground(colorMuted).Render("  "+empty) + "\n"
+	}
+
+	var sb strings.Builder
+	for _, line := range strings.Split(values, "\n") {
+		sb.WriteString("  ")
+		sb.WriteString(highlightYAMLLine(line))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *HelmChartDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// Chart returns the current chart
+func (m *HelmChartDetailsModel) Chart() *domain.HelmChart {
+	return m.chart
+}
@@ -0,0 +1,152 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// helmChartItem implements list.Item for HelmCharts
+type helmChartItem struct {
+	chart domain.HelmChart
+}
+
+func (i helmChartItem) FilterValue() string {
+	return i.chart.Namespace + "/" + i.chart.Name + " " + i.chart.Chart + " " + i.chart.TargetNamespace
+}
+
+// helmJobText describes the install job of a chart. The job retries failed
+// installs for a long time, so failures show while it is still running.
+func helmJobText(c domain.HelmChart) string {
+	switch {
+	case c.JobStatus == "":
+		return "-"
+	case c.JobStatus == domain.JobStatusRunning && c.JobFailures > 0:
+		return fmt.Sprintf("Failing (%d)", c.JobFailures)
+	}
+	return c.JobStatus
+}
+
+// helmJobStyle returns the style for the install job status of a chart
+func helmJobStyle(c domain.HelmChart) lipgloss.Style {
+	if c.Failing() {
+		return lipgloss.NewStyle().Foreground(colorError)
+	}
+	return jobStatusStyle(c.JobStatus)
+}
+
+// helmChartDelegate renders HelmChart list items
+type helmChartDelegate struct {
+	styles Styles
+}
+
+func (d helmChartDelegate) Height() int                             { return 1 }
+func (d helmChartDelegate) Spacing() int                            { return 0 }
+func (d helmChartDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d helmChartDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(helmChartItem)
+	if !ok {
+		return
+	}
+
+	c := item.chart
+
+	config := "-"
+	if c.Config != nil {
+		config = "yes"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAMESPACE(16) NAME(26) CHART(26) VERSION(20) TARGET(16) CONFIG(6) JOB(14) AGE
+	nsPadded := fmt.Sprintf("%-16s", truncateString(c.Namespace, 16))
+	namePadded := fmt.Sprintf("%-26s", truncateString(c.Name, 26))
+	chartPadded := fmt.Sprintf("%-26s", truncateString(orNone(c.Chart), 26))
+	versionPadded := fmt.Sprintf("%-20s", truncateString(orNone(c.Version), 20))
+	targetPadded := fmt.Sprintf("%-16s", truncateString(c.TargetNamespace, 16))
+	configPadded := fmt.Sprintf("%-6s", config)
+	jobPadded := fmt.Sprintf("%-14s", helmJobText(c))
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	nsStyled := mutedStyle.Render(nsPadded)
+	chartStyled := lipgloss.NewStyle().Foreground(colorText).Render(chartPadded)
+	versionStyled := mutedStyle.Render(versionPadded)
+	targetStyled := mutedStyle.Render(targetPadded)
+	configStyle := mutedStyle
+	if c.Config != nil {
+		configStyle = lipgloss.NewStyle().Foreground(colorAccent)
+	}
+	configStyled := configStyle.Render(configPadded)
+	jobStyled := helmJobStyle(c).Render(jobPadded)
+	ageStyled := mutedStyle.Render(c.Age)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s %s %s", prefix, nsStyled, nameStyle.Render(namePadded), chartStyled, versionStyled, targetStyled, configStyled, jobStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s %s %s", nsStyled, nameStyle.Render(namePadded), chartStyled, versionStyled, targetStyled, configStyled, jobStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newHelmChartList creates a list model for HelmCharts
+func newHelmChartList(charts []domain.HelmChart, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(charts))
+	for i, c := range charts {
+		items[i] = helmChartItem{chart: c}
+	}
+
+	delegate := helmChartDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateHelmChartList updates the HelmChart list items while preserving selection
+func updateHelmChartList(l *list.Model, charts []domain.HelmChart) tea.Cmd {
+	currentIndex := l.Index()
+	var currentKey string
+	if item, ok := l.SelectedItem().(helmChartItem); ok {
+		currentKey = item.chart.Namespace + "/" + item.chart.Name
+	}
+
+	items := make([]list.Item, len(charts))
+	newIndex := 0
+	for i, c := range charts {
+		items[i] = helmChartItem{chart: c}
+		if c.Namespace+"/"+c.Name == currentKey {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentKey != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
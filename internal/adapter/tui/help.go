@@ -78,20 +78,39 @@ func (h *HelpScreen) View() string {
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "q", "Quit"))
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "Esc", "Back"))
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "r", "Refresh"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "C", "Context"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "A", "All ns"))
 	col1.WriteString("\n")
 	col1.WriteString(sectionStyle.Render("Navigation"))
 	col1.WriteString("\n")
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "↑/↓", "Move"))
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "Enter", "Select"))
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "/", "Filter"))
-	col1.WriteString(renderShortcut(keyStyle, descStyle, "1-5", "Views"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "1-8", "Views"))
 	col1.WriteString(renderShortcut(keyStyle, descStyle, "9", "SSH"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "0", "Forwards"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, ":", "Resource"))
+	col1.WriteString("\n")
+	col1.WriteString(sectionStyle.Render("Jobs / CronJobs"))
+	col1.WriteString("\n")
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "p", "Pods"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "l", "Logs"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "t", "Run now"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "s", "Suspend"))
+	col1.WriteString("\n")
+	col1.WriteString(sectionStyle.Render("ConfigMaps / Secrets"))
+	col1.WriteString("\n")
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "x", "Reveal"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "e", "Edit key"))
+	col1.WriteString(renderShortcut(keyStyle, descStyle, "E", "Edit all"))
 
-	// Column 2: Pod + Deployment actions
+	// Column 2: Pod + workload actions
 	var col2 strings.Builder
 	col2.WriteString(sectionStyle.Render("Pods"))
 	col2.WriteString("\n")
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "l", "Logs"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "x", "Shell"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "F", "Forward"))
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "d", "Delete"))
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "R", "Restart"))
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "m", "Metrics"))
@@ -101,6 +120,27 @@ func (h *HelpScreen) View() string {
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "s", "Scale"))
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "d", "Delete"))
 	col2.WriteString(renderShortcut(keyStyle, descStyle, "R", "Restart"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "h", "History"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "u", "Rollback"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "P", "Pause/Resume"))
+	col2.WriteString("\n")
+	col2.WriteString(sectionStyle.Render("StatefulSets / DaemonSets"))
+	col2.WriteString("\n")
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "s", "Scale (sts)"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "R", "Restart"))
+	col2.WriteString("\n")
+	col2.WriteString(sectionStyle.Render("Nodes"))
+	col2.WriteString("\n")
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "c", "Cordon"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "u", "Uncordon"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "D", "Drain"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "S", "Containers"))
+	col2.WriteString("\n")
+	col2.WriteString(sectionStyle.Render("Services / Ingresses"))
+	col2.WriteString("\n")
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "p", "Pods (svc)"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "Tab", "Next backend"))
+	col2.WriteString(renderShortcut(keyStyle, descStyle, "Enter", "Open service"))
 
 	// Column 3: Events + Logs viewer
 	var col3 strings.Builder
@@ -116,6 +156,25 @@ func (h *HelpScreen) View() string {
 	col3.WriteString(renderShortcut(keyStyle, descStyle, "t", "Timestamps"))
 	col3.WriteString(renderShortcut(keyStyle, descStyle, "c", "Container"))
 	col3.WriteString(renderShortcut(keyStyle, descStyle, "g/G", "Top/Bottom"))
+	col3.WriteString("\n")
+	col3.WriteString(sectionStyle.Render("YAML"))
+	col3.WriteString("\n")
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "y", "View / Copy"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "e", "Edit"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "m", "managedFields"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "n/N", "Next/Prev"))
+	col3.WriteString("\n")
+	col3.WriteString(sectionStyle.Render("Storage"))
+	col3.WriteString("\n")
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "s", "Resize (pvc)"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "v", "Volume (pvc)"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "o", "Orphaned (pv)"))
+	col3.WriteString("\n")
+	col3.WriteString(sectionStyle.Render("HelmCharts"))
+	col3.WriteString("\n")
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "v", "Edit values"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "V", "Edit config values"))
+	col3.WriteString(renderShortcut(keyStyle, descStyle, "l", "Install job logs"))
 
 	// Column style
 	colStyle := lipgloss.NewStyle().
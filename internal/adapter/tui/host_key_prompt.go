@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// HostKeyPrompt is a modal dialog asking whether to trust an unknown SSH host key
+type HostKeyPrompt struct {
+	visible bool
+	unknown *ssh.UnknownHostKeyError
+	trust   bool
+	width   int
+	form    *huh.Form
+}
+
+// NewHostKeyPrompt creates a new host key prompt
+func NewHostKeyPrompt() HostKeyPrompt {
+	return HostKeyPrompt{}
+}
+
+// Show displays the prompt for an unknown host key and returns a tea.Cmd.
+func (p *HostKeyPrompt) Show(unknown *ssh.UnknownHostKeyError) tea.Cmd {
+	p.visible = true
+	p.unknown = unknown
+	p.trust = false
+
+	description := fmt.Sprintf("The authenticity of %s can't be established.\n%s key fingerprint is\n%s\n\nIt will be added to %s.",
+		unknown.Host, strings.ToUpper(strings.TrimPrefix(unknown.Key.Type(), "ssh-")), unknown.Fingerprint(), unknown.KnownHostsPath)
+
+	p.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Unknown SSH Host Key").
+				Description(description).
+				Affirmative("Trust").
+				Negative("Cancel").
+				Value(&p.trust),
+		),
+	).WithTheme(K4sHuhTheme()).WithShowHelp(false)
+	return p.form.Init()
+}
+
+// Hide hides the prompt
+func (p *HostKeyPrompt) Hide() {
+	p.visible = false
+	p.unknown = nil
+	p.form = nil
+}
+
+// IsVisible returns true if the prompt is visible
+func (p *HostKeyPrompt) IsVisible() bool {
+	return p.visible
+}
+
+// SetWidth sets the dialog width
+func (p *HostKeyPrompt) SetWidth(width int) {
+	p.width = width
+}
+
+// HostKey returns the host key awaiting a decision
+func (p *HostKeyPrompt) HostKey() *ssh.UnknownHostKeyError {
+	return p.unknown
+}
+
+// Update handles input messages, returns (trusted, cancelled, cmd)
+func (p *HostKeyPrompt) Update(msg tea.Msg) (bool, bool, tea.Cmd) {
+	if p.form == nil {
+		return false, false, nil
+	}
+
+	// Handle esc for cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" {
+			return false, true, nil
+		}
+	}
+
+	model, cmd := p.form.Update(msg)
+	if f, ok := model.(*huh.Form); ok {
+		p.form = f
+	}
+
+	if p.form.State == huh.StateCompleted {
+		return p.trust, !p.trust, cmd
+	}
+	if p.form.State == huh.StateAborted {
+		return false, true, cmd
+	}
+
+	return false, false, cmd
+}
+
+// View renders the host key prompt
+func (p *HostKeyPrompt) View() string {
+	if !p.visible || p.form == nil {
+		return ""
+	}
+
+	dialogWidth := 64
+	if p.width > 0 && p.width < 74 {
+		dialogWidth = p.width - 10
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorWarning).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	var sb strings.Builder
+	sb.WriteString(p.form.View())
+	sb.WriteString("\n")
+	sb.WriteString(helpStyle.Render("←/→: choose • Enter: confirm • Esc: cancel"))
+
+	return dialogStyle.Render(sb.String())
+}
@@ -0,0 +1,239 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// routeBackend is a backend of an Ingress or IngressRoute that can be opened
+// in the service details view
+type routeBackend struct {
+	namespace string
+	service   string
+	// unsupported says why the backend is not a plain service, e.g. a
+	// TraefikService or an Ingress resource backend
+	unsupported string
+}
+
+// IngressDetailsModel is the model for the ingress details view
+type IngressDetailsModel struct {
+	ingress  *domain.Ingress
+	backends []routeBackend // one per rule
+	selected int
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewIngressDetailsModel creates a new ingress details model
+func NewIngressDetailsModel(styles Styles) IngressDetailsModel {
+	return IngressDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetIngress sets the ingress to display. The selected backend is kept when
+// the same ingress is reloaded.
+func (m *IngressDetailsModel) SetIngress(ing *domain.Ingress) {
+	same := m.ingress != nil && m.ingress.Name == ing.Name && m.ingress.Namespace == ing.Namespace
+	m.ingress = ing
+	m.backends = make([]routeBackend, 0, len(ing.Rules))
+	for _, r := range ing.Rules {
+		b := routeBackend{namespace: ing.Namespace, service: r.Backend.Service}
+		if r.Backend.Service == "" {
+			b.unsupported = fmt.Sprintf("backend %s is not a service", r.Backend.Resource)
+		}
+		m.backends = append(m.backends, b)
+	}
+	if !same || m.selected >= len(m.backends) {
+		m.selected = 0
+	}
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		if !same {
+			m.viewport.GotoTop()
+		}
+	}
+}
+
+// SetSize sets the viewport size
+func (m *IngressDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.ingress != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// NextBackend selects the next backend, wrapping around
+func (m *IngressDetailsModel) NextBackend() {
+	if len(m.backends) == 0 {
+		return
+	}
+	m.selected = (m.selected + 1) % len(m.backends)
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// SelectedBackend returns the selected backend
+func (m *IngressDetailsModel) SelectedBackend() (routeBackend, bool) {
+	if m.selected >= len(m.backends) {
+		return routeBackend{}, false
+	}
+	return m.backends[m.selected], true
+}
+
+// Update handles messages
+func (m IngressDetailsModel) Update(msg tea.Msg) (IngressDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the ingress details
+func (m IngressDetailsModel) View() string {
+	if !m.ready || m.ingress == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *IngressDetailsModel) renderContent() string {
+	if m.ingress == nil {
+		return "No ingress selected"
+	}
+
+	var sb strings.Builder
+	ing := m.ingress
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	class := ing.Class
+	if class == "" {
+		class = "<none>"
+	}
+	address := ing.Address
+	if address == "" {
+		address = "<pending>"
+	}
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(ing.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(ing.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Class:"), valueStyle.Render(class)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Address:"), valueStyle.Render(address)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(ing.Age)))
+
+	// === Rules Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("RULES (%d)", len(ing.Rules))))
+	sb.WriteString("\n")
+	if len(ing.Rules) == 0 {
+		sb.WriteString(mutedStyle.Render("  No rules"))
+		sb.WriteString("\n")
+	} else {
+		ruleHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-30s %-30s %-22s %s", "HOST", "PATH", "PATH TYPE", "BACKEND"))
+		sb.WriteString(ruleHeader)
+		sb.WriteString("\n")
+
+		prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+		for i, r := range ing.Rules {
+			host := r.Host
+			if host == "" {
+				host = "*"
+			}
+			backend := r.Backend.Resource
+			if r.Backend.Service != "" {
+				backend = r.Backend.Service + ":" + r.Backend.Port
+			}
+
+			line := fmt.Sprintf("%-30s %-30s %-22s %s",
+				truncateString(host, 30),
+				truncateString(r.Path, 30),
+				truncateString(r.PathType, 22),
+				backend)
+			if i == m.selected {
+				sb.WriteString(prefix + " " + lipgloss.NewStyle().Bold(true).Foreground(colorText).Render(line))
+			} else {
+				sb.WriteString("  " + line)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	// === TLS Section ===
+	if len(ing.TLS) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("TLS"))
+		sb.WriteString("\n")
+		for _, t := range ing.TLS {
+			hosts := strings.Join(t.Hosts, ",")
+			if hosts == "" {
+				hosts = "*"
+			}
+			secret := t.SecretName
+			if secret == "" {
+				secret = "<default certificate>"
+			}
+			sb.WriteString(fmt.Sprintf("  %s %s %s\n", truncateString(hosts, 50), mutedStyle.Render("→"), secret))
+		}
+	}
+
+	// === Labels Section ===
+	if len(ing.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(ing.Labels))
+		for k := range ing.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(ing.Labels[k], 40)))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *IngressDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// Ingress returns the current ingress
+func (m *IngressDetailsModel) Ingress() *domain.Ingress {
+	return m.ingress
+}
@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// ingressItem implements list.Item for ingresses
+type ingressItem struct {
+	ingress domain.Ingress
+}
+
+func (i ingressItem) FilterValue() string {
+	return i.ingress.Name + " " + strings.Join(i.ingress.Hosts, " ")
+}
+
+// ingressDelegate renders ingress list items
+type ingressDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d ingressDelegate) Height() int                             { return 1 }
+func (d ingressDelegate) Spacing() int                            { return 0 }
+func (d ingressDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d ingressDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(ingressItem)
+	if !ok {
+		return
+	}
+
+	ing := item.ingress
+
+	class := ing.Class
+	if class == "" {
+		class = "<none>"
+	}
+	address := ing.Address
+	if address == "" {
+		address = "<pending>"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-30s", truncateString(ing.Name, 30))
+	classPadded := fmt.Sprintf("%-10s", truncateString(class, 10))
+	hostsPadded := fmt.Sprintf("%-40s", truncateString(strings.Join(ing.Hosts, ","), 40))
+	addressPadded := fmt.Sprintf("%-16s", truncateString(address, 16))
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(ing.Namespace, 20))) + " "
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	classStyled := mutedStyle.Render(classPadded)
+	hostsStyled := lipgloss.NewStyle().Foreground(colorText).Render(hostsPadded)
+	addressStyle := mutedStyle
+	if ing.Address != "" {
+		addressStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	}
+	addressStyled := addressStyle.Render(addressPadded)
+	ageStyled := mutedStyle.Render(ing.Age)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s", prefix, nsColumn+nameStyle.Render(namePadded), classStyled, hostsStyled, addressStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s", nsColumn+nameStyle.Render(namePadded), classStyled, hostsStyled, addressStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newIngressList creates a list model for ingresses. showNamespace adds a
+// NAMESPACE column for lists spanning all namespaces.
+func newIngressList(ingresses []domain.Ingress, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(ingresses))
+	for i, ing := range ingresses {
+		items[i] = ingressItem{ingress: ing}
+	}
+
+	delegate := ingressDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateIngressList updates the ingress list items while preserving selection
+func updateIngressList(l *list.Model, ingresses []domain.Ingress) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(ingressItem); ok {
+		currentName = item.ingress.Name
+		currentNamespace = item.ingress.Namespace
+	}
+
+	items := make([]list.Item, len(ingresses))
+	newIndex := 0
+	for i, ing := range ingresses {
+		items[i] = ingressItem{ingress: ing}
+		if ing.Name == currentName && ing.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -0,0 +1,255 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// IngressRouteDetailsModel is the model for the Traefik IngressRoute details view
+type IngressRouteDetailsModel struct {
+	route    *domain.IngressRoute
+	backends []routeBackend // services of all routes, in order
+	selected int
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewIngressRouteDetailsModel creates a new IngressRoute details model
+func NewIngressRouteDetailsModel(styles Styles) IngressRouteDetailsModel {
+	return IngressRouteDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetIngressRoute sets the IngressRoute to display. The selected backend is
+// kept when the same route is reloaded.
+func (m *IngressRouteDetailsModel) SetIngressRoute(route *domain.IngressRoute) {
+	same := m.route != nil && m.route.Name == route.Name && m.route.Namespace == route.Namespace
+	m.route = route
+	m.backends = nil
+	for _, r := range route.Routes {
+		for _, s := range r.Services {
+			b := routeBackend{namespace: s.Namespace, service: s.Name}
+			if s.Kind != domain.IngressRouteServiceKind {
+				b.unsupported = fmt.Sprintf("%s '%s' is not a Kubernetes service", s.Kind, s.Name)
+			}
+			m.backends = append(m.backends, b)
+		}
+	}
+	if !same || m.selected >= len(m.backends) {
+		m.selected = 0
+	}
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		if !same {
+			m.viewport.GotoTop()
+		}
+	}
+}
+
+// SetSize sets the viewport size
+func (m *IngressRouteDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.route != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// NextBackend selects the next backend service, wrapping around
+func (m *IngressRouteDetailsModel) NextBackend() {
+	if len(m.backends) == 0 {
+		return
+	}
+	m.selected = (m.selected + 1) % len(m.backends)
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// SelectedBackend returns the selected backend service
+func (m *IngressRouteDetailsModel) SelectedBackend() (routeBackend, bool) {
+	if m.selected >= len(m.backends) {
+		return routeBackend{}, false
+	}
+	return m.backends[m.selected], true
+}
+
+// Update handles messages
+func (m IngressRouteDetailsModel) Update(msg tea.Msg) (IngressRouteDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the IngressRoute details
+func (m IngressRouteDetailsModel) View() string {
+	if !m.ready || m.route == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *IngressRouteDetailsModel) renderContent() string {
+	if m.route == nil {
+		return "No ingressroute selected"
+	}
+
+	var sb strings.Builder
+	route := m.route
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	entryPoints := strings.Join(route.EntryPoints, ", ")
+	if entryPoints == "" {
+		entryPoints = "<all>"
+	}
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(route.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(route.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Entry Points:"), valueStyle.Render(entryPoints)))
+	if route.TLSSecret != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("TLS Secret:"), valueStyle.Render(route.TLSSecret)))
+	}
+	if route.CertResolver != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Cert Resolver:"), valueStyle.Render(route.CertResolver)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(route.Age)))
+
+	// === Routes Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("ROUTES (%d)", len(route.Routes))))
+	sb.WriteString("\n")
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+	matchStyle := lipgloss.NewStyle().Foreground(colorAccent)
+	backend := 0
+	for i, r := range route.Routes {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		title := fmt.Sprintf("  %d. ", i+1)
+		sb.WriteString(title + matchStyle.Render(r.Match))
+		if r.Priority != 0 {
+			sb.WriteString(mutedStyle.Render(fmt.Sprintf("  priority %d", r.Priority)))
+		}
+		sb.WriteString("\n")
+
+		for _, s := range r.Services {
+			target := s.Name
+			if s.Namespace != route.Namespace {
+				target = s.Namespace + "/" + s.Name
+			}
+			if s.Port != "" {
+				target += ":" + s.Port
+			}
+			line := fmt.Sprintf("%-16s %-50s", s.Kind, truncateString(target, 50))
+			if s.Weight != 0 {
+				line += fmt.Sprintf(" weight %d", s.Weight)
+			}
+			if backend == m.selected {
+				sb.WriteString("   " + prefix + " " + lipgloss.NewStyle().Bold(true).Foreground(colorText).Render(line))
+			} else {
+				sb.WriteString("     " + line)
+			}
+			sb.WriteString("\n")
+			backend++
+		}
+
+		if len(r.Middlewares) > 0 {
+			names := make([]string, 0, len(r.Middlewares))
+			for _, mw := range r.Middlewares {
+				names = append(names, mw.Name)
+			}
+			sb.WriteString("     " + mutedStyle.Render("middlewares: ") + strings.Join(names, " → "))
+			sb.WriteString("\n")
+		}
+	}
+
+	// === Middlewares Section ===
+	if len(route.Middlewares) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("MIDDLEWARES (%d)", len(route.Middlewares))))
+		sb.WriteString("\n")
+
+		mwHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-30s %-20s %s", "NAME", "NAMESPACE", "TYPE"))
+		sb.WriteString(mwHeader)
+		sb.WriteString("\n")
+
+		for _, mw := range route.Middlewares {
+			mwType := mw.Type
+			typeStyle := valueStyle
+			switch {
+			case mw.Missing:
+				mwType = "not found"
+				typeStyle = lipgloss.NewStyle().Foreground(colorError)
+			case strings.Contains(mw.Name, "@"):
+				mwType = "other provider"
+				typeStyle = mutedStyle
+			}
+			sb.WriteString(fmt.Sprintf("  %-30s %-20s %s\n",
+				truncateString(mw.Name, 30),
+				truncateString(mw.Namespace, 20),
+				typeStyle.Render(mwType)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(route.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(route.Labels))
+		for k := range route.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(route.Labels[k], 40)))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *IngressRouteDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// IngressRoute returns the current IngressRoute
+func (m *IngressRouteDetailsModel) IngressRoute() *domain.IngressRoute {
+	return m.route
+}
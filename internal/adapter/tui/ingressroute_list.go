@@ -0,0 +1,152 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// ingressRouteItem implements list.Item for Traefik IngressRoutes
+type ingressRouteItem struct {
+	route domain.IngressRoute
+}
+
+func (i ingressRouteItem) FilterValue() string {
+	matches := make([]string, 0, len(i.route.Routes))
+	for _, r := range i.route.Routes {
+		matches = append(matches, r.Match)
+	}
+	return i.route.Name + " " + strings.Join(matches, " ")
+}
+
+// ingressRouteDelegate renders IngressRoute list items
+type ingressRouteDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d ingressRouteDelegate) Height() int                             { return 1 }
+func (d ingressRouteDelegate) Spacing() int                            { return 0 }
+func (d ingressRouteDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d ingressRouteDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(ingressRouteItem)
+	if !ok {
+		return
+	}
+
+	r := item.route
+
+	// The first match rule stands for the route; the count tells how many more
+	match := "-"
+	if len(r.Routes) > 0 {
+		match = r.Routes[0].Match
+		if len(r.Routes) > 1 {
+			match += fmt.Sprintf(" (+%d)", len(r.Routes)-1)
+		}
+	}
+	entryPoints := strings.Join(r.EntryPoints, ",")
+	if entryPoints == "" {
+		entryPoints = "<all>"
+	}
+	tls := "-"
+	if r.TLSSecret != "" || r.CertResolver != "" {
+		tls = "yes"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-30s", truncateString(r.Name, 30))
+	entryPointsPadded := fmt.Sprintf("%-18s", truncateString(entryPoints, 18))
+	tlsPadded := fmt.Sprintf("%-4s", tls)
+	agePadded := fmt.Sprintf("%-6s", r.Age)
+	matchText := truncateString(match, max(m.Width()-70, 20))
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(r.Namespace, 20))) + " "
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	entryPointsStyled := mutedStyle.Render(entryPointsPadded)
+	tlsStyle := mutedStyle
+	if tls != "-" {
+		tlsStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	}
+	tlsStyled := tlsStyle.Render(tlsPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+	matchStyled := lipgloss.NewStyle().Foreground(colorText).Render(matchText)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s", prefix, nsColumn+nameStyle.Render(namePadded), entryPointsStyled, tlsStyled, ageStyled, matchStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s", nsColumn+nameStyle.Render(namePadded), entryPointsStyled, tlsStyled, ageStyled, matchStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newIngressRouteList creates a list model for IngressRoutes. showNamespace
+// adds a NAMESPACE column for lists spanning all namespaces.
+func newIngressRouteList(routes []domain.IngressRoute, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(routes))
+	for i, r := range routes {
+		items[i] = ingressRouteItem{route: r}
+	}
+
+	delegate := ingressRouteDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateIngressRouteList updates the IngressRoute list items while preserving selection
+func updateIngressRouteList(l *list.Model, routes []domain.IngressRoute) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(ingressRouteItem); ok {
+		currentName = item.route.Name
+		currentNamespace = item.route.Namespace
+	}
+
+	items := make([]list.Item, len(routes))
+	newIndex := 0
+	for i, r := range routes {
+		items[i] = ingressRouteItem{route: r}
+		if r.Name == currentName && r.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -0,0 +1,233 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// JobDetailsModel is the model for job details view
+type JobDetailsModel struct {
+	job      *domain.Job
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewJobDetailsModel creates a new job details model
+func NewJobDetailsModel(styles Styles) JobDetailsModel {
+	return JobDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetJob sets the job to display
+func (m *JobDetailsModel) SetJob(job *domain.Job) {
+	m.job = job
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *JobDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.job != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m JobDetailsModel) Update(msg tea.Msg) (JobDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the job details
+func (m JobDetailsModel) View() string {
+	if !m.ready || m.job == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *JobDetailsModel) renderContent() string {
+	if m.job == nil {
+		return "No job selected"
+	}
+
+	var sb strings.Builder
+	job := m.job
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+
+	owner := job.Owner
+	if owner == "" {
+		owner = "<none>"
+	}
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(job.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(job.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Controlled by:"), valueStyle.Render(owner)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(job.Age)))
+
+	// === Status Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("STATUS"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), jobStatusStyle(job.Status).Bold(true).Render(job.Status)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Completions:"), valueStyle.Render(job.Completions)))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Active:"), job.Active))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Succeeded:"), job.Succeeded))
+	failedStyle := valueStyle
+	if job.Failed > 0 {
+		failedStyle = lipgloss.NewStyle().Foreground(colorError)
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Failed:"), failedStyle.Render(fmt.Sprintf("%d (backoff limit %d)", job.Failed, job.BackoffLimit))))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Parallelism:"), job.Parallelism))
+	if job.StartTime != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Started:"), valueStyle.Render(job.StartTime)))
+	}
+	if job.CompletionTime != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Completed:"), valueStyle.Render(job.CompletionTime)))
+	}
+	if job.Duration != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Duration:"), valueStyle.Render(job.Duration)))
+	}
+
+	// === Pods Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("PODS (%d)", len(job.Pods))))
+	sb.WriteString("\n")
+	if len(job.Pods) == 0 {
+		sb.WriteString(lipgloss.NewStyle().Foreground(colorMuted).Render("  No pods left (cleaned up or not started)"))
+		sb.WriteString("\n")
+	} else {
+		podHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-45s %-7s %-18s %-9s %-24s %s", "NAME", "READY", "STATUS", "RESTARTS", "NODE", "AGE"))
+		sb.WriteString(podHeader)
+		sb.WriteString("\n")
+
+		for _, pod := range job.Pods {
+			var statusColor lipgloss.Style
+			switch pod.Status {
+			case "Running", "Succeeded", "Completed":
+				statusColor = lipgloss.NewStyle().Foreground(colorSuccess)
+			case "Pending", "ContainerCreating", "Terminating":
+				statusColor = lipgloss.NewStyle().Foreground(colorWarning)
+			default:
+				statusColor = lipgloss.NewStyle().Foreground(colorError)
+			}
+
+			sb.WriteString(fmt.Sprintf("  %-45s %-7s %s %-9d %-24s %s\n",
+				truncateString(pod.Name, 45),
+				pod.Ready,
+				statusColor.Render(fmt.Sprintf("%-18s", truncateString(pod.Status, 18))),
+				pod.Restarts,
+				truncateString(pod.Node, 24),
+				pod.Age))
+		}
+	}
+
+	// === Images Section ===
+	if len(job.Images) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("IMAGES (%d)", len(job.Images))))
+		sb.WriteString("\n")
+		for _, img := range job.Images {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncateString(img, m.width-6)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(job.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(job.Labels))
+		for k := range job.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := job.Labels[k]
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(v, 40)))
+		}
+	}
+
+	// === Conditions Section ===
+	if len(job.Conditions) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("CONDITIONS"))
+		sb.WriteString("\n")
+
+		condHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-20s %-8s %-24s %s", "TYPE", "STATUS", "REASON", "AGE"))
+		sb.WriteString(condHeader)
+		sb.WriteString("\n")
+
+		for _, cond := range job.Conditions {
+			var statusColor lipgloss.Style
+			if cond.Status == "True" {
+				statusColor = lipgloss.NewStyle().Foreground(colorSuccess)
+			} else {
+				statusColor = lipgloss.NewStyle().Foreground(colorError)
+			}
+
+			sb.WriteString(fmt.Sprintf("  %-20s %s %-24s %s\n",
+				truncateString(cond.Type, 20),
+				statusColor.Render(fmt.Sprintf("%-8s", cond.Status)),
+				truncateString(cond.Reason, 24),
+				cond.LastTransition))
+
+			if cond.Message != "" {
+				sb.WriteString(fmt.Sprintf("    %s\n", truncateString(cond.Message, m.width-10)))
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *JobDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// Job returns the current job
+func (m *JobDetailsModel) Job() *domain.Job {
+	return m.job
+}
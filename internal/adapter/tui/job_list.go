@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// jobItem implements list.Item for jobs
+type jobItem struct {
+	job domain.Job
+}
+
+func (i jobItem) FilterValue() string { return i.job.Name }
+
+// jobDelegate renders job list items
+type jobDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d jobDelegate) Height() int                             { return 1 }
+func (d jobDelegate) Spacing() int                            { return 0 }
+func (d jobDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d jobDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(jobItem)
+	if !ok {
+		return
+	}
+
+	job := item.job
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-40s", truncateString(job.Name, 40))
+	statusPadded := fmt.Sprintf("%-10s", job.Status)
+	completionsPadded := fmt.Sprintf("%-12s", job.Completions)
+	failedPadded := fmt.Sprintf("%-7d", job.Failed)
+	durationPadded := fmt.Sprintf("%-10s", job.Duration)
+	agePadded := job.Age
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(job.Namespace, 20))) + " "
+	}
+
+	// Apply colors after padding
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	statusStyled := jobStatusStyle(job.Status).Render(statusPadded)
+	completionsStyled := mutedStyle.Render(completionsPadded)
+	failedStyled := mutedStyle.Render(failedPadded)
+	if job.Failed > 0 {
+		failedStyled = lipgloss.NewStyle().Foreground(colorError).Render(failedPadded)
+	}
+	durationStyled := mutedStyle.Render(durationPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s",
+			prefix, nsColumn+nameStyle.Render(namePadded), statusStyled, completionsStyled, failedStyled, durationStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s",
+			nsColumn+nameStyle.Render(namePadded), statusStyled, completionsStyled, failedStyled, durationStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// jobStatusStyle colors a job status
+func jobStatusStyle(status string) lipgloss.Style {
+	switch status {
+	case domain.JobStatusComplete:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	case domain.JobStatusFailed:
+		return lipgloss.NewStyle().Foreground(colorError)
+	case domain.JobStatusRunning:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	default:
+		return lipgloss.NewStyle().Foreground(colorMuted)
+	}
+}
+
+// newJobList creates a list model for jobs. showNamespace adds a NAMESPACE
+// column for lists spanning all namespaces.
+func newJobList(jobs []domain.Job, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(jobs))
+	for i, job := range jobs {
+		items[i] = jobItem{job: job}
+	}
+
+	delegate := jobDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateJobList updates the job list items while preserving selection
+func updateJobList(l *list.Model, jobs []domain.Job) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(jobItem); ok {
+		currentName = item.job.Name
+		currentNamespace = item.job.Namespace
+	}
+
+	items := make([]list.Item, len(jobs))
+	newIndex := 0
+	for i, job := range jobs {
+		items[i] = jobItem{job: job}
+		if job.Name == currentName && job.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// journalSince is a time range of the journal view
+type journalSince struct {
+	label string
+	since string // journalctl --since value
+	boot  bool
+}
+
+// journalSinceOptions are cycled with "s"; the first one shows the most recent entries
+var journalSinceOptions = []journalSince{
+	{label: "last 500"},
+	{label: "15m", since: "-15min"},
+	{label: "1h", since: "-1h"},
+	{label: "6h", since: "-6h"},
+	{label: "24h", since: "-24h"},
+	{label: "this boot", boot: true},
+}
+
+const (
+	// journalTailLines is how many entries are shown without a time range
+	journalTailLines = 500
+	// journalMaxLines caps the entries fetched for a time range and kept while following
+	journalMaxLines = 10000
+)
+
+// journalPriority is a priority filter of the journal view
+type journalPriority struct {
+	label string
+	max   int // highest priority number shown
+}
+
+// journalPriorities are cycled with "p"
+var journalPriorities = []journalPriority{
+	{label: "all", max: ssh.PriorityDebug},
+	{label: "warning+", max: ssh.PriorityWarning},
+	{label: "error+", max: ssh.PriorityErr},
+}
+
+// JournalViewer shows the journal of the k3s service of a node. Scrolling,
+// search highlighting and follow mode come from CrictlLogViewer.
+type JournalViewer struct {
+	CrictlLogViewer
+	unit     string
+	entries  []ssh.JournalEntry
+	since    int // index into journalSinceOptions
+	priority int // index into journalPriorities
+}
+
+// NewJournalViewer creates a new journal viewer
+func NewJournalViewer(styles Styles) JournalViewer {
+	return JournalViewer{
+		CrictlLogViewer: NewCrictlLogViewer(styles),
+	}
+}
+
+// SetNode starts a journal view of a node; the unit is detected when fetching
+func (j *JournalViewer) SetNode(nodeName string) {
+	j.SetContainer("", "", nodeName)
+	j.unit = ""
+	j.entries = nil
+}
+
+// Unit returns the k3s unit, or an empty string until it is detected
+func (j *JournalViewer) Unit() string {
+	return j.unit
+}
+
+// SetUnit sets the k3s unit shown
+func (j *JournalViewer) SetUnit(unit string) {
+	j.unit = unit
+}
+
+// Options returns the journal options of the current time range
+func (j *JournalViewer) Options() ssh.JournalOptions {
+	s := journalSinceOptions[j.since]
+	opts := ssh.JournalOptions{Unit: j.unit, Since: s.since, Boot: s.boot, Lines: journalMaxLines}
+	if s.since == "" && !s.boot {
+		opts.Lines = journalTailLines
+	}
+	return opts
+}
+
+// CycleSince switches to the next time range; the journal has to be fetched again
+func (j *JournalViewer) CycleSince() {
+	j.since = (j.since + 1) % len(journalSinceOptions)
+}
+
+// CyclePriority switches to the next priority filter
+func (j *JournalViewer) CyclePriority() {
+	j.priority = (j.priority + 1) % len(journalPriorities)
+	j.SetEntries(j.entries)
+}
+
+// SetEntries replaces the entries shown
+func (j *JournalViewer) SetEntries(entries []ssh.JournalEntry) {
+	j.entries = entries
+	lines := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if j.shows(e) {
+			lines = append(lines, e.String())
+		}
+	}
+	if len(lines) == 0 {
+		j.logs = ""
+		j.logLines = nil
+		j.updateContent()
+		return
+	}
+	j.SetLogs(strings.Join(lines, "\n"))
+}
+
+// AppendEntry adds a streamed entry. Beyond journalMaxLines the oldest entry
+// is dropped, so a long follow does not grow without bound.
+func (j *JournalViewer) AppendEntry(e ssh.JournalEntry) {
+	if len(j.entries) >= journalMaxLines {
+		oldest := j.entries[0]
+		j.entries = j.entries[1:]
+		if j.shows(oldest) && len(j.logLines) > 0 {
+			j.logLines = j.logLines[1:]
+			if i := strings.IndexByte(j.logs, '\n'); i >= 0 {
+				j.logs = j.logs[i+1:]
+			} else {
+				j.logs = ""
+			}
+			if !j.shows(e) {
+				j.updateContent()
+			}
+		}
+	}
+
+	j.entries = append(j.entries, e)
+	if j.shows(e) {
+		j.AppendLog(e.String())
+	}
+}
+
+// shows reports whether e passes the priority filter
+func (j *JournalViewer) shows(e ssh.JournalEntry) bool {
+	return e.Priority <= journalPriorities[j.priority].max
+}
+
+// Clear clears the journal viewer
+func (j *JournalViewer) Clear() {
+	j.CrictlLogViewer.Clear()
+	j.unit = ""
+	j.entries = nil
+}
+
+// Update handles messages
+func (j JournalViewer) Update(msg tea.Msg) (JournalViewer, tea.Cmd) {
+	var cmd tea.Cmd
+	j.CrictlLogViewer, cmd = j.CrictlLogViewer.Update(msg)
+	return j, cmd
+}
+
+// RenderHeader renders the journal viewer header
+func (j *JournalViewer) RenderHeader() string {
+	var parts []string
+
+	nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary)
+	unit := j.unit
+	if unit == "" {
+		unit = "k3s"
+	}
+	parts = append(parts, nameStyle.Render(fmt.Sprintf("Journal: %s", unit)))
+
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+	if j.nodeName != "" {
+		parts = append(parts, infoStyle.Render(fmt.Sprintf("Node: %s", j.nodeName)))
+	}
+	parts = append(parts, infoStyle.Render(fmt.Sprintf("Since: %s", journalSinceOptions[j.since].label)))
+	parts = append(parts, infoStyle.Render(fmt.Sprintf("Priority: %s", journalPriorities[j.priority].label)))
+
+	if j.following {
+		indicator := lipgloss.NewStyle().Foreground(colorSuccess).Render("◉")
+		label := lipgloss.NewStyle().Foreground(colorText).Render("Following")
+		parts = append(parts, indicator+" "+label)
+	}
+
+	if j.searchQuery != "" {
+		searchStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		parts = append(parts, searchStyle.Render(fmt.Sprintf("Search: '%s' (%d)", j.searchQuery, j.matchCount)))
+	}
+
+	return strings.Join(parts, "  ")
+}
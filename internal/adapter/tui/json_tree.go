@@ -0,0 +1,465 @@
+package tui
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// jsonNodeKind is the JSON type of a tree node
+type jsonNodeKind int
+
+const (
+	jsonString jsonNodeKind = iota
+	jsonNumber
+	jsonBool
+	jsonNull
+	jsonObject
+	jsonArray
+)
+
+// jsonNode is a value in a JSON tree; objects and arrays can be collapsed
+type jsonNode struct {
+	key      string // object key; empty for array elements and the root
+	index    int    // position in the parent array, -1 otherwise
+	kind     jsonNodeKind
+	value    string // rendered scalar
+	children []*jsonNode
+	parent   *jsonNode
+	depth    int
+	expanded bool
+}
+
+func (n *jsonNode) isContainer() bool {
+	return n.kind == jsonObject || n.kind == jsonArray
+}
+
+// JSONTree shows a JSON document as a collapsible tree. The keys of the
+// document are kept in their original order.
+type JSONTree struct {
+	title  string
+	raw    string
+	root   *jsonNode
+	rows   []*jsonNode // visible nodes in display order
+	cursor int
+	offset int
+	width  int
+	height int
+}
+
+// NewJSONTree creates a new JSON tree
+func NewJSONTree() JSONTree {
+	return JSONTree{}
+}
+
+// SetJSON parses content and shows it with the first level expanded
+func (t *JSONTree) SetJSON(title, content string) error {
+	dec := json.NewDecoder(strings.NewReader(content))
+	dec.UseNumber()
+	root, err := parseJSONNode(dec, nil, "", -1, -1)
+	if err != nil {
+		return fmt.Errorf("parse JSON: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("parse JSON: unexpected data after the document")
+	}
+
+	t.title = title
+	t.raw = content
+	t.root = root
+	t.root.expanded = true
+	for _, child := range root.children {
+		child.expanded = true
+	}
+	t.cursor = 0
+	t.offset = 0
+	t.updateRows()
+	return nil
+}
+
+// Clear removes the document
+func (t *JSONTree) Clear() {
+	t.title = ""
+	t.raw = ""
+	t.root = nil
+	t.rows = nil
+	t.cursor = 0
+	t.offset = 0
+}
+
+// Content returns the raw JSON
+func (t *JSONTree) Content() string {
+	return t.raw
+}
+
+// SetSize sets the visible size
+func (t *JSONTree) SetSize(width, height int) {
+	t.width = width
+	t.height = height
+	t.clampOffset()
+}
+
+// parseJSONNode reads the next value from dec
+func parseJSONNode(dec *json.Decoder, parent *jsonNode, key string, index, depth int) (*jsonNode, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	node := &jsonNode{key: key, index: index, parent: parent, depth: depth}
+	switch v := tok.(type) {
+	case json.Delim:
+		switch v {
+		case '{':
+			node.kind = jsonObject
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				child, err := parseJSONNode(dec, node, fmt.Sprint(keyTok), -1, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				node.children = append(node.children, child)
+			}
+		case '[':
+			node.kind = jsonArray
+			for i := 0; dec.More(); i++ {
+				child, err := parseJSONNode(dec, node, "", i, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				node.children = append(node.children, child)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q", v)
+		}
+		// Closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+	case string:
+		node.kind = jsonString
+		node.value = strconv.Quote(v)
+	case json.Number:
+		node.kind = jsonNumber
+		node.value = v.String()
+	case bool:
+		node.kind = jsonBool
+		node.value = strconv.FormatBool(v)
+	case nil:
+		node.kind = jsonNull
+		node.value = "null"
+	}
+	return node, nil
+}
+
+// updateRows rebuilds the visible rows, keeping the cursor on the same node
+func (t *JSONTree) updateRows() {
+	var selected *jsonNode
+	if t.cursor < len(t.rows) {
+		selected = t.rows[t.cursor]
+	}
+
+	t.rows = t.rows[:0]
+	if t.root != nil {
+		if t.root.isContainer() {
+			t.appendRows(t.root.children)
+		} else {
+			t.rows = append(t.rows, t.root)
+		}
+	}
+
+	t.cursor = 0
+	for i, row := range t.rows {
+		if row == selected {
+			t.cursor = i
+			break
+		}
+	}
+	t.clampOffset()
+}
+
+func (t *JSONTree) appendRows(nodes []*jsonNode) {
+	for _, node := range nodes {
+		t.rows = append(t.rows, node)
+		if node.expanded {
+			t.appendRows(node.children)
+		}
+	}
+}
+
+// setExpanded expands or collapses node and all of its descendants
+func setExpanded(node *jsonNode, expanded bool) {
+	if node.isContainer() {
+		node.expanded = expanded
+	}
+	for _, child := range node.children {
+		setExpanded(child, expanded)
+	}
+}
+
+// ExpandAll expands every node
+func (t *JSONTree) ExpandAll() {
+	if t.root == nil {
+		return
+	}
+	setExpanded(t.root, true)
+	t.updateRows()
+}
+
+// CollapseAll collapses every node, leaving only the first level visible
+func (t *JSONTree) CollapseAll() {
+	if t.root == nil {
+		return
+	}
+	setExpanded(t.root, false)
+	t.root.expanded = true
+	t.updateRows()
+}
+
+// toggle expands or collapses the node under the cursor
+func (t *JSONTree) toggle() {
+	if t.cursor >= len(t.rows) {
+		return
+	}
+	node := t.rows[t.cursor]
+	if node.isContainer() && len(node.children) > 0 {
+		node.expanded = !node.expanded
+		t.updateRows()
+	}
+}
+
+// expand opens the node under the cursor, or moves into it when already open
+func (t *JSONTree) expand() {
+	if t.cursor >= len(t.rows) {
+		return
+	}
+	node := t.rows[t.cursor]
+	if !node.isContainer() || len(node.children) == 0 {
+		return
+	}
+	if !node.expanded {
+		node.expanded = true
+		t.updateRows()
+		return
+	}
+	t.moveCursor(1)
+}
+
+// collapse closes the node under the cursor, or moves to its parent
+func (t *JSONTree) collapse() {
+	if t.cursor >= len(t.rows) {
+		return
+	}
+	node := t.rows[t.cursor]
+	if node.isContainer() && node.expanded {
+		node.expanded = false
+		t.updateRows()
+		return
+	}
+	if node.parent == nil || node.parent == t.root {
+		return
+	}
+	for i, row := range t.rows {
+		if row == node.parent {
+			t.cursor = i
+			t.clampOffset()
+			return
+		}
+	}
+}
+
+func (t *JSONTree) moveCursor(delta int) {
+	t.cursor += delta
+	if t.cursor >= len(t.rows) {
+		t.cursor = len(t.rows) - 1
+	}
+	if t.cursor < 0 {
+		t.cursor = 0
+	}
+	t.clampOffset()
+}
+
+// clampOffset scrolls so the cursor stays visible
+func (t *JSONTree) clampOffset() {
+	if t.height <= 0 {
+		return
+	}
+	if t.cursor < t.offset {
+		t.offset = t.cursor
+	}
+	if t.cursor >= t.offset+t.height {
+		t.offset = t.cursor - t.height + 1
+	}
+	if maxOffset := len(t.rows) - t.height; t.offset > maxOffset {
+		t.offset = maxOffset
+	}
+	if t.offset < 0 {
+		t.offset = 0
+	}
+}
+
+// Update handles key messages
+func (t JSONTree) Update(msg tea.Msg) (JSONTree, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return t, nil
+	}
+
+	switch keyMsg.String() {
+	case "up", "k":
+		t.moveCursor(-1)
+	case "down", "j":
+		t.moveCursor(1)
+	case "pgup", "ctrl+u":
+		t.moveCursor(-t.height)
+	case "pgdown", "ctrl+d":
+		t.moveCursor(t.height)
+	case "g", "home":
+		t.moveCursor(-len(t.rows))
+	case "G", "end":
+		t.moveCursor(len(t.rows))
+	case "enter", " ":
+		t.toggle()
+	case "right", "l":
+		t.expand()
+	case "left", "h":
+		t.collapse()
+	case "e":
+		t.ExpandAll()
+	case "c":
+		t.CollapseAll()
+	}
+	return t, nil
+}
+
+// View renders the visible part of the tree
+func (t JSONTree) View() string {
+	if t.root == nil {
+		return "No content"
+	}
+
+	end := t.offset + t.height
+	if end > len(t.rows) || t.height <= 0 {
+		end = len(t.rows)
+	}
+
+	var sb strings.Builder
+	for i := t.offset; i < end; i++ {
+		if i > t.offset {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(t.renderRow(t.rows[i], i == t.cursor))
+	}
+	return sb.String()
+}
+
+// renderRow renders a node as "▾ key {n}" or "  key: value"
+func (t JSONTree) renderRow(node *jsonNode, selected bool) string {
+	keyStyle := lipgloss.NewStyle().Foreground(colorAccent)
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	indent := strings.Repeat("  ", node.depth)
+	marker := "  "
+	if node.isContainer() && len(node.children) > 0 {
+		marker = "▸ "
+		if node.expanded {
+			marker = "▾ "
+		}
+	}
+
+	// Array elements are labelled by their index
+	label := keyStyle.Render(node.key)
+	plainLabel := node.key
+	if node.index >= 0 {
+		plainLabel = fmt.Sprintf("[%d]", node.index)
+		label = mutedStyle.Render(plainLabel)
+	}
+
+	var line string
+	switch node.kind {
+	case jsonObject:
+		line = label + " " + mutedStyle.Render(containerSummary("{", "}", len(node.children), node.expanded))
+	case jsonArray:
+		line = label + " " + mutedStyle.Render(containerSummary("[", "]", len(node.children), node.expanded))
+	default:
+		value := node.value
+		if t.width > 0 {
+			value = truncateRunes(value, t.width-lipgloss.Width(indent+marker+plainLabel+": "))
+		}
+		line = label + mutedStyle.Render(":") + " " + jsonValueStyle(node.kind).Render(value)
+	}
+
+	line = indent + mutedStyle.Render(marker) + line
+	if selected {
+		width := t.width
+		if w := lipgloss.Width(line); w > width {
+			width = w
+		}
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Width(width).Render(line)
+	}
+	return line
+}
+
+// containerSummary describes an object or array, e.g. "{…} 3 keys"
+func containerSummary(open, close string, count int, expanded bool) string {
+	if count == 0 {
+		return open + close
+	}
+	noun := "keys"
+	if open == "[" {
+		noun = "items"
+	}
+	if count == 1 {
+		noun = strings.TrimSuffix(noun, "s")
+	}
+	if expanded {
+		return fmt.Sprintf("%s%d %s%s", open, count, noun, close)
+	}
+	return fmt.Sprintf("%s…%s %d %s", open, close, count, noun)
+}
+
+// jsonValueStyle colors a scalar by its type
+func jsonValueStyle(kind jsonNodeKind) lipgloss.Style {
+	switch kind {
+	case jsonString:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	case jsonNumber, jsonBool:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	default:
+		return lipgloss.NewStyle().Foreground(colorMuted)
+	}
+}
+
+// truncateRunes shortens s to at most width runes, ending with "…"
+func truncateRunes(s string, width int) string {
+	if width < 1 {
+		width = 1
+	}
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+// RenderHeader returns the tree header
+func (t *JSONTree) RenderHeader() string {
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary)
+	header := titleStyle.Render(t.title)
+
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+	if len(t.rows) > 0 {
+		header += "  " + infoStyle.Render(fmt.Sprintf("%d/%d", t.cursor+1, len(t.rows)))
+	}
+	return header
+}
@@ -35,6 +35,9 @@ func (d kubeConfigDelegate) Render(w io.Writer, m list.Model, index int, listIte
 
 	name := item.kubeConfig.Name
 	path := item.kubeConfig.Path
+	if item.kubeConfig.Context != "" {
+		path = fmt.Sprintf("%s @ %s", path, item.kubeConfig.Context)
+	}
 
 	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
 	bgStyle := lipgloss.NewStyle().Background(colorBgHighlight)
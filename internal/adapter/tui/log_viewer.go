@@ -98,6 +98,11 @@ func (l *LogViewer) PodName() string {
 	return l.podName
 }
 
+// Namespace returns the namespace of the current pod
+func (l *LogViewer) Namespace() string {
+	return l.namespace
+}
+
 // SetSize sets the viewport size
 func (l *LogViewer) SetSize(width, height int) {
 	l.width = width
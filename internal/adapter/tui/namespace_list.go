@@ -90,11 +90,34 @@ func newNamespaceList(namespaces []domain.Namespace, width, height int, styles S
 	return l
 }
 
-// updateNamespaceList updates the namespace list items
-func updateNamespaceList(l *list.Model, namespaces []domain.Namespace) {
+// updateNamespaceList updates the namespace list items while preserving selection
+func updateNamespaceList(l *list.Model, namespaces []domain.Namespace) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName string
+	if item, ok := l.SelectedItem().(namespaceItem); ok {
+		currentName = item.namespace.Name
+	}
+
 	items := make([]list.Item, len(namespaces))
+	newIndex := -1
 	for i, ns := range namespaces {
 		items[i] = namespaceItem{namespace: ns}
+		if ns.Name == currentName {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if newIndex >= 0 {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
 	}
-	l.SetItems(items)
+	return cmd
 }
@@ -0,0 +1,278 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// NodeDetailsModel is the model for node details view
+type NodeDetailsModel struct {
+	node     *domain.Node
+	sshHost  *domain.SSHHost // configured SSH host of the node, if any
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewNodeDetailsModel creates a new node details model
+func NewNodeDetailsModel(styles Styles) NodeDetailsModel {
+	return NodeDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetNode sets the node to display along with its SSH host, keeping the
+// scroll position when the same node is reloaded
+func (m *NodeDetailsModel) SetNode(node *domain.Node, sshHost *domain.SSHHost) {
+	same := m.node != nil && node != nil && m.node.Name == node.Name
+	m.node = node
+	m.sshHost = sshHost
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		if !same {
+			m.viewport.GotoTop()
+		}
+	}
+}
+
+// SetSize sets the viewport size
+func (m *NodeDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.node != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m NodeDetailsModel) Update(msg tea.Msg) (NodeDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the node details
+func (m NodeDetailsModel) View() string {
+	if !m.ready || m.node == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *NodeDetailsModel) renderContent() string {
+	if m.node == nil {
+		return "No node selected"
+	}
+
+	var sb strings.Builder
+	node := m.node
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(18)
+
+	valueStyle := lipgloss.NewStyle()
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(node.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), nodeStatusStyle(*node).Bold(true).Render(nodeStatusText(*node))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Roles:"), valueStyle.Render(nodeRolesText(*node))))
+	if node.InternalIP != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Internal IP:"), valueStyle.Render(node.InternalIP)))
+	}
+	if node.ExternalIP != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("External IP:"), valueStyle.Render(node.ExternalIP)))
+	}
+	if node.Hostname != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Hostname:"), valueStyle.Render(node.Hostname)))
+	}
+	if m.sshHost != nil {
+		sb.WriteString(fmt.Sprintf("%s %s %s\n", labelStyle.Render("SSH host:"),
+			lipgloss.NewStyle().Foreground(colorSecondary).Render(m.sshHost.Name),
+			mutedStyle.Render(fmt.Sprintf("(%s@%s)", m.sshHost.User, m.sshHost.Host))))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(node.Age)))
+
+	// === System Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("SYSTEM"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Kubelet:"), valueStyle.Render(node.KubeletVersion)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Runtime:"), valueStyle.Render(node.ContainerRuntime)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("OS image:"), valueStyle.Render(node.OSImage)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Kernel:"), valueStyle.Render(node.KernelVersion)))
+
+	// === Allocation Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("ALLOCATED RESOURCES"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("CPU requests:"),
+		renderUsageBar(node.CPU.Percent(), 20)+" "+formatNodeResource(node.CPU, formatMilliCPU)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("CPU limits:"),
+		mutedStyle.Render(formatMilliCPU(node.CPU.Limits))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Memory requests:"),
+		renderUsageBar(node.Memory.Percent(), 20)+" "+formatNodeResource(node.Memory, formatBytes)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Memory limits:"),
+		mutedStyle.Render(formatBytes(node.Memory.Limits))))
+	sb.WriteString(fmt.Sprintf("%s %d/%d\n", labelStyle.Render("Pods:"), node.PodCount, node.PodCapacity))
+
+	// === Conditions Section ===
+	if len(node.Conditions) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("CONDITIONS"))
+		sb.WriteString("\n")
+
+		condHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-20s %-8s %-28s %s", "TYPE", "STATUS", "REASON", "AGE"))
+		sb.WriteString(condHeader)
+		sb.WriteString("\n")
+
+		for _, cond := range node.Conditions {
+			// Ready is the only condition that should be true
+			healthy := cond.Status == "False"
+			if cond.Type == domain.NodeConditionReady {
+				healthy = cond.Status == "True"
+			}
+			statusColor := lipgloss.NewStyle().Foreground(colorSuccess)
+			if !healthy {
+				statusColor = lipgloss.NewStyle().Foreground(colorError)
+			}
+
+			sb.WriteString(fmt.Sprintf("  %-20s %s %-28s %s\n",
+				truncateString(cond.Type, 20),
+				statusColor.Render(fmt.Sprintf("%-8s", cond.Status)),
+				truncateString(cond.Reason, 28),
+				cond.LastTransition))
+
+			if !healthy && cond.Message != "" {
+				sb.WriteString(fmt.Sprintf("    %s\n", truncateString(cond.Message, m.width-10)))
+			}
+		}
+	}
+
+	// === Taints Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("TAINTS (%d)", len(node.Taints))))
+	sb.WriteString("\n")
+	if len(node.Taints) == 0 {
+		sb.WriteString(mutedStyle.Render("  <none>"))
+		sb.WriteString("\n")
+	} else {
+		for _, t := range node.Taints {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncateString(t.String(), m.width-6)))
+		}
+	}
+
+	// === Pods Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("PODS (%d)", len(node.Pods))))
+	sb.WriteString("\n")
+	if len(node.Pods) == 0 {
+		sb.WriteString(mutedStyle.Render("  No pods scheduled"))
+		sb.WriteString("\n")
+	} else {
+		podHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-20s %-40s %-18s %-8s %-10s %-12s %s", "NAMESPACE", "NAME", "STATUS", "CPU", "MEMORY", "CONTROLLER", "AGE"))
+		sb.WriteString(podHeader)
+		sb.WriteString("\n")
+
+		for _, pod := range node.Pods {
+			var statusColor lipgloss.Style
+			switch pod.Status {
+			case "Running", "Succeeded", "Completed":
+				statusColor = lipgloss.NewStyle().Foreground(colorSuccess)
+			case "Pending", "ContainerCreating", "Terminating":
+				statusColor = lipgloss.NewStyle().Foreground(colorWarning)
+			default:
+				statusColor = lipgloss.NewStyle().Foreground(colorError)
+			}
+
+			controller := pod.Controller
+			switch {
+			case pod.MirrorPod:
+				controller = "static"
+			case controller == "":
+				controller = "<none>"
+			}
+
+			sb.WriteString(fmt.Sprintf("  %-20s %-40s %s %-8s %-10s %-12s %s\n",
+				truncateString(pod.Namespace, 20),
+				truncateString(pod.Name, 40),
+				statusColor.Render(fmt.Sprintf("%-18s", truncateString(pod.Status, 18))),
+				formatMilliCPU(pod.CPU),
+				formatBytes(pod.Memory),
+				truncateString(controller, 12),
+				pod.Age))
+		}
+	}
+
+	// === Labels Section ===
+	if len(node.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(node.Labels))
+		for k := range node.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := node.Labels[k]
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 40), truncateString(v, 40)))
+		}
+	}
+
+	return sb.String()
+}
+
+// renderUsageBar renders a bar for a percentage, colored like the node list
+func renderUsageBar(percent, width int) string {
+	filled := percent * width / 100
+	if filled > width {
+		filled = width
+	}
+	return resourceUsageStyle(percent).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(colorDim).Render(strings.Repeat("░", width-filled))
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *NodeDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// Node returns the current node
+func (m *NodeDetailsModel) Node() *domain.Node {
+	return m.node
+}
+
+// SSHHost returns the SSH host of the current node
+func (m *NodeDetailsModel) SSHHost() *domain.SSHHost {
+	return m.sshHost
+}
@@ -0,0 +1,216 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// nodeItem implements list.Item for nodes
+type nodeItem struct {
+	node domain.Node
+}
+
+func (i nodeItem) FilterValue() string { return i.node.Name }
+
+// nodeDelegate renders node list items
+type nodeDelegate struct {
+	styles Styles
+}
+
+func (d nodeDelegate) Height() int                             { return 1 }
+func (d nodeDelegate) Spacing() int                            { return 0 }
+func (d nodeDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d nodeDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(nodeItem)
+	if !ok {
+		return
+	}
+
+	node := item.node
+
+	pressure := strings.Join(shortPressure(node.Pressure()), ",")
+	if pressure == "" {
+		pressure = "-"
+	}
+	taints := "-"
+	if len(node.Taints) > 0 {
+		taints = fmt.Sprintf("%d", len(node.Taints))
+	}
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-28s", truncateString(node.Name, 28))
+	statusPadded := fmt.Sprintf("%-26s", nodeStatusText(node))
+	rolesPadded := fmt.Sprintf("%-16s", truncateString(nodeRolesText(node), 16))
+	versionPadded := fmt.Sprintf("%-14s", truncateString(node.KubeletVersion, 14))
+	cpuPadded := fmt.Sprintf("%-18s", formatNodeResource(node.CPU, formatMilliCPU))
+	memoryPadded := fmt.Sprintf("%-22s", formatNodeResource(node.Memory, formatBytes))
+	podsPadded := fmt.Sprintf("%-8s", fmt.Sprintf("%d/%d", node.PodCount, node.PodCapacity))
+	pressurePadded := fmt.Sprintf("%-10s", truncateString(pressure, 10))
+	taintsPadded := fmt.Sprintf("%-7s", taints)
+	agePadded := node.Age
+
+	// Apply colors after padding
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	statusStyled := nodeStatusStyle(node).Render(statusPadded)
+	rolesStyled := mutedStyle.Render(rolesPadded)
+	versionStyled := mutedStyle.Render(versionPadded)
+	cpuStyled := resourceUsageStyle(node.CPU.Percent()).Render(cpuPadded)
+	memoryStyled := resourceUsageStyle(node.Memory.Percent()).Render(memoryPadded)
+	podsStyled := mutedStyle.Render(podsPadded)
+	pressureStyled := mutedStyle.Render(pressurePadded)
+	if pressure != "-" {
+		pressureStyled = lipgloss.NewStyle().Foreground(colorError).Render(pressurePadded)
+	}
+	taintsStyled := mutedStyle.Render(taintsPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s %s %s %s %s",
+			prefix, nameStyle.Render(namePadded), statusStyled, rolesStyled, versionStyled, cpuStyled, memoryStyled, podsStyled, pressureStyled, taintsStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s %s %s %s %s",
+			nameStyle.Render(namePadded), statusStyled, rolesStyled, versionStyled, cpuStyled, memoryStyled, podsStyled, pressureStyled, taintsStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newNodeList creates a list model for nodes
+func newNodeList(nodes []domain.Node, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(nodes))
+	for i, n := range nodes {
+		items[i] = nodeItem{node: n}
+	}
+
+	delegate := nodeDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateNodeList updates the node list items while preserving selection
+func updateNodeList(l *list.Model, nodes []domain.Node) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName string
+	if item, ok := l.SelectedItem().(nodeItem); ok {
+		currentName = item.node.Name
+	}
+
+	items := make([]list.Item, len(nodes))
+	newIndex := 0
+	for i, n := range nodes {
+		items[i] = nodeItem{node: n}
+		if n.Name == currentName {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
+
+// nodeStatusText returns the status like kubectl, e.g. "Ready,SchedulingDisabled"
+func nodeStatusText(node domain.Node) string {
+	if node.Unschedulable {
+		return node.Status + ",SchedulingDisabled"
+	}
+	return node.Status
+}
+
+// nodeRolesText returns the node roles, or "<none>" for plain workers
+func nodeRolesText(node domain.Node) string {
+	if len(node.Roles) == 0 {
+		return "<none>"
+	}
+	return strings.Join(node.Roles, ",")
+}
+
+func nodeStatusStyle(node domain.Node) lipgloss.Style {
+	switch {
+	case node.Status != "Ready":
+		return lipgloss.NewStyle().Foreground(colorError)
+	case node.Unschedulable:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	default:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	}
+}
+
+// resourceUsageStyle colors a requested/allocatable percentage
+func resourceUsageStyle(percent int) lipgloss.Style {
+	switch {
+	case percent >= 90:
+		return lipgloss.NewStyle().Foreground(colorError)
+	case percent >= 70:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	default:
+		return lipgloss.NewStyle().Foreground(colorText)
+	}
+}
+
+// formatNodeResource formats requested vs allocatable, e.g. "750m/2 (37%)"
+func formatNodeResource(r domain.NodeResource, format func(int64) string) string {
+	return fmt.Sprintf("%s/%s (%d%%)", format(r.Requested), format(r.Allocatable), r.Percent())
+}
+
+// formatMilliCPU formats CPU millicores, using whole cores where possible
+func formatMilliCPU(milliCores int64) string {
+	if milliCores < 1000 || milliCores%100 != 0 {
+		return fmt.Sprintf("%dm", milliCores)
+	}
+	if milliCores%1000 == 0 {
+		return fmt.Sprintf("%d", milliCores/1000)
+	}
+	return fmt.Sprintf("%.1f", float64(milliCores)/1000)
+}
+
+// shortPressure abbreviates pressure conditions for the list, e.g. "Mem,Disk"
+func shortPressure(conditions []string) []string {
+	short := make([]string, 0, len(conditions))
+	for _, c := range conditions {
+		switch c {
+		case domain.NodeConditionMemoryPressure:
+			short = append(short, "Mem")
+		case domain.NodeConditionDiskPressure:
+			short = append(short, "Disk")
+		case domain.NodeConditionPIDPressure:
+			short = append(short, "PID")
+		case domain.NodeConditionNetworkUnavailable:
+			short = append(short, "Net")
+		default:
+			short = append(short, c)
+		}
+	}
+	return short
+}
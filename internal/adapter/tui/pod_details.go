@@ -185,6 +185,31 @@ func (m *PodDetailsModel) renderContent() string {
 		}
 	}
 
+	// === Volumes Section ===
+	if len(pod.Volumes) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("VOLUMES (%d)", len(pod.Volumes))))
+		sb.WriteString("\n")
+
+		volHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-25s %-22s %s", "NAME", "TYPE", "SOURCE"))
+		sb.WriteString(volHeader)
+		sb.WriteString("\n")
+
+		for _, v := range pod.Volumes {
+			source := truncateString(v.Source, 50)
+			if v.ClaimPhase != "" {
+				source += " " + claimPhaseStyle(v.ClaimPhase).Render("("+v.ClaimPhase+")")
+			}
+			sb.WriteString(fmt.Sprintf("  %-25s %-22s %s\n",
+				truncateString(v.Name, 25),
+				v.Type,
+				source))
+		}
+	}
+
 	// === Conditions Section ===
 	if len(pod.Conditions) > 0 {
 		sb.WriteString("\n")
@@ -252,6 +277,11 @@ func (m *PodDetailsModel) renderContent() string {
 	return sb.String()
 }
 
+// Pod returns the pod being displayed
+func (m *PodDetailsModel) Pod() *domain.Pod {
+	return m.pod
+}
+
 // ScrollPercent returns the scroll percentage
 func (m *PodDetailsModel) ScrollPercent() float64 {
 	return m.viewport.ScrollPercent()
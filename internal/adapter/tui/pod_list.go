@@ -23,6 +23,7 @@ type podDelegate struct {
 	styles         Styles
 	metricsEnabled bool
 	metrics        map[string]domain.PodMetrics
+	showNamespace  bool
 }
 
 func (d podDelegate) Height() int                             { return 1 }
@@ -75,6 +76,12 @@ func (d podDelegate) Render(w io.Writer, m list.Model, index int, listItem list.
 	restartsPadded := fmt.Sprintf("%-8s", fmt.Sprintf("%d", pod.Restarts))
 	agePadded := pod.Age
 
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(pod.Namespace, 20))) + " "
+	}
+
 	// Apply colors after padding
 	statusStyled := statusStyle.Render(statusPadded)
 	restartsStyled := restartsStyle.Render(restartsPadded)
@@ -86,7 +93,7 @@ func (d podDelegate) Render(w io.Writer, m list.Model, index int, listItem list.
 		cpuStr := "-"
 		memStr := "-"
 		if d.metrics != nil {
-			if metrics, ok := d.metrics[pod.Name]; ok {
+			if metrics, ok := d.metrics[domain.PodMetricsKey(pod.Namespace, pod.Name)]; ok {
 				cpuStr = metrics.CPUUsage
 				memStr = metrics.MemoryUsage
 			}
@@ -105,28 +112,29 @@ func (d podDelegate) Render(w io.Writer, m list.Model, index int, listItem list.
 	if index == m.Index() {
 		if d.metricsEnabled {
 			line = fmt.Sprintf("%s %s %s %s %s %s %s %s",
-				prefix, nameStyle.Render(namePadded), readyPadded, statusStyled, restartsPadded, cpuStyled, memStyled, ageStyled)
+				prefix, nsColumn+nameStyle.Render(namePadded), readyPadded, statusStyled, restartsPadded, cpuStyled, memStyled, ageStyled)
 		} else {
 			line = fmt.Sprintf("%s %s %s %s %s %s",
-				prefix, nameStyle.Render(namePadded), readyPadded, statusStyled, restartsStyled, ageStyled)
+				prefix, nsColumn+nameStyle.Render(namePadded), readyPadded, statusStyled, restartsStyled, ageStyled)
 		}
 		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
 	} else {
 		nameNormal := lipgloss.NewStyle().Foreground(colorText)
 		if d.metricsEnabled {
 			line = fmt.Sprintf("  %s %s %s %s %s %s %s",
-				nameNormal.Render(namePadded), readyPadded, statusStyled, restartsPadded, cpuStyled, memStyled, ageStyled)
+				nsColumn+nameNormal.Render(namePadded), readyPadded, statusStyled, restartsPadded, cpuStyled, memStyled, ageStyled)
 		} else {
 			line = fmt.Sprintf("  %s %s %s %s %s",
-				nameNormal.Render(namePadded), readyPadded, statusStyled, restartsStyled, ageStyled)
+				nsColumn+nameNormal.Render(namePadded), readyPadded, statusStyled, restartsStyled, ageStyled)
 		}
 	}
 
 	fmt.Fprint(w, line)
 }
 
-// newPodList creates a list model for pods
-func newPodList(pods []domain.Pod, width, height int, styles Styles, metricsEnabled bool, metrics map[string]domain.PodMetrics) list.Model {
+// newPodList creates a list model for pods. showNamespace adds a NAMESPACE
+// column for lists spanning all namespaces.
+func newPodList(pods []domain.Pod, width, height int, styles Styles, metricsEnabled bool, metrics map[string]domain.PodMetrics, showNamespace bool) list.Model {
 	items := make([]list.Item, len(pods))
 	for i, pod := range pods {
 		items[i] = podItem{pod: pod}
@@ -136,6 +144,7 @@ func newPodList(pods []domain.Pod, width, height int, styles Styles, metricsEnab
 		styles:         styles,
 		metricsEnabled: metricsEnabled,
 		metrics:        metrics,
+		showNamespace:  showNamespace,
 	}
 	l := list.New(items, delegate, width, height)
 	l.SetShowTitle(false)      // We render our own title
@@ -149,24 +158,30 @@ func newPodList(pods []domain.Pod, width, height int, styles Styles, metricsEnab
 }
 
 // updatePodList updates the pod list items while preserving selection
-func updatePodList(l *list.Model, pods []domain.Pod) {
+func updatePodList(l *list.Model, pods []domain.Pod) tea.Cmd {
 	// Preserve current selection
 	currentIndex := l.Index()
-	var currentName string
+	var currentName, currentNamespace string
 	if item, ok := l.SelectedItem().(podItem); ok {
 		currentName = item.pod.Name
+		currentNamespace = item.pod.Namespace
 	}
 
 	items := make([]list.Item, len(pods))
 	newIndex := 0
 	for i, pod := range pods {
 		items[i] = podItem{pod: pod}
-		if pod.Name == currentName {
+		if pod.Name == currentName && pod.Namespace == currentNamespace {
 			newIndex = i
 		}
 	}
 
-	l.SetItems(items)
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
 
 	// Try to restore selection by name, otherwise by index
 	if currentName != "" {
@@ -174,6 +189,7 @@ func updatePodList(l *list.Model, pods []domain.Pod) {
 	} else if currentIndex < len(items) {
 		l.Select(currentIndex)
 	}
+	return cmd
 }
 
 func truncateString(s string, maxLen int) string {
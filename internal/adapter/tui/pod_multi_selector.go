@@ -15,7 +15,7 @@ type PodMultiSelector struct {
 	visible  bool
 	width    int
 	selected []string
-	allPods  []string // all pod names for expanding "All Pods"
+	allPods  []string // all pod labels for expanding "All Pods"
 	form     *huh.Form
 }
 
@@ -24,8 +24,17 @@ func NewPodMultiSelector() PodMultiSelector {
 	return PodMultiSelector{}
 }
 
+// podLabel identifies a pod in the multi-pod log view. Pods are prefixed with
+// their namespace when the list spans several namespaces.
+func podLabel(pod domain.Pod, showNamespace bool) string {
+	if showNamespace {
+		return pod.Namespace + "/" + pod.Name
+	}
+	return pod.Name
+}
+
 // Show displays the pod multi-selector and returns a tea.Cmd
-func (s *PodMultiSelector) Show(pods []domain.Pod) tea.Cmd {
+func (s *PodMultiSelector) Show(pods []domain.Pod, showNamespace bool) tea.Cmd {
 	s.visible = true
 	s.selected = nil
 
@@ -36,8 +45,9 @@ func (s *PodMultiSelector) Show(pods []domain.Pod) tea.Cmd {
 	opts = append(opts, huh.NewOption("* All Pods", allPodsValue))
 
 	for i, pod := range pods {
-		s.allPods[i] = pod.Name
-		opts = append(opts, huh.NewOption(pod.Name, pod.Name))
+		label := podLabel(pod, showNamespace)
+		s.allPods[i] = label
+		opts = append(opts, huh.NewOption(label, label))
 	}
 
 	s.form = huh.NewForm(
@@ -68,7 +78,7 @@ func (s *PodMultiSelector) SetWidth(width int) {
 	s.width = width
 }
 
-// SelectedPods returns the selected pod names, expanding "All Pods" if selected
+// SelectedPods returns the selected pod labels, expanding "All Pods" if selected
 func (s *PodMultiSelector) SelectedPods() []string {
 	for _, v := range s.selected {
 		if v == allPodsValue {
@@ -0,0 +1,191 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PortOption is a remote port offered in the port-forward dialog
+type PortOption struct {
+	Label string
+	Port  int32
+}
+
+// PortForwardDialog is a dialog for starting a port-forward to a pod or service
+type PortForwardDialog struct {
+	kind        string
+	namespace   string
+	name        string
+	remoteValue string
+	localValue  string
+	visible     bool
+	width       int
+	form        *huh.Form
+}
+
+// NewPortForwardDialog creates a new port-forward dialog
+func NewPortForwardDialog() PortForwardDialog {
+	return PortForwardDialog{}
+}
+
+// Show displays the dialog for the given target. When no ports are known the
+// remote port has to be typed in.
+func (d *PortForwardDialog) Show(kind, namespace, name string, ports []PortOption) tea.Cmd {
+	d.kind = kind
+	d.namespace = namespace
+	d.name = name
+	d.visible = true
+	d.remoteValue = ""
+	d.localValue = ""
+
+	validatePort := func(allowZero bool) func(string) error {
+		return func(s string) error {
+			if s == "" && allowZero {
+				return nil
+			}
+			val, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("invalid port")
+			}
+			if val < 0 || val > 65535 || (val == 0 && !allowZero) {
+				return fmt.Errorf("must be 1-65535")
+			}
+			return nil
+		}
+	}
+
+	var remoteField huh.Field
+	if len(ports) > 0 {
+		opts := make([]huh.Option[string], len(ports))
+		for i, p := range ports {
+			opts[i] = huh.NewOption(p.Label, strconv.Itoa(int(p.Port)))
+		}
+		d.remoteValue = opts[0].Value
+		remoteField = huh.NewSelect[string]().
+			Title(fmt.Sprintf("Port-forward %s", kind)).
+			Description(truncateString(name, 40)).
+			Options(opts...).
+			Value(&d.remoteValue)
+	} else {
+		remoteField = huh.NewInput().
+			Title(fmt.Sprintf("Port-forward %s", kind)).
+			Description(fmt.Sprintf("%s (no declared ports)", truncateString(name, 30))).
+			Placeholder("remote port").
+			Value(&d.remoteValue).
+			Validate(validatePort(false))
+	}
+
+	d.form = huh.NewForm(
+		huh.NewGroup(
+			remoteField,
+			huh.NewInput().
+				Title("Local port").
+				Description("Empty: same as remote · 0: random").
+				Value(&d.localValue).
+				Validate(validatePort(true)),
+		),
+	).WithTheme(K4sHuhTheme()).WithShowHelp(false)
+	return d.form.Init()
+}
+
+// Hide hides the dialog
+func (d *PortForwardDialog) Hide() {
+	d.visible = false
+	d.form = nil
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *PortForwardDialog) IsVisible() bool {
+	return d.visible
+}
+
+// SetWidth sets the dialog width
+func (d *PortForwardDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Kind returns the kind of the target (Pod or Service)
+func (d *PortForwardDialog) Kind() string {
+	return d.kind
+}
+
+// Namespace returns the namespace of the target
+func (d *PortForwardDialog) Namespace() string {
+	return d.namespace
+}
+
+// Name returns the name of the target
+func (d *PortForwardDialog) Name() string {
+	return d.name
+}
+
+// RemotePort returns the chosen remote port
+func (d *PortForwardDialog) RemotePort() int32 {
+	val, _ := strconv.Atoi(d.remoteValue)
+	return int32(val)
+}
+
+// LocalPort returns the chosen local port, defaulting to the remote port
+func (d *PortForwardDialog) LocalPort() int {
+	if d.localValue == "" {
+		return int(d.RemotePort())
+	}
+	val, _ := strconv.Atoi(d.localValue)
+	return val
+}
+
+// Update handles key messages for the dialog
+func (d *PortForwardDialog) Update(msg tea.Msg) (confirmed bool, cancelled bool, cmd tea.Cmd) {
+	if !d.visible || d.form == nil {
+		return false, false, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" {
+			return false, true, nil
+		}
+	}
+
+	model, formCmd := d.form.Update(msg)
+	if f, ok := model.(*huh.Form); ok {
+		d.form = f
+	}
+
+	if d.form.State == huh.StateCompleted {
+		return true, false, formCmd
+	}
+	if d.form.State == huh.StateAborted {
+		return false, true, formCmd
+	}
+
+	return false, false, formCmd
+}
+
+// View renders the dialog
+func (d *PortForwardDialog) View() string {
+	if !d.visible || d.form == nil {
+		return ""
+	}
+
+	dialogWidth := 50
+	if d.width > 0 && d.width < 60 {
+		dialogWidth = d.width - 10
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorBorder).
+		Padding(1, 2).
+		Width(dialogWidth)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	content := d.form.View() + "\n" + hintStyle.Render("Tab: next field • Enter: start • Esc: cancel")
+
+	return dialogStyle.Render(content)
+}
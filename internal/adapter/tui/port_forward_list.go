@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// portForwardItem implements list.Item for port-forwards
+type portForwardItem struct {
+	forward domain.PortForward
+}
+
+func (i portForwardItem) FilterValue() string { return i.forward.Target }
+
+// portForwardDelegate renders port-forward list items
+type portForwardDelegate struct {
+	styles Styles
+}
+
+func (d portForwardDelegate) Height() int                             { return 1 }
+func (d portForwardDelegate) Spacing() int                            { return 0 }
+func (d portForwardDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d portForwardDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(portForwardItem)
+	if !ok {
+		return
+	}
+
+	pf := item.forward
+
+	var statusStyle lipgloss.Style
+	switch pf.Status {
+	case domain.PortForwardStatusActive:
+		statusStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	case domain.PortForwardStatusError:
+		statusStyle = lipgloss.NewStyle().Foreground(colorError)
+	default:
+		statusStyle = lipgloss.NewStyle().Foreground(colorMuted)
+	}
+
+	target := fmt.Sprintf("%s/%s", pf.Kind, pf.Target)
+	local := fmt.Sprintf("127.0.0.1:%d", pf.LocalPort)
+	remote := fmt.Sprintf("%d", pf.RemotePort)
+	traffic := fmt.Sprintf("%s / %s", formatBytes(pf.BytesOut), formatBytes(pf.BytesIn))
+
+	// Pad plain text FIRST, then apply styling
+	localPadded := fmt.Sprintf("%-17s", local)
+	targetPadded := fmt.Sprintf("%-36s", truncateString(target, 36))
+	remotePadded := fmt.Sprintf("%-7s", remote)
+	statusPadded := fmt.Sprintf("%-8s", pf.Status)
+	trafficPadded := fmt.Sprintf("%-20s", traffic)
+	agePadded := fmt.Sprintf("%-6s", formatDuration(time.Since(pf.Started)))
+	errText := truncateString(pf.Error, 40)
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	statusStyled := statusStyle.Render(statusPadded)
+	remoteStyled := mutedStyle.Render(remotePadded)
+	trafficStyled := mutedStyle.Render(trafficPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+	errStyled := lipgloss.NewStyle().Foreground(colorError).Render(errText)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s %s",
+			prefix, nameStyle.Render(localPadded), nameStyle.Render(targetPadded), remoteStyled, statusStyled, trafficStyled, ageStyled, errStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s %s",
+			nameStyle.Render(localPadded), targetPadded, remoteStyled, statusStyled, trafficStyled, ageStyled, errStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newPortForwardList creates a list model for port-forwards
+func newPortForwardList(forwards []domain.PortForward, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(forwards))
+	for i, pf := range forwards {
+		items[i] = portForwardItem{forward: pf}
+	}
+
+	delegate := portForwardDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updatePortForwardList updates the port-forward list items while preserving selection
+func updatePortForwardList(l *list.Model, forwards []domain.PortForward) {
+	currentIndex := l.Index()
+	currentID := 0
+	if item, ok := l.SelectedItem().(portForwardItem); ok {
+		currentID = item.forward.ID
+	}
+
+	items := make([]list.Item, len(forwards))
+	newIndex := -1
+	for i, pf := range forwards {
+		items[i] = portForwardItem{forward: pf}
+		if pf.ID == currentID {
+			newIndex = i
+		}
+	}
+
+	l.SetItems(items)
+
+	if newIndex >= 0 {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+}
+
+// formatBytes formats a byte count in human readable units
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// formatDuration formats a duration in the same short style as resource ages
+func formatDuration(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
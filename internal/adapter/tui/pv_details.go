@@ -0,0 +1,155 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// PVDetailsModel is the model for the persistent volume details view
+type PVDetailsModel struct {
+	volume   *domain.PersistentVolume
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewPVDetailsModel creates a new persistent volume details model
+func NewPVDetailsModel(styles Styles) PVDetailsModel {
+	return PVDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetVolume sets the volume to display
+func (m *PVDetailsModel) SetVolume(volume *domain.PersistentVolume) {
+	m.volume = volume
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *PVDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.volume != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m PVDetailsModel) Update(msg tea.Msg) (PVDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the volume details
+func (m PVDetailsModel) View() string {
+	if !m.ready || m.volume == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *PVDetailsModel) renderContent() string {
+	if m.volume == nil {
+		return "No volume selected"
+	}
+
+	var sb strings.Builder
+	pv := m.volume
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+
+	status := volumePhaseStyle(pv.Phase).Bold(true).Render(pv.Phase)
+	if pv.Reason != "" {
+		status += " (" + pv.Reason + ")"
+	}
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(pv.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), status))
+	if pv.Orphaned != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Orphaned:"), lipgloss.NewStyle().Foreground(colorWarning).Render(pv.Orphaned)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Claim:"), valueStyle.Render(orNone(pv.Claim))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Capacity:"), valueStyle.Render(pv.Capacity)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Access Modes:"), valueStyle.Render(strings.Join(pv.AccessModes, ", "))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Reclaim Policy:"), valueStyle.Render(pv.ReclaimPolicy)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Storage Class:"), valueStyle.Render(orNone(pv.StorageClass))))
+	if pv.VolumeMode != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Volume Mode:"), valueStyle.Render(pv.VolumeMode)))
+	}
+	if pv.Source != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Source:"), valueStyle.Render(pv.Source)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(pv.Age)))
+	if pv.Message != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Message:"), valueStyle.Render(truncateString(pv.Message, m.width-20))))
+	}
+
+	// === Node Affinity Section ===
+	if len(pv.NodeAffinity) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("NODE AFFINITY"))
+		sb.WriteString("\n")
+		for _, term := range pv.NodeAffinity {
+			sb.WriteString(fmt.Sprintf("  %s\n", term))
+		}
+	}
+
+	// === Labels Section ===
+	if len(pv.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(pv.Labels))
+		for k := range pv.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(pv.Labels[k], 40)))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *PVDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// Volume returns the current volume
+func (m *PVDetailsModel) Volume() *domain.PersistentVolume {
+	return m.volume
+}
@@ -0,0 +1,158 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// pvItem implements list.Item for persistent volumes
+type pvItem struct {
+	volume domain.PersistentVolume
+}
+
+func (i pvItem) FilterValue() string { return i.volume.Name + " " + i.volume.Claim }
+
+// pvDelegate renders persistent volume list items
+type pvDelegate struct {
+	styles Styles
+}
+
+func (d pvDelegate) Height() int                             { return 1 }
+func (d pvDelegate) Spacing() int                            { return 0 }
+func (d pvDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d pvDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(pvItem)
+	if !ok {
+		return
+	}
+
+	pv := item.volume
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME(40) CAPACITY(9) ACCESS(6) RECLAIM(8) STATUS(11) CLAIM(30) CLASS(16) AGE(6)
+	namePadded := fmt.Sprintf("%-40s", truncateString(pv.Name, 40))
+	capacityPadded := fmt.Sprintf("%-9s", pv.Capacity)
+	accessPadded := fmt.Sprintf("%-6s", truncateString(strings.Join(pv.AccessModes, ","), 6))
+	reclaimPadded := fmt.Sprintf("%-8s", pv.ReclaimPolicy)
+	statusPadded := fmt.Sprintf("%-11s", pv.Phase)
+	claimPadded := fmt.Sprintf("%-30s", truncateString(orNone(pv.Claim), 30))
+	classPadded := fmt.Sprintf("%-16s", truncateString(orNone(pv.StorageClass), 16))
+	agePadded := fmt.Sprintf("%-6s", pv.Age)
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	capacityStyled := lipgloss.NewStyle().Foreground(colorText).Render(capacityPadded)
+	accessStyled := mutedStyle.Render(accessPadded)
+	reclaimStyled := mutedStyle.Render(reclaimPadded)
+	statusStyled := volumePhaseStyle(pv.Phase).Render(statusPadded)
+	claimStyled := mutedStyle.Render(claimPadded)
+	classStyled := mutedStyle.Render(classPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+	orphaned := ""
+	if pv.Orphaned != "" {
+		orphaned = lipgloss.NewStyle().Foreground(colorWarning).Render("⚠ " + pv.Orphaned)
+	}
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s %s %s %s", prefix, nameStyle.Render(namePadded), capacityStyled, accessStyled, reclaimStyled, statusStyled, claimStyled, classStyled, ageStyled, orphaned)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s %s %s %s", nameStyle.Render(namePadded), capacityStyled, accessStyled, reclaimStyled, statusStyled, claimStyled, classStyled, ageStyled, orphaned)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newPVList creates a list model for persistent volumes
+func newPVList(volumes []domain.PersistentVolume, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(volumes))
+	for i, pv := range volumes {
+		items[i] = pvItem{volume: pv}
+	}
+
+	delegate := pvDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updatePVList updates the persistent volume list items while preserving
+// selection. With orphansOnly only orphaned volumes are listed.
+func updatePVList(l *list.Model, volumes []domain.PersistentVolume, orphansOnly bool) tea.Cmd {
+	if orphansOnly {
+		volumes = orphanedVolumes(volumes)
+	}
+
+	currentIndex := l.Index()
+	var currentName string
+	if item, ok := l.SelectedItem().(pvItem); ok {
+		currentName = item.volume.Name
+	}
+
+	items := make([]list.Item, len(volumes))
+	newIndex := 0
+	for i, pv := range volumes {
+		items[i] = pvItem{volume: pv}
+		if pv.Name == currentName {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
+
+// orphanedVolumes returns the volumes no longer used by a claim
+func orphanedVolumes(volumes []domain.PersistentVolume) []domain.PersistentVolume {
+	var orphaned []domain.PersistentVolume
+	for _, pv := range volumes {
+		if pv.Orphaned != "" {
+			orphaned = append(orphaned, pv)
+		}
+	}
+	return orphaned
+}
+
+func volumePhaseStyle(phase string) lipgloss.Style {
+	switch phase {
+	case domain.VolumePhaseBound:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	case domain.VolumePhaseAvailable:
+		return lipgloss.NewStyle().Foreground(colorText)
+	case domain.VolumePhaseReleased:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	case domain.VolumePhaseFailed:
+		return lipgloss.NewStyle().Foreground(colorError)
+	default:
+		return lipgloss.NewStyle().Foreground(colorMuted)
+	}
+}
@@ -0,0 +1,208 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// PVCDetailsModel is the model for the persistent volume claim details view
+type PVCDetailsModel struct {
+	claim    *domain.PersistentVolumeClaim
+	viewport viewport.Model
+	styles   Styles
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewPVCDetailsModel creates a new persistent volume claim details model
+func NewPVCDetailsModel(styles Styles) PVCDetailsModel {
+	return PVCDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetClaim sets the claim to display
+func (m *PVCDetailsModel) SetClaim(claim *domain.PersistentVolumeClaim) {
+	m.claim = claim
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *PVCDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.claim != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m PVCDetailsModel) Update(msg tea.Msg) (PVCDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the claim details
+func (m PVCDetailsModel) View() string {
+	if !m.ready || m.claim == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *PVCDetailsModel) renderContent() string {
+	if m.claim == nil {
+		return "No claim selected"
+	}
+
+	var sb strings.Builder
+	pvc := m.claim
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(pvc.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(pvc.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Status:"), claimPhaseStyle(pvc.Phase).Bold(true).Render(pvc.Phase)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Capacity:"), valueStyle.Render(orNone(pvc.Capacity))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Requested:"), valueStyle.Render(orNone(pvc.Requested))))
+	if pvc.Resizing != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Resizing:"), lipgloss.NewStyle().Foreground(colorWarning).Render(pvc.Resizing)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Access Modes:"), valueStyle.Render(strings.Join(pvc.AccessModes, ", "))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Storage Class:"), valueStyle.Render(orNone(pvc.StorageClass))))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Volume:"), valueStyle.Render(orNone(pvc.VolumeName))))
+	if pvc.VolumeMode != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Volume Mode:"), valueStyle.Render(pvc.VolumeMode)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(pvc.Age)))
+
+	// === Node Affinity Section ===
+	if len(pvc.VolumeNodeAffinity) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("VOLUME NODE AFFINITY"))
+		sb.WriteString("\n")
+		for _, term := range pvc.VolumeNodeAffinity {
+			sb.WriteString(fmt.Sprintf("  %s\n", term))
+		}
+	}
+
+	// === Mounted By Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render(fmt.Sprintf("MOUNTED BY (%d)", len(pvc.Pods))))
+	sb.WriteString("\n")
+	if len(pvc.Pods) == 0 {
+		sb.WriteString(mutedStyle.Render("  No pods mount this claim"))
+		sb.WriteString("\n")
+	} else {
+		podHeader := lipgloss.NewStyle().
+			Foreground(colorMuted).
+			Bold(true).
+			Render(fmt.Sprintf("  %-40s %-20s %s", "NAME", "STATUS", "NODE"))
+		sb.WriteString(podHeader)
+		sb.WriteString("\n")
+
+		for _, p := range pvc.Pods {
+			statusStyle := valueStyle
+			switch p.Status {
+			case domain.PodStatusRunning:
+				statusStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+			case domain.PodStatusPending:
+				statusStyle = lipgloss.NewStyle().Foreground(colorWarning)
+			}
+			sb.WriteString(fmt.Sprintf("  %-40s %s %s\n",
+				truncateString(p.Name, 40),
+				statusStyle.Render(fmt.Sprintf("%-20s", truncateString(p.Status, 20))),
+				orNone(p.Node)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(pvc.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(pvc.Labels))
+		for k := range pvc.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(pvc.Labels[k], 40)))
+		}
+	}
+
+	// === Events Section ===
+	if len(pvc.Events) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("EVENTS (%d)", len(pvc.Events))))
+		sb.WriteString("\n")
+
+		// Show last 10 events
+		eventsToShow := pvc.Events
+		if len(eventsToShow) > 10 {
+			eventsToShow = eventsToShow[len(eventsToShow)-10:]
+		}
+
+		for _, e := range eventsToShow {
+			typeStyle := mutedStyle
+			if e.Type == "Warning" {
+				typeStyle = lipgloss.NewStyle().Foreground(colorWarning)
+			}
+
+			countStr := ""
+			if e.Count > 1 {
+				countStr = fmt.Sprintf(" (x%d)", e.Count)
+			}
+
+			sb.WriteString(fmt.Sprintf("  %s %s%s\n",
+				typeStyle.Render(fmt.Sprintf("%-8s", e.Type)),
+				e.Reason,
+				countStr))
+			sb.WriteString(fmt.Sprintf("    %s\n", truncateString(e.Message, m.width-10)))
+			sb.WriteString(fmt.Sprintf("    Last seen: %s ago\n", e.LastSeen))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *PVCDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// Claim returns the current claim
+func (m *PVCDetailsModel) Claim() *domain.PersistentVolumeClaim {
+	return m.claim
+}
@@ -0,0 +1,177 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// pvcItem implements list.Item for persistent volume claims
+type pvcItem struct {
+	claim domain.PersistentVolumeClaim
+}
+
+func (i pvcItem) FilterValue() string { return i.claim.Name + " " + i.claim.StorageClass }
+
+// pvcDelegate renders persistent volume claim list items
+type pvcDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d pvcDelegate) Height() int                             { return 1 }
+func (d pvcDelegate) Spacing() int                            { return 0 }
+func (d pvcDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d pvcDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(pvcItem)
+	if !ok {
+		return
+	}
+
+	pvc := item.claim
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME(30) STATUS(11) CAPACITY(12) ACCESS(6) CLASS(16) AGE(6) USED BY
+	namePadded := fmt.Sprintf("%-30s", truncateString(pvc.Name, 30))
+	statusPadded := fmt.Sprintf("%-11s", pvc.Phase)
+	capacityPadded := fmt.Sprintf("%-12s", claimCapacityText(pvc))
+	accessPadded := fmt.Sprintf("%-6s", truncateString(strings.Join(pvc.AccessModes, ","), 6))
+	classPadded := fmt.Sprintf("%-16s", truncateString(orNone(pvc.StorageClass), 16))
+	agePadded := fmt.Sprintf("%-6s", pvc.Age)
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(pvc.Namespace, 20))) + " "
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	statusStyled := claimPhaseStyle(pvc.Phase).Render(statusPadded)
+	capacityStyle := lipgloss.NewStyle().Foreground(colorText)
+	if pvc.Resizing != "" {
+		capacityStyle = lipgloss.NewStyle().Foreground(colorWarning)
+	}
+	capacityStyled := capacityStyle.Render(capacityPadded)
+	accessStyled := mutedStyle.Render(accessPadded)
+	classStyled := mutedStyle.Render(classPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+	usedByStyled := mutedStyle.Render(truncateString(claimPodsText(pvc.Pods), max(m.Width()-100, 10)))
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s %s", prefix, nsColumn+nameStyle.Render(namePadded), statusStyled, capacityStyled, accessStyled, classStyled, ageStyled, usedByStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s %s", nsColumn+nameStyle.Render(namePadded), statusStyled, capacityStyled, accessStyled, classStyled, ageStyled, usedByStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newPVCList creates a list model for persistent volume claims. showNamespace
+// adds a NAMESPACE column for lists spanning all namespaces.
+func newPVCList(claims []domain.PersistentVolumeClaim, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(claims))
+	for i, pvc := range claims {
+		items[i] = pvcItem{claim: pvc}
+	}
+
+	delegate := pvcDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updatePVCList updates the persistent volume claim list items while preserving selection
+func updatePVCList(l *list.Model, claims []domain.PersistentVolumeClaim) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(pvcItem); ok {
+		currentName = item.claim.Name
+		currentNamespace = item.claim.Namespace
+	}
+
+	items := make([]list.Item, len(claims))
+	newIndex := 0
+	for i, pvc := range claims {
+		items[i] = pvcItem{claim: pvc}
+		if pvc.Name == currentName && pvc.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
+
+// claimCapacityText returns the provisioned size, the requested size while
+// the claim is pending, or both while a resize is in progress, e.g. "1Gi→5Gi"
+func claimCapacityText(pvc domain.PersistentVolumeClaim) string {
+	switch {
+	case pvc.Capacity == "":
+		return orNone(pvc.Requested)
+	case pvc.Requested != "" && pvc.Requested != pvc.Capacity:
+		return pvc.Capacity + "→" + pvc.Requested
+	}
+	return pvc.Capacity
+}
+
+// claimPodsText names the pods mounting a claim, e.g. "db-0 (+2)"
+func claimPodsText(pods []domain.VolumePod) string {
+	switch len(pods) {
+	case 0:
+		return "-"
+	case 1:
+		return pods[0].Name
+	}
+	return fmt.Sprintf("%s (+%d)", pods[0].Name, len(pods)-1)
+}
+
+func claimPhaseStyle(phase string) lipgloss.Style {
+	switch phase {
+	case domain.ClaimPhaseBound:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	case domain.ClaimPhasePending:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	case domain.ClaimPhaseLost, "NotFound":
+		return lipgloss.NewStyle().Foreground(colorError)
+	default:
+		return lipgloss.NewStyle().Foreground(colorMuted)
+	}
+}
+
+// orNone returns s, or "<none>" when it is empty
+func orNone(s string) string {
+	if s == "" {
+		return "<none>"
+	}
+	return s
+}
@@ -0,0 +1,137 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/k8s"
+)
+
+// PVCResizeDialog asks for the new size of a persistent volume claim
+type PVCResizeDialog struct {
+	namespace  string
+	name       string
+	current    string
+	inputValue string
+	visible    bool
+	width      int
+	form       *huh.Form
+}
+
+// NewPVCResizeDialog creates a new claim resize dialog
+func NewPVCResizeDialog() PVCResizeDialog {
+	return PVCResizeDialog{}
+}
+
+// Show displays the dialog for a claim currently requesting current and
+// returns a tea.Cmd to initialise the form
+func (d *PVCResizeDialog) Show(namespace, name, current string) tea.Cmd {
+	d.namespace = namespace
+	d.name = name
+	d.current = current
+	d.visible = true
+	d.inputValue = current
+
+	d.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Resize Claim").
+				Description(fmt.Sprintf("%s (requests %s)", truncateString(name, 30), current)).
+				Placeholder(current).
+				Value(&d.inputValue).
+				Validate(func(s string) error {
+					return k8s.ValidateClaimSize(d.current, s)
+				}),
+		),
+	).WithTheme(K4sHuhTheme()).WithShowHelp(false)
+	return d.form.Init()
+}
+
+// Hide hides the dialog
+func (d *PVCResizeDialog) Hide() {
+	d.visible = false
+	d.form = nil
+}
+
+// IsVisible returns whether the dialog is visible
+func (d *PVCResizeDialog) IsVisible() bool {
+	return d.visible
+}
+
+// Namespace returns the namespace of the claim
+func (d *PVCResizeDialog) Namespace() string {
+	return d.namespace
+}
+
+// Name returns the name of the claim
+func (d *PVCResizeDialog) Name() string {
+	return d.name
+}
+
+// Size returns the size entered
+func (d *PVCResizeDialog) Size() string {
+	return strings.TrimSpace(d.inputValue)
+}
+
+// SetWidth sets the dialog width
+func (d *PVCResizeDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Update handles key messages for the dialog
+func (d *PVCResizeDialog) Update(msg tea.Msg) (confirmed bool, cancelled bool, cmd tea.Cmd) {
+	if !d.visible || d.form == nil {
+		return false, false, nil
+	}
+
+	// Handle esc for cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" {
+			return false, true, nil
+		}
+	}
+
+	model, formCmd := d.form.Update(msg)
+	if f, ok := model.(*huh.Form); ok {
+		d.form = f
+	}
+
+	if d.form.State == huh.StateCompleted {
+		return true, false, formCmd
+	}
+	if d.form.State == huh.StateAborted {
+		return false, true, formCmd
+	}
+
+	return false, false, formCmd
+}
+
+// View renders the dialog
+func (d *PVCResizeDialog) View() string {
+	if !d.visible || d.form == nil {
+		return ""
+	}
+
+	dialogWidth := 55
+	if d.width > 0 && d.width < 65 {
+		dialogWidth = d.width - 10
+	}
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorBorder).
+		Padding(1, 2).
+		Width(dialogWidth).
+		Align(lipgloss.Center)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	content := d.form.View() + "\n" + hintStyle.Render("Enter: resize • Esc: cancel")
+
+	return dialogStyle.Render(content)
+}
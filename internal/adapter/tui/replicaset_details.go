@@ -0,0 +1,169 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// ReplicaSetDetailsModel is the model for replicaset details view
+type ReplicaSetDetailsModel struct {
+	replicaSet *domain.ReplicaSet
+	viewport   viewport.Model
+	styles     Styles
+	width      int
+	height     int
+	ready      bool
+}
+
+// NewReplicaSetDetailsModel creates a new replicaset details model
+func NewReplicaSetDetailsModel(styles Styles) ReplicaSetDetailsModel {
+	return ReplicaSetDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetReplicaSet sets the replicaset to display
+func (m *ReplicaSetDetailsModel) SetReplicaSet(rs *domain.ReplicaSet) {
+	m.replicaSet = rs
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *ReplicaSetDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.replicaSet != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m ReplicaSetDetailsModel) Update(msg tea.Msg) (ReplicaSetDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the replicaset details
+func (m ReplicaSetDetailsModel) View() string {
+	if !m.ready || m.replicaSet == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *ReplicaSetDetailsModel) renderContent() string {
+	if m.replicaSet == nil {
+		return "No replicaset selected"
+	}
+
+	var sb strings.Builder
+	rs := m.replicaSet
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(16)
+
+	valueStyle := lipgloss.NewStyle()
+	readyStyle := replicaStatusStyle(rs.Ready, rs.Desired).Bold(true)
+
+	owner := rs.Owner
+	if owner == "" {
+		owner = "<none>"
+	}
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(rs.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(rs.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Controlled by:"), valueStyle.Render(owner)))
+	if rs.Revision != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Revision:"), valueStyle.Render(rs.Revision)))
+	}
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(rs.Age)))
+
+	// === Status Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("STATUS"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Desired:"), rs.Desired))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Current:"), rs.Current))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Ready:"), readyStyle.Render(fmt.Sprintf("%d", rs.Ready))))
+
+	// === Images Section ===
+	if len(rs.Images) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("IMAGES (%d)", len(rs.Images))))
+		sb.WriteString("\n")
+		for _, img := range rs.Images {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncateString(img, m.width-6)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(rs.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(rs.Labels))
+		for k := range rs.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := rs.Labels[k]
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(v, 40)))
+		}
+	}
+
+	// === Selector Section ===
+	if len(rs.Selector) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("SELECTOR"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(rs.Selector))
+		for k := range rs.Selector {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", k, rs.Selector[k]))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *ReplicaSetDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// ReplicaSet returns the current replicaset
+func (m *ReplicaSetDetailsModel) ReplicaSet() *domain.ReplicaSet {
+	return m.replicaSet
+}
@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// replicaSetItem implements list.Item for replicasets
+type replicaSetItem struct {
+	replicaSet domain.ReplicaSet
+}
+
+func (i replicaSetItem) FilterValue() string { return i.replicaSet.Name }
+
+// replicaSetDelegate renders replicaset list items
+type replicaSetDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d replicaSetDelegate) Height() int                             { return 1 }
+func (d replicaSetDelegate) Spacing() int                            { return 0 }
+func (d replicaSetDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d replicaSetDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(replicaSetItem)
+	if !ok {
+		return
+	}
+
+	rs := item.replicaSet
+	readyStyle := replicaStatusStyle(rs.Ready, rs.Desired)
+
+	owner := rs.Owner
+	if owner == "" {
+		owner = "<none>"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-45s", truncateString(rs.Name, 45))
+	desiredPadded := fmt.Sprintf("%-8d", rs.Desired)
+	currentPadded := fmt.Sprintf("%-8d", rs.Current)
+	readyPadded := fmt.Sprintf("%-8d", rs.Ready)
+	ownerPadded := fmt.Sprintf("%-32s", truncateString(owner, 32))
+	agePadded := rs.Age
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(rs.Namespace, 20))) + " "
+	}
+
+	// Apply colors after padding. Scaled-down ReplicaSets (old rollout
+	// revisions) are dimmed.
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	desiredStyled := mutedStyle.Render(desiredPadded)
+	currentStyled := mutedStyle.Render(currentPadded)
+	readyStyled := readyStyle.Render(readyPadded)
+	ownerStyled := mutedStyle.Render(ownerPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s",
+			prefix, nsColumn+nameStyle.Render(namePadded), desiredStyled, currentStyled, readyStyled, ownerStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		if rs.Desired == 0 {
+			nameStyle = mutedStyle
+		}
+		line = fmt.Sprintf("  %s %s %s %s %s %s",
+			nsColumn+nameStyle.Render(namePadded), desiredStyled, currentStyled, readyStyled, ownerStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newReplicaSetList creates a list model for replicasets. showNamespace adds
+// a NAMESPACE column for lists spanning all namespaces.
+func newReplicaSetList(replicaSets []domain.ReplicaSet, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(replicaSets))
+	for i, rs := range replicaSets {
+		items[i] = replicaSetItem{replicaSet: rs}
+	}
+
+	delegate := replicaSetDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateReplicaSetList updates the replicaset list items while preserving selection
+func updateReplicaSetList(l *list.Model, replicaSets []domain.ReplicaSet) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(replicaSetItem); ok {
+		currentName = item.replicaSet.Name
+		currentNamespace = item.replicaSet.Namespace
+	}
+
+	items := make([]list.Item, len(replicaSets))
+	newIndex := 0
+	for i, rs := range replicaSets {
+		items[i] = replicaSetItem{replicaSet: rs}
+		if rs.Name == currentName && rs.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -0,0 +1,181 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// Width limits of the columns of the resource browser, which are fitted to
+// the listed values
+const (
+	resourceNameWidth   = 50
+	resourceColumnWidth = 30
+)
+
+// resourceItem implements list.Item for objects of any resource type
+type resourceItem struct {
+	object domain.ResourceObject
+}
+
+func (i resourceItem) FilterValue() string {
+	return i.object.Namespace + "/" + i.object.Name + " " + strings.Join(i.object.Cells, " ")
+}
+
+// resourceDelegate renders objects with the columns of their resource type
+type resourceDelegate struct {
+	styles        Styles
+	nameWidth     int
+	widths        []int
+	showNamespace bool
+}
+
+func (d resourceDelegate) Height() int                             { return 1 }
+func (d resourceDelegate) Spacing() int                            { return 0 }
+func (d resourceDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d resourceDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(resourceItem)
+	if !ok {
+		return
+	}
+
+	obj := item.object
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME, the printer columns of the CRD, AGE
+	namePadded := fmt.Sprintf("%-*s", d.nameWidth, truncateString(obj.Name, d.nameWidth))
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(obj.Namespace, 20))) + " "
+	}
+
+	cellStyle := lipgloss.NewStyle().Foreground(colorText)
+	var cells strings.Builder
+	for i, width := range d.widths {
+		value := ""
+		if i < len(obj.Cells) {
+			value = obj.Cells[i]
+		}
+		cells.WriteString(cellStyle.Render(fmt.Sprintf("%-*s", width, truncateString(value, width))))
+		cells.WriteString(" ")
+	}
+	ageStyled := lipgloss.NewStyle().Foreground(colorMuted).Render(obj.Age)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s%s", prefix, nsColumn+nameStyle.Render(namePadded), cells.String(), ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s%s", nsColumn+nameStyle.Render(namePadded), cells.String(), ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// resourceColumnWidths fits the name column and the printer columns to the
+// header and the listed values
+func resourceColumnWidths(rl *domain.ResourceList) (int, []int) {
+	nameWidth := len("NAME")
+	widths := make([]int, len(rl.Columns))
+	for i, c := range rl.Columns {
+		widths[i] = len(c.Name)
+	}
+	for _, obj := range rl.Objects {
+		nameWidth = max(nameWidth, len(obj.Name))
+		for i, cell := range obj.Cells {
+			if i < len(widths) {
+				widths[i] = max(widths[i], len(cell))
+			}
+		}
+	}
+	for i := range widths {
+		widths[i] = min(widths[i], resourceColumnWidth)
+	}
+	return min(nameWidth, resourceNameWidth), widths
+}
+
+// resourceListHeader returns the column header matching the rows of a
+// resource list, without the namespace column
+func resourceListHeader(rl *domain.ResourceList) string {
+	nameWidth, widths := resourceColumnWidths(rl)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%-*s ", nameWidth, "NAME"))
+	for i, c := range rl.Columns {
+		sb.WriteString(fmt.Sprintf("%-*s ", widths[i], truncateString(strings.ToUpper(c.Name), widths[i])))
+	}
+	sb.WriteString("AGE")
+	return sb.String()
+}
+
+// newResourceList creates a list model for the resource browser
+func newResourceList(width, height int, styles Styles) list.Model {
+	l := list.New(nil, resourceDelegate{styles: styles}, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateResourceList shows a resource list, fitting the columns to it, while
+// preserving the selection. showNamespace adds a NAMESPACE column for
+// namespaced resources.
+func updateResourceList(l *list.Model, rl *domain.ResourceList, styles Styles, showNamespace bool) tea.Cmd {
+	if rl == nil {
+		return l.SetItems(nil)
+	}
+
+	nameWidth, widths := resourceColumnWidths(rl)
+	l.SetDelegate(resourceDelegate{
+		styles:        styles,
+		nameWidth:     nameWidth,
+		widths:        widths,
+		showNamespace: showNamespace && rl.Resource.Namespaced,
+	})
+
+	currentIndex := l.Index()
+	var currentKey string
+	if item, ok := l.SelectedItem().(resourceItem); ok {
+		currentKey = item.object.Namespace + "/" + item.object.Name
+	}
+
+	items := make([]list.Item, len(rl.Objects))
+	newIndex := 0
+	for i, obj := range rl.Objects {
+		items[i] = resourceItem{object: obj}
+		if obj.Namespace+"/"+obj.Name == currentKey {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentKey != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
@@ -0,0 +1,180 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// resourceCommand maps a resource name typed at the ":" prompt to a view
+type resourceCommand struct {
+	name    string
+	aliases []string
+	view    ViewState
+}
+
+// resourceCommands lists the views reachable from the ":" prompt. Views
+// without a number key are only reachable from here.
+var resourceCommands = []resourceCommand{
+	{"namespaces", []string{"ns", "namespace"}, ViewNamespaces},
+	{"pods", []string{"po", "pod"}, ViewPods},
+	{"deployments", []string{"deploy", "deployment"}, ViewDeployments},
+	{"services", []string{"svc", "service"}, ViewServices},
+	{"events", []string{"ev", "event"}, ViewEvents},
+	{"statefulsets", []string{"sts", "statefulset"}, ViewStatefulSets},
+	{"daemonsets", []string{"ds", "daemonset"}, ViewDaemonSets},
+	{"replicasets", []string{"rs", "replicaset"}, ViewReplicaSets},
+	{"jobs", []string{"job"}, ViewJobs},
+	{"cronjobs", []string{"cj", "cronjob"}, ViewCronJobs},
+	{"nodes", []string{"no", "node"}, ViewNodes},
+	{"configmaps", []string{"cm", "configmap"}, ViewConfigMaps},
+	{"secrets", []string{"secret"}, ViewSecrets},
+	{"ingresses", []string{"ing", "ingress"}, ViewIngresses},
+	{"ingressroutes", []string{"ir", "ingressroute"}, ViewIngressRoutes},
+	{"persistentvolumeclaims", []string{"pvc", "persistentvolumeclaim"}, ViewPVCs},
+	{"persistentvolumes", []string{"pv", "persistentvolume"}, ViewPVs},
+	{"storageclasses", []string{"sc", "storageclass"}, ViewStorageClasses},
+	{"helmcharts", []string{"hc", "helmchart"}, ViewHelmCharts},
+	{"forwards", []string{"pf", "portforwards"}, ViewPortForwards},
+	{"api-resources", []string{"api", "crds"}, ViewAPIResources},
+}
+
+// lookupResource resolves a resource name or alias to its view
+func lookupResource(input string) (ViewState, bool) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	for _, rc := range resourceCommands {
+		if rc.name == input {
+			return rc.view, true
+		}
+		for _, alias := range rc.aliases {
+			if alias == input {
+				return rc.view, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// completeResource returns the first resource name starting with input
+func completeResource(input string) string {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return ""
+	}
+	for _, rc := range resourceCommands {
+		if strings.HasPrefix(rc.name, input) {
+			return rc.name
+		}
+	}
+	return ""
+}
+
+// ResourcePrompt is the ":" prompt for jumping to a resource view by name.
+// Resources without a view of their own open in the resource browser.
+type ResourcePrompt struct {
+	visible      bool
+	input        textinput.Model
+	apiResources []domain.APIResource
+}
+
+// NewResourcePrompt creates a new resource prompt
+func NewResourcePrompt() ResourcePrompt {
+	ti := textinput.New()
+	ti.Placeholder = "pods, deploy, jobs, cj, ..."
+	ti.CharLimit = 64
+	ti.Width = 30
+	ti.Prompt = ""
+
+	return ResourcePrompt{
+		input: ti,
+	}
+}
+
+// Show displays the prompt
+func (p *ResourcePrompt) Show() tea.Cmd {
+	p.visible = true
+	p.input.Reset()
+	return p.input.Focus()
+}
+
+// Hide hides the prompt
+func (p *ResourcePrompt) Hide() {
+	p.visible = false
+	p.input.Blur()
+}
+
+// IsVisible returns true if the prompt is visible
+func (p *ResourcePrompt) IsVisible() bool {
+	return p.visible
+}
+
+// SetAPIResources sets the discovered resources the prompt completes
+func (p *ResourcePrompt) SetAPIResources(resources []domain.APIResource) {
+	p.apiResources = resources
+}
+
+// complete returns the completion of input, preferring the resources k4s
+// has a view for
+func (p *ResourcePrompt) complete(input string) string {
+	if name := completeResource(input); name != "" {
+		return name
+	}
+	return completeAPIResource(p.apiResources, input)
+}
+
+// Update handles input messages. Tab completes the resource name.
+// Returns (input, submitted, cancelled, cmd)
+func (p *ResourcePrompt) Update(msg tea.Msg) (string, bool, bool, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "enter":
+			return p.input.Value(), true, false, nil
+		case "esc":
+			return "", false, true, nil
+		case "tab":
+			if name := p.complete(p.input.Value()); name != "" {
+				p.input.SetValue(name)
+				p.input.CursorEnd()
+			}
+			return p.input.Value(), false, false, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	p.input, cmd = p.input.Update(msg)
+	return p.input.Value(), false, false, cmd
+}
+
+// View renders the prompt with the completion hint
+func (p *ResourcePrompt) View() string {
+	if !p.visible {
+		return ""
+	}
+
+	promptStyle := lipgloss.NewStyle().
+		Foreground(colorPrimary).
+		Bold(true)
+
+	hintStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+
+	var sb strings.Builder
+	sb.WriteString(promptStyle.Render(":"))
+	sb.WriteString(p.input.View())
+
+	value := p.input.Value()
+	if name := p.complete(value); name != "" && name != strings.ToLower(value) {
+		sb.WriteString(hintStyle.Render("  tab: " + name))
+	} else if value != "" {
+		_, ok := lookupResource(value)
+		if _, found := lookupAPIResource(p.apiResources, value); !ok && !found {
+			sb.WriteString(hintStyle.Render("  unknown resource"))
+		}
+	}
+
+	return sb.String()
+}
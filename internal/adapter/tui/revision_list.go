@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// revisionItem implements list.Item for deployment revisions
+type revisionItem struct {
+	revision domain.DeploymentRevision
+	marked   bool // picked as the base of a diff
+}
+
+func (i revisionItem) FilterValue() string { return i.revision.ReplicaSet }
+
+// revisionDelegate renders rollout history items
+type revisionDelegate struct {
+	styles Styles
+}
+
+func (d revisionDelegate) Height() int                             { return 1 }
+func (d revisionDelegate) Spacing() int                            { return 0 }
+func (d revisionDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d revisionDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(revisionItem)
+	if !ok {
+		return
+	}
+
+	rev := item.revision
+
+	marker := " "
+	if item.marked {
+		marker = "◆"
+	}
+	changeCause := rev.ChangeCause
+	if changeCause == "" {
+		changeCause = "<none>"
+	}
+	if rev.Current {
+		changeCause = "(current) " + changeCause
+	}
+
+	// Pad plain text FIRST, then apply styling
+	revPadded := fmt.Sprintf("%-5d", rev.Revision)
+	namePadded := fmt.Sprintf("%-36s", truncateString(rev.ReplicaSet, 36))
+	readyPadded := fmt.Sprintf("%-7s", fmt.Sprintf("%d/%d", rev.Ready, rev.Replicas))
+	agePadded := fmt.Sprintf("%-6s", rev.Age)
+	imagesPadded := fmt.Sprintf("%-40s", truncateString(strings.Join(rev.Images, ","), 40))
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	markerStyled := lipgloss.NewStyle().Foreground(colorWarning).Render(marker)
+	readyStyled := replicaStatusStyle(rev.Ready, rev.Replicas).Render(readyPadded)
+	ageStyled := mutedStyle.Render(agePadded)
+	imagesStyled := mutedStyle.Render(imagesPadded)
+	causeStyled := mutedStyle.Render(changeCause)
+	if rev.Current {
+		causeStyled = lipgloss.NewStyle().Foreground(colorSuccess).Render(changeCause)
+	}
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s%s %s %s %s %s %s %s",
+			prefix, markerStyled, nameStyle.Render(revPadded), nameStyle.Render(namePadded), readyStyled, ageStyled, imagesStyled, causeStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		if rev.Replicas == 0 {
+			nameStyle = mutedStyle
+		}
+		line = fmt.Sprintf(" %s %s %s %s %s %s %s",
+			markerStyled, nameStyle.Render(revPadded), nameStyle.Render(namePadded), readyStyled, ageStyled, imagesStyled, causeStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newRevisionList creates a list model for a deployment's rollout history
+func newRevisionList(width, height int, styles Styles) list.Model {
+	delegate := revisionDelegate{styles: styles}
+	l := list.New(nil, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(false)
+	l.SetShowHelp(false)
+
+	return l
+}
+
+// updateRevisionList updates the history items while preserving selection
+func updateRevisionList(l *list.Model, history []domain.DeploymentRevision, marked int64) tea.Cmd {
+	currentIndex := l.Index()
+	var currentRevision int64
+	if item, ok := l.SelectedItem().(revisionItem); ok {
+		currentRevision = item.revision.Revision
+	}
+
+	items := make([]list.Item, len(history))
+	newIndex := -1
+	for i, rev := range history {
+		items[i] = revisionItem{revision: rev, marked: marked != 0 && rev.Revision == marked}
+		if rev.Revision == currentRevision {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	if newIndex >= 0 {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
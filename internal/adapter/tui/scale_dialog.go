@@ -9,9 +9,11 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// ScaleDialog is a dialog for scaling deployments
+// ScaleDialog is a dialog for scaling deployments and statefulsets
 type ScaleDialog struct {
-	deployName string
+	kind       string
+	namespace  string
+	name       string
 	current    int32
 	target     int32
 	inputValue string
@@ -25,9 +27,12 @@ func NewScaleDialog() ScaleDialog {
 	return ScaleDialog{}
 }
 
-// Show displays the scale dialog and returns a tea.Cmd to initialise the form.
-func (d *ScaleDialog) Show(deployName string, currentReplicas int32) tea.Cmd {
-	d.deployName = deployName
+// Show displays the scale dialog for a workload of the given kind (Deployment
+// or StatefulSet) and returns a tea.Cmd to initialise the form.
+func (d *ScaleDialog) Show(kind, namespace, name string, currentReplicas int32) tea.Cmd {
+	d.kind = kind
+	d.namespace = namespace
+	d.name = name
 	d.current = currentReplicas
 	d.target = currentReplicas
 	d.visible = true
@@ -36,8 +41,8 @@ func (d *ScaleDialog) Show(deployName string, currentReplicas int32) tea.Cmd {
 	d.form = huh.NewForm(
 		huh.NewGroup(
 			huh.NewInput().
-				Title("Scale Deployment").
-				Description(fmt.Sprintf("%s (current: %d)", truncateString(deployName, 40), currentReplicas)).
+				Title(fmt.Sprintf("Scale %s", kind)).
+				Description(fmt.Sprintf("%s (current: %d)", truncateString(name, 40), currentReplicas)).
 				Placeholder("0").
 				Value(&d.inputValue).
 				Validate(func(s string) error {
@@ -61,7 +66,9 @@ func (d *ScaleDialog) Show(deployName string, currentReplicas int32) tea.Cmd {
 // Hide hides the scale dialog
 func (d *ScaleDialog) Hide() {
 	d.visible = false
-	d.deployName = ""
+	d.kind = ""
+	d.namespace = ""
+	d.name = ""
 	d.form = nil
 }
 
@@ -70,9 +77,19 @@ func (d *ScaleDialog) IsVisible() bool {
 	return d.visible
 }
 
-// DeploymentName returns the deployment name
-func (d *ScaleDialog) DeploymentName() string {
-	return d.deployName
+// Kind returns the kind of the workload being scaled
+func (d *ScaleDialog) Kind() string {
+	return d.kind
+}
+
+// Namespace returns the namespace of the workload
+func (d *ScaleDialog) Namespace() string {
+	return d.namespace
+}
+
+// Name returns the workload name
+func (d *ScaleDialog) Name() string {
+	return d.name
 }
 
 // TargetReplicas returns the target replica count
@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// secretItem implements list.Item for secrets
+type secretItem struct {
+	secret domain.Secret
+}
+
+func (i secretItem) FilterValue() string { return i.secret.Name + " " + i.secret.Type }
+
+// secretDelegate renders secret list items
+type secretDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d secretDelegate) Height() int                             { return 1 }
+func (d secretDelegate) Spacing() int                            { return 0 }
+func (d secretDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d secretDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(secretItem)
+	if !ok {
+		return
+	}
+
+	s := item.secret
+
+	typeStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	if s.Type == domain.SecretTypeTLS {
+		typeStyle = lipgloss.NewStyle().Foreground(colorSecondary)
+	}
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-40s", truncateString(s.Name, 40))
+	typePadded := fmt.Sprintf("%-36s", truncateString(s.Type, 36))
+	keysPadded := fmt.Sprintf("%-6d", s.Keys)
+	sizePadded := fmt.Sprintf("%10s", formatBytes(int64(s.Size)))
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(s.Namespace, 20))) + " "
+	}
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	typeStyled := typeStyle.Render(typePadded)
+	keysStyled := lipgloss.NewStyle().Foreground(colorText).Render(keysPadded)
+	sizeStyled := mutedStyle.Render(sizePadded)
+	ageStyled := mutedStyle.Render(s.Age)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s  %s", prefix, nsColumn+nameStyle.Render(namePadded), typeStyled, keysStyled, sizeStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s  %s", nsColumn+nameStyle.Render(namePadded), typeStyled, keysStyled, sizeStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newSecretList creates a list model for secrets. showNamespace adds a
+// NAMESPACE column for lists spanning all namespaces.
+func newSecretList(secrets []domain.Secret, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(secrets))
+	for i, s := range secrets {
+		items[i] = secretItem{secret: s}
+	}
+
+	delegate := secretDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateSecretList updates the secret list items while preserving selection
+func updateSecretList(l *list.Model, secrets []domain.Secret) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(secretItem); ok {
+		currentName = item.secret.Name
+		currentNamespace = item.secret.Namespace
+	}
+
+	items := make([]list.Item, len(secrets))
+	newIndex := 0
+	for i, s := range secrets {
+		items[i] = secretItem{secret: s}
+		if s.Name == currentName && s.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
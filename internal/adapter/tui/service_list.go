@@ -20,7 +20,8 @@ func (i serviceItem) FilterValue() string { return i.service.Name }
 
 // serviceDelegate renders service list items
 type serviceDelegate struct {
-	styles Styles
+	styles        Styles
+	showNamespace bool
 }
 
 func (d serviceDelegate) Height() int                             { return 1 }
@@ -58,6 +59,12 @@ func (d serviceDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 	portsPadded := fmt.Sprintf("%-20s", truncateString(svc.Ports, 20))
 	agePadded := svc.Age
 
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(svc.Namespace, 20))) + " "
+	}
+
 	// Apply colors after padding
 	typeStyled := typeStyle.Render(typePadded)
 	clusterIPStyled := lipgloss.NewStyle().Foreground(colorMuted).Render(clusterIPPadded)
@@ -72,25 +79,26 @@ func (d serviceDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 	if index == m.Index() {
 		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
 		line = fmt.Sprintf("%s %s %s %s %s %s %s",
-			prefix, nameStyle.Render(namePadded), typeStyled, clusterIPStyled, externalIPStyled, portsStyled, ageStyled)
+			prefix, nsColumn+nameStyle.Render(namePadded), typeStyled, clusterIPStyled, externalIPStyled, portsStyled, ageStyled)
 		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
 	} else {
 		nameStyle := lipgloss.NewStyle().Foreground(colorText)
 		line = fmt.Sprintf("  %s %s %s %s %s %s",
-			nameStyle.Render(namePadded), typePadded, clusterIPStyled, externalIPStyled, portsStyled, ageStyled)
+			nsColumn+nameStyle.Render(namePadded), typePadded, clusterIPStyled, externalIPStyled, portsStyled, ageStyled)
 	}
 
 	fmt.Fprint(w, line)
 }
 
-// newServiceList creates a list model for services
-func newServiceList(services []domain.Service, width, height int, styles Styles) list.Model {
+// newServiceList creates a list model for services. showNamespace adds a
+// NAMESPACE column for lists spanning all namespaces.
+func newServiceList(services []domain.Service, width, height int, styles Styles, showNamespace bool) list.Model {
 	items := make([]list.Item, len(services))
 	for i, svc := range services {
 		items[i] = serviceItem{service: svc}
 	}
 
-	delegate := serviceDelegate{styles: styles}
+	delegate := serviceDelegate{styles: styles, showNamespace: showNamespace}
 	l := list.New(items, delegate, width, height)
 	l.SetShowTitle(false)
 	l.SetShowStatusBar(false)
@@ -103,27 +111,34 @@ func newServiceList(services []domain.Service, width, height int, styles Styles)
 }
 
 // updateServiceList updates the service list items while preserving selection
-func updateServiceList(l *list.Model, services []domain.Service) {
+func updateServiceList(l *list.Model, services []domain.Service) tea.Cmd {
 	currentIndex := l.Index()
-	var currentName string
+	var currentName, currentNamespace string
 	if item, ok := l.SelectedItem().(serviceItem); ok {
 		currentName = item.service.Name
+		currentNamespace = item.service.Namespace
 	}
 
 	items := make([]list.Item, len(services))
 	newIndex := 0
 	for i, svc := range services {
 		items[i] = serviceItem{service: svc}
-		if svc.Name == currentName {
+		if svc.Name == currentName && svc.Namespace == currentNamespace {
 			newIndex = i
 		}
 	}
 
-	l.SetItems(items)
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
 
 	if currentName != "" {
 		l.Select(newIndex)
 	} else if currentIndex < len(items) {
 		l.Select(currentIndex)
 	}
+	return cmd
 }
@@ -83,9 +83,11 @@ func (a *App) renderSidebarClusterInfo() string {
 		nameStyle := lipgloss.NewStyle().Foreground(colorText)
 		mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
 
-		sb.WriteString(fmt.Sprintf("  %s", nameStyle.Render(a.clusterInfo.Context)))
+		labelStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+
+		sb.WriteString(fmt.Sprintf("  %s %s", labelStyle.Render("ctx"), nameStyle.Render(a.clusterInfo.Context)))
 		sb.WriteString("\n")
-		sb.WriteString(fmt.Sprintf("  %s", mutedStyle.Render(a.clusterInfo.Namespace)))
+		sb.WriteString(fmt.Sprintf("  %s  %s", labelStyle.Render("ns"), mutedStyle.Render(a.namespaceLabel())))
 		sb.WriteString("\n")
 	}
 
@@ -125,11 +127,27 @@ func (a *App) renderSidebarNavigation() string {
 		{"3", "Deployments", []ViewState{ViewDeployments, ViewDeploymentDetails}},
 		{"4", "Services", []ViewState{ViewServices, ViewServiceDetails}},
 		{"5", "Events", []ViewState{ViewEvents}},
+		{"6", "StatefulSets", []ViewState{ViewStatefulSets, ViewStatefulSetDetails}},
+		{"7", "DaemonSets", []ViewState{ViewDaemonSets, ViewDaemonSetDetails}},
+		{"8", "ReplicaSets", []ViewState{ViewReplicaSets, ViewReplicaSetDetails}},
+		{":", "Jobs", []ViewState{ViewJobs, ViewJobDetails}},
+		{":", "CronJobs", []ViewState{ViewCronJobs, ViewCronJobDetails}},
+		{":", "Nodes", []ViewState{ViewNodes, ViewNodeDetails, ViewNodeDrain}},
+		{":", "ConfigMaps", []ViewState{ViewConfigMaps, ViewConfigMapDetails}},
+		{":", "Secrets", []ViewState{ViewSecrets, ViewSecretDetails}},
+		{":", "Ingresses", []ViewState{ViewIngresses, ViewIngressDetails}},
+		{":", "IngressRoutes", []ViewState{ViewIngressRoutes, ViewIngressRouteDetails}},
+		{":", "PVCs", []ViewState{ViewPVCs, ViewPVCDetails}},
+		{":", "PVs", []ViewState{ViewPVs, ViewPVDetails}},
+		{":", "StorageClasses", []ViewState{ViewStorageClasses}},
+		{":", "HelmCharts", []ViewState{ViewHelmCharts, ViewHelmChartDetails}},
+		{":", "API Resources", []ViewState{ViewAPIResources, ViewResources}},
+		{"0", "Forwards", []ViewState{ViewPortForwards}},
 	}
 
 	// Add SSH if configured
 	if len(a.config.SSHHosts) > 0 {
-		items = append(items, navItem{"9", "SSH", []ViewState{ViewSSHHosts, ViewSSHConnecting, ViewCrictlContainers, ViewCrictlLogs}})
+		items = append(items, navItem{"9", "SSH", []ViewState{ViewSSHHosts, ViewSSHConnecting, ViewCrictlContainers, ViewCrictlLogs, ViewCrictlStats, ViewCrictlInspect, ViewCrictlImages, ViewCrictlSandboxes, ViewSSHRun, ViewSSHRunDiff, ViewK3sJournal, ViewEtcdSnapshots}})
 	}
 
 	activeStyle := lipgloss.NewStyle().Foreground(colorPrimary).Bold(true)
@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// SSHCommandDialog asks for a command to run on several SSH hosts. Saved
+// snippets can be picked with the arrow keys and edited before running.
+type SSHCommandDialog struct {
+	visible  bool
+	hosts    []string
+	snippets []domain.SSHSnippet
+	snippet  int // selected snippet, -1 while typing a command
+	input    textinput.Model
+	width    int
+}
+
+// NewSSHCommandDialog creates a new command dialog
+func NewSSHCommandDialog() SSHCommandDialog {
+	ti := textinput.New()
+	ti.Placeholder = "command, or ↑/↓ for a snippet"
+	ti.CharLimit = 1024
+	ti.Prompt = "$ "
+
+	return SSHCommandDialog{
+		input:   ti,
+		snippet: -1,
+	}
+}
+
+// Show displays the dialog for hosts, starting with command
+func (d *SSHCommandDialog) Show(hosts []string, snippets []domain.SSHSnippet, command string) tea.Cmd {
+	d.visible = true
+	d.hosts = hosts
+	d.snippets = snippets
+	d.snippet = -1
+	d.input.SetValue(command)
+	d.input.CursorEnd()
+	return d.input.Focus()
+}
+
+// Hide hides the dialog
+func (d *SSHCommandDialog) Hide() {
+	d.visible = false
+	d.input.Blur()
+}
+
+// IsVisible returns true if the dialog is visible
+func (d *SSHCommandDialog) IsVisible() bool {
+	return d.visible
+}
+
+// SetWidth sets the dialog width
+func (d *SSHCommandDialog) SetWidth(width int) {
+	d.width = width
+}
+
+// Update handles input messages. Up and down cycle through the snippets.
+// Returns (command, submitted, cancelled, cmd)
+func (d *SSHCommandDialog) Update(msg tea.Msg) (string, bool, bool, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "enter":
+			command := strings.TrimSpace(d.input.Value())
+			if command == "" {
+				return "", false, false, nil
+			}
+			return command, true, false, nil
+		case "esc":
+			return "", false, true, nil
+		case "up", "shift+tab":
+			d.selectSnippet(-1)
+			return d.input.Value(), false, false, nil
+		case "down", "tab":
+			d.selectSnippet(1)
+			return d.input.Value(), false, false, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	d.input, cmd = d.input.Update(msg)
+	return d.input.Value(), false, false, cmd
+}
+
+// selectSnippet moves the snippet selection and copies its command into the input
+func (d *SSHCommandDialog) selectSnippet(delta int) {
+	if len(d.snippets) == 0 {
+		return
+	}
+	d.snippet += delta
+	if d.snippet < 0 {
+		d.snippet = len(d.snippets) - 1
+	}
+	if d.snippet >= len(d.snippets) {
+		d.snippet = 0
+	}
+	d.input.SetValue(d.snippets[d.snippet].Command)
+	d.input.CursorEnd()
+}
+
+// View renders the dialog
+func (d *SSHCommandDialog) View() string {
+	if !d.visible {
+		return ""
+	}
+
+	dialogWidth := 70
+	if d.width > 0 && d.width < 80 {
+		dialogWidth = d.width - 10
+	}
+	d.input.Width = dialogWidth - 8
+
+	dialogStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(colorBorder).
+		Padding(1, 2).
+		Width(dialogWidth)
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary)
+	mutedStyle := lipgloss.NewStyle().
+		Foreground(colorMuted)
+	textStyle := lipgloss.NewStyle().
+		Foreground(colorText)
+	selectedStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorAccent)
+
+	noun := "hosts"
+	if len(d.hosts) == 1 {
+		noun = "host"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("Run on %d %s", len(d.hosts), noun)))
+	sb.WriteString("\n")
+	sb.WriteString(mutedStyle.Render(truncateString(strings.Join(d.hosts, ", "), max(dialogWidth-6, 10))))
+	sb.WriteString("\n\n")
+	sb.WriteString(d.input.View())
+
+	if len(d.snippets) > 0 {
+		sb.WriteString("\n\n")
+		sb.WriteString(mutedStyle.Render("Snippets"))
+		for i, s := range d.snippets {
+			sb.WriteString("\n")
+			if i == d.snippet {
+				sb.WriteString(selectedStyle.Render("▸ " + s.Name))
+			} else {
+				sb.WriteString(textStyle.Render("  " + s.Name))
+			}
+			sb.WriteString(mutedStyle.Render("  " + truncateString(s.Command, max(dialogWidth-len(s.Name)-12, 10))))
+		}
+	}
+
+	sb.WriteString("\n\n")
+	sb.WriteString(mutedStyle.Render("↑/↓: snippet • Enter: run • Esc: cancel"))
+	return dialogStyle.Render(sb.String())
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
@@ -13,10 +14,12 @@ import (
 
 // sshHostItem implements list.Item for SSH hosts
 type sshHostItem struct {
-	host domain.SSHHost
+	host   domain.SSHHost
+	node   string // matching Kubernetes node, if known
+	marked bool   // picked for running a command on several hosts
 }
 
-func (i sshHostItem) FilterValue() string { return i.host.Name }
+func (i sshHostItem) FilterValue() string { return i.host.Name + " " + i.node }
 
 // sshHostDelegate renders SSH host list items
 type sshHostDelegate struct {
@@ -40,6 +43,22 @@ func (d sshHostDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 	}
 
 	connectionStr := fmt.Sprintf("%s@%s:%d", host.User, host.Host, port)
+	if host.ProxyJump != "" && !strings.EqualFold(host.ProxyJump, "none") {
+		connectionStr += " via " + host.ProxyJump
+	}
+	if item.node != "" {
+		connectionStr += " · node: " + item.node
+	}
+
+	var source string
+	if host.Imported {
+		source = " " + lipgloss.NewStyle().Foreground(colorSubtle).Render("~/.ssh/config")
+	}
+
+	marker := " "
+	if item.marked {
+		marker = lipgloss.NewStyle().Foreground(colorWarning).Render("◆")
+	}
 
 	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
 	bgStyle := lipgloss.NewStyle().Background(colorBgHighlight)
@@ -47,22 +66,19 @@ func (d sshHostDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 	if index == m.Index() {
 		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
 		connStyle := lipgloss.NewStyle().Foreground(colorMuted)
-		line1 := bgStyle.Render(fmt.Sprintf("%s %s", prefix, nameStyle.Render(host.Name)))
+		line1 := bgStyle.Render(fmt.Sprintf("%s%s%s%s", prefix, marker, nameStyle.Render(host.Name), source))
 		line2 := bgStyle.Render(fmt.Sprintf("  %s", connStyle.Render(connectionStr)))
 		fmt.Fprintf(w, "%s\n%s", line1, line2)
 	} else {
 		nameStyle := lipgloss.NewStyle().Foreground(colorText)
 		connStyle := lipgloss.NewStyle().Foreground(colorMuted)
-		fmt.Fprintf(w, "  %s\n  %s", nameStyle.Render(host.Name), connStyle.Render(connectionStr))
+		fmt.Fprintf(w, " %s%s%s\n  %s", marker, nameStyle.Render(host.Name), source, connStyle.Render(connectionStr))
 	}
 }
 
 // newSSHHostList creates a list model for SSH hosts
 func newSSHHostList(hosts []domain.SSHHost, width, height int, styles Styles) list.Model {
-	items := make([]list.Item, len(hosts))
-	for i, host := range hosts {
-		items[i] = sshHostItem{host: host}
-	}
+	items := sshHostItems(hosts, nil, nil)
 
 	delegate := sshHostDelegate{styles: styles}
 	l := list.New(items, delegate, width, height)
@@ -77,11 +93,20 @@ func newSSHHostList(hosts []domain.SSHHost, width, height int, styles Styles) li
 	return l
 }
 
-// updateSSHHostList updates the SSH host list items
-func updateSSHHostList(l *list.Model, hosts []domain.SSHHost) {
+// updateSSHHostList updates the SSH host list items, linking each host to
+// its node when the cluster's nodes are known and flagging the marked hosts
+func updateSSHHostList(l *list.Model, hosts []domain.SSHHost, nodes []domain.Node, marked map[string]bool) {
+	l.SetItems(sshHostItems(hosts, nodes, marked))
+}
+
+func sshHostItems(hosts []domain.SSHHost, nodes []domain.Node, marked map[string]bool) []list.Item {
 	items := make([]list.Item, len(hosts))
 	for i, host := range hosts {
-		items[i] = sshHostItem{host: host}
+		item := sshHostItem{host: host, marked: marked[host.Name]}
+		if node := domain.FindNodeForSSHHost(nodes, host); node != nil {
+			item.node = node.Name
+		}
+		items[i] = item
 	}
-	l.SetItems(items)
+	return items
 }
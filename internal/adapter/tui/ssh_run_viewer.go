@@ -0,0 +1,328 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/adapter/ssh"
+)
+
+// SSHRunViewer shows the output of a command run on several SSH hosts,
+// grouped per host. Hosts whose output differs from the majority are marked.
+type SSHRunViewer struct {
+	command  string
+	hosts    []string // in the order they were selected
+	results  map[string]ssh.HostResult
+	started  time.Time
+	elapsed  time.Duration
+	running  bool
+	selected int
+	offsets  []int // first line of each host's block
+	viewport viewport.Model
+	width    int
+	height   int
+	ready    bool
+}
+
+// NewSSHRunViewer creates a new run viewer
+func NewSSHRunViewer() SSHRunViewer {
+	return SSHRunViewer{
+		results: make(map[string]ssh.HostResult),
+	}
+}
+
+// SetSize sets the viewport size
+func (v *SSHRunViewer) SetSize(width, height int) {
+	v.width = width
+	v.height = height
+	v.viewport = viewport.New(width, height)
+	v.viewport.Style = lipgloss.NewStyle()
+	v.ready = true
+	v.updateContent()
+}
+
+// Start clears the previous run and waits for the results of command on hosts
+func (v *SSHRunViewer) Start(command string, hosts []string) {
+	v.command = command
+	v.hosts = hosts
+	v.results = make(map[string]ssh.HostResult)
+	v.started = time.Now()
+	v.elapsed = 0
+	v.running = true
+	v.selected = 0
+	if v.ready {
+		v.viewport.GotoTop()
+	}
+	v.updateContent()
+}
+
+// AddResult records the result of one host
+func (v *SSHRunViewer) AddResult(r ssh.HostResult) {
+	v.results[r.Host] = r
+	v.updateContent()
+}
+
+// Finish marks the run as done
+func (v *SSHRunViewer) Finish() {
+	v.running = false
+	v.elapsed = time.Since(v.started)
+	v.updateContent()
+}
+
+// Running returns true while hosts are still running the command
+func (v *SSHRunViewer) Running() bool {
+	return v.running
+}
+
+// Command returns the command of the current run
+func (v *SSHRunViewer) Command() string {
+	return v.command
+}
+
+// Selected returns the selected host and its result, if it has one
+func (v *SSHRunViewer) Selected() (string, ssh.HostResult, bool) {
+	if v.selected >= len(v.hosts) {
+		return "", ssh.HostResult{}, false
+	}
+	host := v.hosts[v.selected]
+	r, ok := v.results[host]
+	return host, r, ok
+}
+
+// Output returns the output of every host, grouped per host
+func (v *SSHRunViewer) Output() string {
+	var sb strings.Builder
+	for _, host := range v.hosts {
+		r, ok := v.results[host]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&sb, "==> %s (exit %d, %s) <==\n", host, r.ExitCode, r.Duration.Round(time.Millisecond))
+		if r.Err != nil {
+			fmt.Fprintf(&sb, "error: %v\n", r.Err)
+		}
+		sb.WriteString(r.Output)
+		if r.Output != "" && !strings.HasSuffix(r.Output, "\n") {
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// Majority returns the output shared by most hosts that ran the command and
+// how many hosts share it. Ties go to the output of the host listed first.
+func (v *SSHRunViewer) Majority() (string, int) {
+	counts := make(map[string]int)
+	var order []string
+	for _, host := range v.hosts {
+		r, ok := v.results[host]
+		if !ok || r.Err != nil {
+			continue
+		}
+		out := strings.TrimSpace(r.Output)
+		if counts[out] == 0 {
+			order = append(order, out)
+		}
+		counts[out]++
+	}
+
+	best, bestCount := "", 0
+	for _, out := range order {
+		if counts[out] > bestCount {
+			best, bestCount = out, counts[out]
+		}
+	}
+	return best, bestCount
+}
+
+// Differs returns true if the host ran the command and its output differs
+// from the majority. Nothing differs while fewer than two hosts have output.
+func (v *SSHRunViewer) Differs(host string) bool {
+	r, ok := v.results[host]
+	if !ok || r.Err != nil {
+		return false
+	}
+	majority, count := v.Majority()
+	if count == 0 || v.ranCount() < 2 {
+		return false
+	}
+	return strings.TrimSpace(r.Output) != majority
+}
+
+// ranCount returns how many hosts ran the command
+func (v *SSHRunViewer) ranCount() int {
+	n := 0
+	for _, r := range v.results {
+		if r.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// selectHost moves the selection and scrolls to the selected host
+func (v *SSHRunViewer) selectHost(delta int) {
+	if len(v.hosts) == 0 {
+		return
+	}
+	v.selected = (v.selected + delta + len(v.hosts)) % len(v.hosts)
+	v.updateContent()
+	if v.selected < len(v.offsets) {
+		v.viewport.SetYOffset(v.offsets[v.selected])
+	}
+}
+
+func (v *SSHRunViewer) updateContent() {
+	if !v.ready {
+		return
+	}
+	if len(v.hosts) == 0 {
+		v.viewport.SetContent("No hosts")
+		return
+	}
+
+	outputStyle := lipgloss.NewStyle().Foreground(colorText)
+	errStyle := lipgloss.NewStyle().Foreground(colorError)
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	var lines []string
+	v.offsets = v.offsets[:0]
+	for i, host := range v.hosts {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		v.offsets = append(v.offsets, len(lines))
+		lines = append(lines, v.renderHostHeader(host, i == v.selected))
+
+		r, ok := v.results[host]
+		switch {
+		case !ok && v.running:
+			lines = append(lines, mutedStyle.Render("  running..."))
+			continue
+		case !ok:
+			lines = append(lines, mutedStyle.Render("  cancelled"))
+			continue
+		case r.Err != nil:
+			lines = append(lines, errStyle.Render("  "+r.Err.Error()))
+		}
+
+		out := strings.TrimRight(r.Output, "\n")
+		if out == "" {
+			if r.Err == nil {
+				lines = append(lines, mutedStyle.Render("  (no output)"))
+			}
+			continue
+		}
+		for _, line := range strings.Split(out, "\n") {
+			lines = append(lines, outputStyle.Render("  "+line))
+		}
+	}
+	v.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// renderHostHeader renders "✓ host  exit 0  120ms" with the majority marker
+func (v *SSHRunViewer) renderHostHeader(host string, selected bool) string {
+	nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	r, ok := v.results[host]
+	var status, info string
+	switch {
+	case !ok:
+		status = mutedStyle.Render("…")
+	case r.OK():
+		status = lipgloss.NewStyle().Foreground(colorSuccess).Render("✓")
+		info = fmt.Sprintf("  exit %d  %s", r.ExitCode, r.Duration.Round(time.Millisecond))
+	case r.Err != nil:
+		status = lipgloss.NewStyle().Foreground(colorError).Render("✗")
+		info = fmt.Sprintf("  failed  %s", r.Duration.Round(time.Millisecond))
+	default:
+		status = lipgloss.NewStyle().Foreground(colorError).Render("✗")
+		info = fmt.Sprintf("  exit %d  %s", r.ExitCode, r.Duration.Round(time.Millisecond))
+	}
+
+	line := fmt.Sprintf("%s %s%s", status, nameStyle.Render(host), mutedStyle.Render(info))
+	if v.Differs(host) {
+		line += "  " + lipgloss.NewStyle().Foreground(colorWarning).Render("≠ differs from majority")
+	}
+
+	if selected {
+		prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+		width := v.width
+		if w := lipgloss.Width(prefix + line); w > width {
+			width = w
+		}
+		return lipgloss.NewStyle().Background(colorBgHighlight).Width(width).Render(prefix + line)
+	}
+	return " " + line
+}
+
+// Update handles messages. Tab and shift+tab move between hosts.
+func (v SSHRunViewer) Update(msg tea.Msg) (SSHRunViewer, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "tab", "n":
+			v.selectHost(1)
+			return v, nil
+		case "shift+tab", "N":
+			v.selectHost(-1)
+			return v, nil
+		case "g", "home":
+			v.viewport.GotoTop()
+			return v, nil
+		case "G", "end":
+			v.viewport.GotoBottom()
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+// View renders the grouped output
+func (v SSHRunViewer) View() string {
+	if !v.ready {
+		return "Loading..."
+	}
+	return v.viewport.View()
+}
+
+// RenderHeader returns the title line with the command and the run summary
+func (v *SSHRunViewer) RenderHeader() string {
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(colorPrimary)
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+
+	var ok, failed, differ int
+	for _, host := range v.hosts {
+		r, done := v.results[host]
+		if !done {
+			continue
+		}
+		if r.OK() {
+			ok++
+		} else {
+			failed++
+		}
+		if v.Differs(host) {
+			differ++
+		}
+	}
+
+	header := titleStyle.Render("Run: ") + lipgloss.NewStyle().Foreground(colorAccent).
+		Render(truncateString(v.command, max(v.width/2, 20)))
+
+	summary := fmt.Sprintf("%d/%d done  ok: %d  failed: %d  differ: %d", ok+failed, len(v.hosts), ok, failed, differ)
+	if v.running {
+		summary += fmt.Sprintf("  %s", time.Since(v.started).Round(time.Second))
+	} else if !v.started.IsZero() {
+		summary += fmt.Sprintf("  took %s", v.elapsed.Round(time.Millisecond))
+	}
+	return header + "  " + infoStyle.Render(summary)
+}
@@ -0,0 +1,180 @@
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// StatefulSetDetailsModel is the model for statefulset details view
+type StatefulSetDetailsModel struct {
+	statefulSet *domain.StatefulSet
+	viewport    viewport.Model
+	styles      Styles
+	width       int
+	height      int
+	ready       bool
+}
+
+// NewStatefulSetDetailsModel creates a new statefulset details model
+func NewStatefulSetDetailsModel(styles Styles) StatefulSetDetailsModel {
+	return StatefulSetDetailsModel{
+		styles: styles,
+	}
+}
+
+// SetStatefulSet sets the statefulset to display
+func (m *StatefulSetDetailsModel) SetStatefulSet(sts *domain.StatefulSet) {
+	m.statefulSet = sts
+	if m.ready {
+		m.viewport.SetContent(m.renderContent())
+		m.viewport.GotoTop()
+	}
+}
+
+// SetSize sets the viewport size
+func (m *StatefulSetDetailsModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.viewport = viewport.New(width, height)
+	m.viewport.Style = lipgloss.NewStyle()
+	m.ready = true
+	if m.statefulSet != nil {
+		m.viewport.SetContent(m.renderContent())
+	}
+}
+
+// Update handles messages
+func (m StatefulSetDetailsModel) Update(msg tea.Msg) (StatefulSetDetailsModel, tea.Cmd) {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	return m, cmd
+}
+
+// View renders the statefulset details
+func (m StatefulSetDetailsModel) View() string {
+	if !m.ready || m.statefulSet == nil {
+		return "Loading..."
+	}
+	return m.viewport.View()
+}
+
+func (m *StatefulSetDetailsModel) renderContent() string {
+	if m.statefulSet == nil {
+		return "No statefulset selected"
+	}
+
+	var sb strings.Builder
+	sts := m.statefulSet
+
+	// Styles
+	sectionStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary).
+		MarginTop(1)
+
+	labelStyle := lipgloss.NewStyle().
+		Foreground(colorMuted).
+		Width(18)
+
+	valueStyle := lipgloss.NewStyle()
+	statusStyle := replicaStatusStyle(sts.ReadyReplicas, sts.Replicas).Bold(true)
+
+	// === Metadata Section ===
+	sb.WriteString(sectionStyle.Render("METADATA"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Name:"), valueStyle.Render(sts.Name)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Namespace:"), valueStyle.Render(sts.Namespace)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Service:"), valueStyle.Render(sts.ServiceName)))
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Age:"), valueStyle.Render(sts.Age)))
+
+	// === Status Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("STATUS"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Ready:"), statusStyle.Render(sts.Ready)))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Current:"), sts.CurrentReplicas))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Up-to-date:"), sts.UpdatedReplicas))
+	sb.WriteString(fmt.Sprintf("%s %d\n", labelStyle.Render("Replicas:"), sts.Replicas))
+
+	// === Update Strategy Section ===
+	sb.WriteString("\n")
+	sb.WriteString(sectionStyle.Render("UPDATE STRATEGY"))
+	sb.WriteString("\n")
+	if sts.UpdateStrategy != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Type:"), valueStyle.Render(sts.UpdateStrategy)))
+	}
+	if sts.CurrentRevision != "" {
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Current revision:"), valueStyle.Render(sts.CurrentRevision)))
+	}
+	if sts.UpdateRevision != "" {
+		revStyle := valueStyle
+		if sts.UpdateRevision != sts.CurrentRevision {
+			revStyle = lipgloss.NewStyle().Foreground(colorWarning)
+		}
+		sb.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render("Update revision:"), revStyle.Render(sts.UpdateRevision)))
+	}
+
+	// === Images Section ===
+	if len(sts.Images) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render(fmt.Sprintf("IMAGES (%d)", len(sts.Images))))
+		sb.WriteString("\n")
+		for _, img := range sts.Images {
+			sb.WriteString(fmt.Sprintf("  %s\n", truncateString(img, m.width-6)))
+		}
+	}
+
+	// === Labels Section ===
+	if len(sts.Labels) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("LABELS"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(sts.Labels))
+		for k := range sts.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v := sts.Labels[k]
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", truncateString(k, 30), truncateString(v, 40)))
+		}
+	}
+
+	// === Selector Section ===
+	if len(sts.Selector) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(sectionStyle.Render("SELECTOR"))
+		sb.WriteString("\n")
+
+		keys := make([]string, 0, len(sts.Selector))
+		for k := range sts.Selector {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			sb.WriteString(fmt.Sprintf("  %s=%s\n", k, sts.Selector[k]))
+		}
+	}
+
+	return sb.String()
+}
+
+// ScrollPercent returns the scroll percentage
+func (m *StatefulSetDetailsModel) ScrollPercent() float64 {
+	return m.viewport.ScrollPercent()
+}
+
+// StatefulSet returns the current statefulset
+func (m *StatefulSetDetailsModel) StatefulSet() *domain.StatefulSet {
+	return m.statefulSet
+}
@@ -0,0 +1,143 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// statefulSetItem implements list.Item for statefulsets
+type statefulSetItem struct {
+	statefulSet domain.StatefulSet
+}
+
+func (i statefulSetItem) FilterValue() string { return i.statefulSet.Name }
+
+// statefulSetDelegate renders statefulset list items
+type statefulSetDelegate struct {
+	styles        Styles
+	showNamespace bool
+}
+
+func (d statefulSetDelegate) Height() int                             { return 1 }
+func (d statefulSetDelegate) Spacing() int                            { return 0 }
+func (d statefulSetDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d statefulSetDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(statefulSetItem)
+	if !ok {
+		return
+	}
+
+	sts := item.statefulSet
+	statusStyle := replicaStatusStyle(sts.ReadyReplicas, sts.Replicas)
+
+	// Pad plain text FIRST, then apply styling
+	namePadded := fmt.Sprintf("%-40s", truncateString(sts.Name, 40))
+	readyPadded := fmt.Sprintf("%-10s", sts.Ready)
+	upToDatePadded := fmt.Sprintf("%-10d", sts.UpdatedReplicas)
+	servicePadded := fmt.Sprintf("%-24s", truncateString(sts.ServiceName, 24))
+	agePadded := sts.Age
+
+	// Namespace column, shown when listing across all namespaces
+	nsColumn := ""
+	if d.showNamespace {
+		nsColumn = lipgloss.NewStyle().Foreground(colorMuted).Render(fmt.Sprintf("%-20s", truncateString(sts.Namespace, 20))) + " "
+	}
+
+	// Apply colors after padding
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	readyStyled := statusStyle.Render(readyPadded)
+	upToDateStyled := mutedStyle.Render(upToDatePadded)
+	serviceStyled := mutedStyle.Render(servicePadded)
+	ageStyled := mutedStyle.Render(agePadded)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s",
+			prefix, nsColumn+nameStyle.Render(namePadded), readyStyled, upToDateStyled, serviceStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s",
+			nsColumn+nameStyle.Render(namePadded), readyStyled, upToDateStyled, serviceStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newStatefulSetList creates a list model for statefulsets. showNamespace
+// adds a NAMESPACE column for lists spanning all namespaces.
+func newStatefulSetList(statefulSets []domain.StatefulSet, width, height int, styles Styles, showNamespace bool) list.Model {
+	items := make([]list.Item, len(statefulSets))
+	for i, sts := range statefulSets {
+		items[i] = statefulSetItem{statefulSet: sts}
+	}
+
+	delegate := statefulSetDelegate{styles: styles, showNamespace: showNamespace}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateStatefulSetList updates the statefulset list items while preserving selection
+func updateStatefulSetList(l *list.Model, statefulSets []domain.StatefulSet) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName, currentNamespace string
+	if item, ok := l.SelectedItem().(statefulSetItem); ok {
+		currentName = item.statefulSet.Name
+		currentNamespace = item.statefulSet.Namespace
+	}
+
+	items := make([]list.Item, len(statefulSets))
+	newIndex := 0
+	for i, sts := range statefulSets {
+		items[i] = statefulSetItem{statefulSet: sts}
+		if sts.Name == currentName && sts.Namespace == currentNamespace {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
+
+// replicaStatusStyle colors a ready count: green when all replicas are ready,
+// red when none are and yellow in between
+func replicaStatusStyle(ready, desired int32) lipgloss.Style {
+	switch {
+	case ready == desired && desired > 0:
+		return lipgloss.NewStyle().Foreground(colorSuccess)
+	case ready == 0 && desired > 0:
+		return lipgloss.NewStyle().Foreground(colorError)
+	case desired == 0:
+		return lipgloss.NewStyle().Foreground(colorMuted)
+	default:
+		return lipgloss.NewStyle().Foreground(colorWarning)
+	}
+}
@@ -0,0 +1,130 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// storageClassItem implements list.Item for storage classes
+type storageClassItem struct {
+	class domain.StorageClass
+}
+
+func (i storageClassItem) FilterValue() string { return i.class.Name + " " + i.class.Provisioner }
+
+// storageClassDelegate renders storage class list items
+type storageClassDelegate struct {
+	styles Styles
+}
+
+func (d storageClassDelegate) Height() int                             { return 1 }
+func (d storageClassDelegate) Spacing() int                            { return 0 }
+func (d storageClassDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d storageClassDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
+	item, ok := listItem.(storageClassItem)
+	if !ok {
+		return
+	}
+
+	sc := item.class
+
+	name := sc.Name
+	if sc.Default {
+		name += " (default)"
+	}
+	expansion := "no"
+	if sc.AllowExpansion {
+		expansion = "yes"
+	}
+
+	// Pad plain text FIRST, then apply styling
+	// Columns: NAME(30) PROVISIONER(36) RECLAIM(8) BINDING(22) EXPANSION(9) AGE
+	namePadded := fmt.Sprintf("%-30s", truncateString(name, 30))
+	provisionerPadded := fmt.Sprintf("%-36s", truncateString(sc.Provisioner, 36))
+	reclaimPadded := fmt.Sprintf("%-8s", sc.ReclaimPolicy)
+	bindingPadded := fmt.Sprintf("%-22s", sc.VolumeBindingMode)
+	expansionPadded := fmt.Sprintf("%-9s", expansion)
+
+	mutedStyle := lipgloss.NewStyle().Foreground(colorMuted)
+	provisionerStyled := lipgloss.NewStyle().Foreground(colorText).Render(provisionerPadded)
+	reclaimStyled := mutedStyle.Render(reclaimPadded)
+	bindingStyled := mutedStyle.Render(bindingPadded)
+	expansionStyle := mutedStyle
+	if sc.AllowExpansion {
+		expansionStyle = lipgloss.NewStyle().Foreground(colorSuccess)
+	}
+	expansionStyled := expansionStyle.Render(expansionPadded)
+	ageStyled := mutedStyle.Render(sc.Age)
+
+	prefix := lipgloss.NewStyle().Foreground(colorPrimary).Render("▌")
+
+	var line string
+	if index == m.Index() {
+		nameStyle := lipgloss.NewStyle().Bold(true).Foreground(colorText)
+		line = fmt.Sprintf("%s %s %s %s %s %s %s", prefix, nameStyle.Render(namePadded), provisionerStyled, reclaimStyled, bindingStyled, expansionStyled, ageStyled)
+		line = lipgloss.NewStyle().Background(colorBgHighlight).Render(line)
+	} else {
+		nameStyle := lipgloss.NewStyle().Foreground(colorText)
+		line = fmt.Sprintf("  %s %s %s %s %s %s", nameStyle.Render(namePadded), provisionerStyled, reclaimStyled, bindingStyled, expansionStyled, ageStyled)
+	}
+
+	fmt.Fprint(w, line)
+}
+
+// newStorageClassList creates a list model for storage classes
+func newStorageClassList(classes []domain.StorageClass, width, height int, styles Styles) list.Model {
+	items := make([]list.Item, len(classes))
+	for i, sc := range classes {
+		items[i] = storageClassItem{class: sc}
+	}
+
+	delegate := storageClassDelegate{styles: styles}
+	l := list.New(items, delegate, width, height)
+	l.SetShowTitle(false)
+	l.SetShowStatusBar(false)
+	l.SetFilteringEnabled(true)
+	l.SetShowHelp(false)
+	l.Styles.FilterPrompt = lipgloss.NewStyle().Foreground(colorPrimary)
+	l.Styles.FilterCursor = lipgloss.NewStyle().Foreground(colorPrimary)
+
+	return l
+}
+
+// updateStorageClassList updates the storage class list items while preserving selection
+func updateStorageClassList(l *list.Model, classes []domain.StorageClass) tea.Cmd {
+	currentIndex := l.Index()
+	var currentName string
+	if item, ok := l.SelectedItem().(storageClassItem); ok {
+		currentName = item.class.Name
+	}
+
+	items := make([]list.Item, len(classes))
+	newIndex := 0
+	for i, sc := range classes {
+		items[i] = storageClassItem{class: sc}
+		if sc.Name == currentName {
+			newIndex = i
+		}
+	}
+
+	cmd := l.SetItems(items)
+
+	// A filtered list keeps its own selection
+	if l.FilterState() != list.Unfiltered {
+		return cmd
+	}
+
+	if currentName != "" {
+		l.Select(newIndex)
+	} else if currentIndex < len(items) {
+		l.Select(currentIndex)
+	}
+	return cmd
+}
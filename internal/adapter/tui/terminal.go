@@ -0,0 +1,95 @@
+package tui
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/muesli/cancelreader"
+	"golang.org/x/term"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// terminalSessionFunc runs an interactive remote session on the given stdio
+type terminalSessionFunc func(ctx context.Context, stdin io.Reader, stdout, stderr io.Writer, resize <-chan domain.TerminalSize) error
+
+// terminalCommand hands the real terminal to a remote session while the
+// program is suspended. It implements tea.ExecCommand.
+type terminalCommand struct {
+	session terminalSessionFunc
+	stdin   io.Reader
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+// newTerminalCommand creates a tea.ExecCommand for an interactive session
+func newTerminalCommand(session terminalSessionFunc) *terminalCommand {
+	return &terminalCommand{session: session}
+}
+
+// SetStdin sets the input the session reads from
+func (t *terminalCommand) SetStdin(r io.Reader) {
+	t.stdin = r
+}
+
+// SetStdout sets the output the session writes to
+func (t *terminalCommand) SetStdout(w io.Writer) {
+	t.stdout = w
+}
+
+// SetStderr sets the error output of the session
+func (t *terminalCommand) SetStderr(w io.Writer) {
+	t.stderr = w
+}
+
+// Run puts the terminal into raw mode and blocks until the session ends
+func (t *terminalCommand) Run() error {
+	stdin := t.stdin
+	if stdin == nil {
+		stdin = os.Stdin
+	}
+	stdout := t.stdout
+	if stdout == nil {
+		stdout = os.Stdout
+	}
+	stderr := t.stderr
+	if stderr == nil {
+		stderr = os.Stderr
+	}
+
+	if f, ok := stdin.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		state, err := term.MakeRaw(int(f.Fd()))
+		if err != nil {
+			return err
+		}
+		defer term.Restore(int(f.Fd()), state)
+	}
+
+	// The session copies stdin in a goroutine that outlives it; a cancelable
+	// reader makes sure it does not swallow keys meant for the TUI afterwards.
+	input, err := cancelreader.NewReader(stdin)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+	defer input.Cancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	return t.session(ctx, input, stdout, stderr, watchTerminalSize(ctx, stdout))
+}
+
+// terminalSize returns the current size of the terminal behind w, if any
+func terminalSize(w io.Writer) (domain.TerminalSize, bool) {
+	f, ok := w.(*os.File)
+	if !ok {
+		return domain.TerminalSize{}, false
+	}
+	width, height, err := term.GetSize(int(f.Fd()))
+	if err != nil || width <= 0 || height <= 0 {
+		return domain.TerminalSize{}, false
+	}
+	return domain.TerminalSize{Width: uint16(width), Height: uint16(height)}, true
+}
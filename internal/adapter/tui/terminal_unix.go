@@ -0,0 +1,48 @@
+//go:build !windows
+
+package tui
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// watchTerminalSize emits the current terminal size and every change
+// signalled by SIGWINCH until ctx is done
+func watchTerminalSize(ctx context.Context, w io.Writer) <-chan domain.TerminalSize {
+	sizes := make(chan domain.TerminalSize, 1)
+	if size, ok := terminalSize(w); ok {
+		sizes <- size
+	}
+
+	winch := make(chan os.Signal, 1)
+	signal.Notify(winch, syscall.SIGWINCH)
+
+	go func() {
+		defer close(sizes)
+		defer signal.Stop(winch)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-winch:
+				size, ok := terminalSize(w)
+				if !ok {
+					continue
+				}
+				select {
+				case sizes <- size:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return sizes
+}
@@ -0,0 +1,46 @@
+//go:build windows
+
+package tui
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/LywwKkA-aD/k4s/internal/domain"
+)
+
+// watchTerminalSize emits the current terminal size and polls for changes,
+// since Windows consoles have no resize signal
+func watchTerminalSize(ctx context.Context, w io.Writer) <-chan domain.TerminalSize {
+	sizes := make(chan domain.TerminalSize, 1)
+	last, ok := terminalSize(w)
+	if ok {
+		sizes <- last
+	}
+
+	go func() {
+		defer close(sizes)
+		ticker := time.NewTicker(250 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				size, ok := terminalSize(w)
+				if !ok || size == last {
+					continue
+				}
+				last = size
+				select {
+				case sizes <- size:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return sizes
+}
@@ -0,0 +1,325 @@
+package tui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// yamlKeyRegex matches "key:" at the start of a YAML line (after indent and list marker)
+var yamlKeyRegex = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s:#'"][^:#]*?):(\s|$)`)
+
+// YAMLViewer shows the live object of a resource as highlighted YAML
+type YAMLViewer struct {
+	kind              string
+	namespace         string
+	name              string
+	content           string
+	lines             []string
+	viewport          viewport.Model
+	styles            Styles
+	width             int
+	height            int
+	ready             bool
+	showManagedFields bool
+	searchQuery       string
+	matches           []int // Indices of lines matching search
+}
+
+// NewYAMLViewer creates a new YAML viewer
+func NewYAMLViewer(styles Styles) YAMLViewer {
+	return YAMLViewer{
+		styles: styles,
+	}
+}
+
+// SetResource sets the resource to show and clears the previous content
+func (y *YAMLViewer) SetResource(kind, namespace, name string) {
+	y.kind = kind
+	y.namespace = namespace
+	y.name = name
+	y.content = ""
+	y.lines = nil
+	y.searchQuery = ""
+	y.matches = nil
+	if y.ready {
+		y.viewport.SetContent("Loading...")
+		y.viewport.GotoTop()
+	}
+}
+
+// SetYAML sets the YAML content, keeping the scroll position when the
+// resource is only reloaded
+func (y *YAMLViewer) SetYAML(content string) {
+	y.content = content
+	y.lines = strings.Split(strings.TrimRight(content, "\n"), "\n")
+	y.updateMatches()
+	y.updateContent()
+}
+
+// SetSize sets the viewport size
+func (y *YAMLViewer) SetSize(width, height int) {
+	y.width = width
+	y.height = height
+	y.viewport = viewport.New(width, height)
+	y.viewport.Style = lipgloss.NewStyle()
+	y.ready = true
+	y.updateContent()
+}
+
+// Kind returns the kind of the shown resource
+func (y *YAMLViewer) Kind() string {
+	return y.kind
+}
+
+// Namespace returns the namespace of the shown resource
+func (y *YAMLViewer) Namespace() string {
+	return y.namespace
+}
+
+// Name returns the name of the shown resource
+func (y *YAMLViewer) Name() string {
+	return y.name
+}
+
+// Content returns the raw YAML
+func (y *YAMLViewer) Content() string {
+	return y.content
+}
+
+// ShowManagedFields returns whether metadata.managedFields is included
+func (y *YAMLViewer) ShowManagedFields() bool {
+	return y.showManagedFields
+}
+
+// ToggleManagedFields toggles whether metadata.managedFields is included
+func (y *YAMLViewer) ToggleManagedFields() {
+	y.showManagedFields = !y.showManagedFields
+}
+
+// SetSearchQuery sets the search query and jumps to the first match
+func (y *YAMLViewer) SetSearchQuery(query string) {
+	y.searchQuery = query
+	y.updateMatches()
+	y.updateContent()
+	y.GotoMatch(1)
+}
+
+// SearchQuery returns the current search query
+func (y *YAMLViewer) SearchQuery() string {
+	return y.searchQuery
+}
+
+// MatchCount returns the number of lines matching the search
+func (y *YAMLViewer) MatchCount() int {
+	return len(y.matches)
+}
+
+// GotoMatch scrolls to the n-th match (1-based)
+func (y *YAMLViewer) GotoMatch(n int) {
+	if !y.ready || n < 1 || n > len(y.matches) {
+		return
+	}
+	// Keep some context above the match
+	offset := y.matches[n-1] - y.viewport.Height/3
+	if offset < 0 {
+		offset = 0
+	}
+	y.viewport.SetYOffset(offset)
+}
+
+func (y *YAMLViewer) updateMatches() {
+	y.matches = nil
+	if y.searchQuery == "" {
+		return
+	}
+
+	query := strings.ToLower(y.searchQuery)
+	for i, line := range y.lines {
+		if strings.Contains(strings.ToLower(line), query) {
+			y.matches = append(y.matches, i)
+		}
+	}
+}
+
+func (y *YAMLViewer) updateContent() {
+	if !y.ready {
+		return
+	}
+
+	if len(y.lines) == 0 {
+		y.viewport.SetContent("No content")
+		return
+	}
+
+	query := strings.ToLower(y.searchQuery)
+	var sb strings.Builder
+	for i, line := range y.lines {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		// Matching lines are shown plain with the match highlighted
+		if query != "" && strings.Contains(strings.ToLower(line), query) {
+			sb.WriteString(highlightMatch(line, query))
+			continue
+		}
+		sb.WriteString(highlightYAMLLine(line))
+	}
+	y.viewport.SetContent(sb.String())
+}
+
+// highlightYAMLLine colors keys, list markers, comments and scalar values
+func highlightYAMLLine(line string) string {
+	keyStyle := lipgloss.NewStyle().Foreground(colorAccent)
+	markerStyle := lipgloss.NewStyle().Foreground(colorMuted)
+
+	trimmed := strings.TrimLeft(line, " ")
+	indent := line[:len(line)-len(trimmed)]
+
+	if strings.HasPrefix(trimmed, "#") {
+		return indent + markerStyle.Render(trimmed)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(indent)
+	for strings.HasPrefix(trimmed, "- ") || trimmed == "-" {
+		sb.WriteString(markerStyle.Render("-"))
+		trimmed = strings.TrimPrefix(trimmed, "-")
+		rest := strings.TrimLeft(trimmed, " ")
+		sb.WriteString(trimmed[:len(trimmed)-len(rest)])
+		trimmed = rest
+	}
+
+	if m := yamlKeyRegex.FindStringSubmatch(trimmed); m != nil {
+		sb.WriteString(keyStyle.Render(m[1]))
+		sb.WriteString(markerStyle.Render(":"))
+		value := trimmed[len(m[1])+1:]
+		rest := strings.TrimLeft(value, " ")
+		sb.WriteString(value[:len(value)-len(rest)])
+		sb.WriteString(highlightYAMLValue(rest))
+		return sb.String()
+	}
+
+	sb.WriteString(highlightYAMLValue(trimmed))
+	return sb.String()
+}
+
+// highlightYAMLValue colors a scalar by its type
+func highlightYAMLValue(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	switch {
+	case value == "|" || value == "|-" || value == ">" || value == ">-" || value == "{}" || value == "[]":
+		return lipgloss.NewStyle().Foreground(colorMuted).Render(value)
+	case value == "true" || value == "false" || value == "null":
+		return lipgloss.NewStyle().Foreground(colorWarning).Render(value)
+	case isYAMLNumber(value):
+		return lipgloss.NewStyle().Foreground(colorWarning).Render(value)
+	case strings.HasPrefix(value, `"`) || strings.HasPrefix(value, "'"):
+		return lipgloss.NewStyle().Foreground(colorSuccess).Render(value)
+	}
+	return lipgloss.NewStyle().Foreground(colorText).Render(value)
+}
+
+func isYAMLNumber(s string) bool {
+	digits := 0
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case (r == '-' || r == '+') && i == 0:
+		case r == '.':
+		default:
+			return false
+		}
+	}
+	return digits > 0
+}
+
+// highlightMatch highlights every case-insensitive occurrence of query in line
+func highlightMatch(line, query string) string {
+	highlightStyle := lipgloss.NewStyle().
+		Background(colorWarning).
+		Foreground(lipgloss.Color("#000000"))
+
+	lower := strings.ToLower(line)
+	var sb strings.Builder
+	for {
+		idx := strings.Index(lower, query)
+		if idx == -1 {
+			sb.WriteString(line)
+			break
+		}
+		sb.WriteString(line[:idx])
+		sb.WriteString(highlightStyle.Render(line[idx : idx+len(query)]))
+		line = line[idx+len(query):]
+		lower = lower[idx+len(query):]
+	}
+	return sb.String()
+}
+
+// Update handles messages
+func (y YAMLViewer) Update(msg tea.Msg) (YAMLViewer, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch keyMsg.String() {
+		case "g", "home":
+			y.viewport.GotoTop()
+			return y, nil
+		case "G", "end":
+			y.viewport.GotoBottom()
+			return y, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	y.viewport, cmd = y.viewport.Update(msg)
+	return y, cmd
+}
+
+// View renders the YAML viewport
+func (y YAMLViewer) View() string {
+	if !y.ready {
+		return "Loading..."
+	}
+	return y.viewport.View()
+}
+
+// ScrollPercent returns the scroll percentage
+func (y *YAMLViewer) ScrollPercent() float64 {
+	return y.viewport.ScrollPercent()
+}
+
+// RenderHeader returns the YAML viewer header
+func (y *YAMLViewer) RenderHeader() string {
+	target := y.name
+	if y.namespace != "" {
+		target = y.namespace + "/" + y.name
+	}
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(colorPrimary)
+	header := titleStyle.Render(fmt.Sprintf("YAML: %s %s", y.kind, target))
+
+	if y.showManagedFields {
+		indicator := lipgloss.NewStyle().Foreground(colorPrimary).Render("◉")
+		label := lipgloss.NewStyle().Foreground(colorText).Render("managedFields")
+		header += "  " + indicator + " " + label
+	}
+
+	infoStyle := lipgloss.NewStyle().Foreground(colorSubtle)
+	header += "  " + infoStyle.Render(fmt.Sprintf("Lines: %d", len(y.lines)))
+
+	if y.searchQuery != "" {
+		searchStyle := lipgloss.NewStyle().Foreground(colorMuted)
+		header += "  " + searchStyle.Render(fmt.Sprintf("Search: '%s' (%d)", y.searchQuery, y.MatchCount()))
+	}
+
+	header += "  " + infoStyle.Render(fmt.Sprintf("%.0f%%", y.ScrollPercent()*100))
+	return header
+}
@@ -0,0 +1,68 @@
+package domain
+
+import "slices"
+
+// APIResource is a resource type served by the API server, found through
+// discovery
+type APIResource struct {
+	Name       string // plural resource name, e.g. helmcharts
+	Kind       string
+	Group      string // empty for the core group
+	Version    string // preferred version of the group
+	Namespaced bool
+	ShortNames []string
+	Verbs      []string
+}
+
+// GroupVersion returns the apiVersion of the resource, e.g. helm.cattle.io/v1
+func (r APIResource) GroupVersion() string {
+	if r.Group == "" {
+		return r.Version
+	}
+	return r.Group + "/" + r.Version
+}
+
+// FullName returns the resource name qualified with its group, the way
+// kubectl accepts it, e.g. helmcharts.helm.cattle.io
+func (r APIResource) FullName() string {
+	if r.Group == "" {
+		return r.Name
+	}
+	return r.Name + "." + r.Group
+}
+
+// QualifiedKind returns the kind qualified with its group, e.g.
+// HelmChart.helm.cattle.io. Kinds of the core group are not qualified.
+func (r APIResource) QualifiedKind() string {
+	if r.Group == "" {
+		return r.Kind
+	}
+	return r.Kind + "." + r.Group
+}
+
+// Supports returns true if the resource supports an API verb, e.g. delete
+func (r APIResource) Supports(verb string) bool {
+	return slices.Contains(r.Verbs, verb)
+}
+
+// ResourceColumn is a column of a resource list, taken from the
+// additionalPrinterColumns of a CRD
+type ResourceColumn struct {
+	Name string
+	Type string // string, integer, number, boolean or date
+}
+
+// ResourceObject is an object of any resource type
+type ResourceObject struct {
+	Name      string
+	Namespace string
+	Age       string
+	Cells     []string // one value per column of the list
+}
+
+// ResourceList is the objects of one resource type with their columns
+type ResourceList struct {
+	Resource APIResource
+	Columns  []ResourceColumn
+	Objects  []ResourceObject
+}
@@ -9,6 +9,15 @@ type ClusterInfo struct {
 	Connected bool
 }
 
+// KubeContext represents a context defined in a kubeconfig file
+type KubeContext struct {
+	Name      string
+	Cluster   string
+	User      string
+	Namespace string
+	Current   bool // current-context of the kubeconfig
+}
+
 // ConnectionStatus represents the current connection state
 type ConnectionStatus int
 
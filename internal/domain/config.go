@@ -1,25 +1,62 @@
 package domain
 
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
 // KubeConfig represents a kubeconfig entry
 type KubeConfig struct {
 	Name    string `yaml:"name" mapstructure:"name"`
 	Path    string `yaml:"path" mapstructure:"path"`
+	Context string `yaml:"context,omitempty" mapstructure:"context"` // optional, skips the context picker
 	Default bool   `yaml:"default" mapstructure:"default"`
 }
 
 // SSHHost represents an SSH host configuration
 type SSHHost struct {
-	Name    string `yaml:"name" mapstructure:"name"`
-	Host    string `yaml:"host" mapstructure:"host"`
-	User    string `yaml:"user" mapstructure:"user"`
-	KeyPath string `yaml:"key_path" mapstructure:"key_path"`
-	Port    int    `yaml:"port" mapstructure:"port"`
+	Name       string `yaml:"name" mapstructure:"name"`
+	Host       string `yaml:"host" mapstructure:"host"`
+	User       string `yaml:"user" mapstructure:"user"`
+	KeyPath    string `yaml:"key_path" mapstructure:"key_path"`
+	Port       int    `yaml:"port" mapstructure:"port"`
+	Node       string `yaml:"node,omitempty" mapstructure:"node"`               // optional, Kubernetes node name when it differs from host
+	KnownHosts string `yaml:"known_hosts,omitempty" mapstructure:"known_hosts"` // optional, defaults to ~/.ssh/known_hosts
+	ProxyJump  string `yaml:"proxy_jump,omitempty" mapstructure:"proxy_jump"`   // optional, jump hosts as in ssh -J
+	Imported   bool   `yaml:"-" mapstructure:"-"`                               // read from ~/.ssh/config, never saved
 }
 
 // Config represents the application configuration
 type Config struct {
 	KubeConfigs []KubeConfig `yaml:"kubeconfigs" mapstructure:"kubeconfigs"`
 	SSHHosts    []SSHHost    `yaml:"ssh_hosts" mapstructure:"ssh_hosts"`
+	SSHConfig   string       `yaml:"ssh_config,omitempty" mapstructure:"ssh_config"` // hosts are imported from it, default ~/.ssh/config, "none" disables
+	SSHSnippets []SSHSnippet `yaml:"ssh_snippets,omitempty" mapstructure:"ssh_snippets"`
+}
+
+// SSHSnippet is a saved command that can be run on several SSH hosts at once
+type SSHSnippet struct {
+	Name    string `yaml:"name" mapstructure:"name"`
+	Command string `yaml:"command" mapstructure:"command"`
+}
+
+// defaultSSHSnippets are offered when no snippets are configured
+var defaultSSHSnippets = []SSHSnippet{
+	{Name: "k3s version", Command: "k3s --version | head -n 1"},
+	{Name: "Disk usage", Command: "df -h /"},
+	{Name: "containerd config", Command: "cat /var/lib/rancher/k3s/agent/etc/containerd/config.toml"},
+	{Name: "Uptime", Command: "uptime"},
+}
+
+// Snippets returns the configured SSH snippets, or the built-in ones when
+// none are configured
+func (c *Config) Snippets() []SSHSnippet {
+	if len(c.SSHSnippets) > 0 {
+		return c.SSHSnippets
+	}
+	return defaultSSHSnippets
 }
 
 // DefaultKubeConfig returns the default kubeconfig or the first one
@@ -45,6 +82,102 @@ func (c *Config) FindKubeConfig(name string) *KubeConfig {
 	return nil
 }
 
+// FindSSHHost finds an SSH host by name
+func (c *Config) FindSSHHost(name string) *SSHHost {
+	for i := range c.SSHHosts {
+		if c.SSHHosts[i].Name == name {
+			return &c.SSHHosts[i]
+		}
+	}
+	return nil
+}
+
+// maxProxyJumpDepth limits jump hosts that themselves use a jump host
+const maxProxyJumpDepth = 8
+
+// JumpHosts resolves the ProxyJump chain of host into the hosts to connect
+// through, in order. Each hop is either the name of a configured host or
+// [user@]host[:port]; an unnamed hop connects with host's user and key.
+func (c *Config) JumpHosts(host SSHHost) ([]SSHHost, error) {
+	return c.jumpHosts(host, 0)
+}
+
+func (c *Config) jumpHosts(host SSHHost, depth int) ([]SSHHost, error) {
+	spec := strings.TrimSpace(host.ProxyJump)
+	if spec == "" || strings.EqualFold(spec, "none") {
+		return nil, nil
+	}
+	if depth >= maxProxyJumpDepth {
+		return nil, fmt.Errorf("proxy jump of %s: too many nested jump hosts", host.Name)
+	}
+
+	var jumps []SSHHost
+	for i, hop := range strings.Split(spec, ",") {
+		hop = strings.TrimSpace(hop)
+		if hop == "" {
+			continue
+		}
+
+		jump, err := c.parseJumpHost(hop, host)
+		if err != nil {
+			return nil, fmt.Errorf("proxy jump of %s: %w", host.Name, err)
+		}
+
+		// Like ssh, only the first hop's own jump hosts are used
+		if i == 0 {
+			before, err := c.jumpHosts(jump, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			jumps = append(jumps, before...)
+		}
+		jumps = append(jumps, jump)
+	}
+	return jumps, nil
+}
+
+// parseJumpHost turns one ProxyJump hop into a host
+func (c *Config) parseJumpHost(hop string, target SSHHost) (SSHHost, error) {
+	hop = strings.TrimPrefix(hop, "ssh://")
+	if h := c.FindSSHHost(hop); h != nil {
+		return *h, nil
+	}
+
+	jump := SSHHost{Name: hop, User: target.User, KeyPath: target.KeyPath}
+	userGiven := false
+	if at := strings.LastIndex(hop, "@"); at >= 0 {
+		jump.User = hop[:at]
+		hop = hop[at+1:]
+		userGiven = true
+	}
+
+	jump.Host = hop
+	if host, port, err := net.SplitHostPort(hop); err == nil {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return SSHHost{}, fmt.Errorf("invalid port in %q", hop)
+		}
+		jump.Host = host
+		jump.Port = p
+	}
+	if jump.Host == "" {
+		return SSHHost{}, fmt.Errorf("invalid jump host %q", hop)
+	}
+
+	// A bare alias may still name a configured host, e.g. "admin@bastion"
+	if h := c.FindSSHHost(jump.Host); h != nil {
+		named := *h
+		if userGiven {
+			named.User = jump.User
+		}
+		if jump.Port != 0 {
+			named.Port = jump.Port
+		}
+		return named, nil
+	}
+	return jump, nil
+}
+
 // NodeInfo represents information about a node
 type NodeInfo struct {
 	Hostname string
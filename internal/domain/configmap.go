@@ -0,0 +1,22 @@
+package domain
+
+// ConfigMap represents a Kubernetes ConfigMap
+type ConfigMap struct {
+	Name            string
+	Namespace       string
+	Keys            int
+	Size            int // bytes of all values
+	Age             string
+	Labels          map[string]string
+	ResourceVersion string
+	Data            []DataItem // keys sorted by name, only filled in for details
+}
+
+// DataItem is one key of a ConfigMap or Secret
+type DataItem struct {
+	Key   string
+	Value []byte
+	// Binary is set for binaryData of a ConfigMap and for values that are not
+	// UTF-8 text; they are shown by size only and cannot be edited
+	Binary bool
+}
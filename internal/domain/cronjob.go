@@ -0,0 +1,20 @@
+package domain
+
+// CronJob represents a Kubernetes CronJob
+type CronJob struct {
+	Name              string
+	Namespace         string
+	Schedule          string
+	TimeZone          string
+	Suspend           bool
+	Active            int
+	LastSchedule      string // age of the last scheduled run, e.g. "5m"
+	LastSuccessful    string // age of the last successful run
+	ConcurrencyPolicy string
+	SuccessfulHistory int32
+	FailedHistory     int32
+	Age               string
+	Labels            map[string]string
+	Images            []string
+	Jobs              []Job // jobs owned by the cronjob, only filled in for details
+}
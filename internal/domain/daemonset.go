@@ -0,0 +1,32 @@
+package domain
+
+// DaemonSet represents a Kubernetes DaemonSet
+type DaemonSet struct {
+	Name           string
+	Namespace      string
+	Desired        int32
+	Current        int32
+	Ready          int32
+	UpToDate       int32
+	Available      int32
+	Misscheduled   int32
+	NodeSelector   string // e.g., "kubernetes.io/os=linux" or "<none>"
+	UpdateStrategy string
+	Age            string
+	Labels         map[string]string
+	Selector       map[string]string
+	Images         []string
+	Pods           []DaemonSetPod // only filled in for details
+}
+
+// DaemonSetPod is a pod of a DaemonSet together with its rollout state
+type DaemonSetPod struct {
+	Name     string
+	Node     string
+	Ready    string // e.g., "1/1"
+	Status   string
+	Restarts int32
+	Age      string
+	Revision string // controller-revision-hash of the pod
+	UpToDate bool   // pod runs the current DaemonSet revision
+}
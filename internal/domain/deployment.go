@@ -15,6 +15,35 @@ type Deployment struct {
 	Selector      map[string]string
 	Conditions    []DeploymentCondition
 	Images        []string
+	Paused        bool
+	Revision      string // current revision, from deployment.kubernetes.io/revision
+	Rollout       RolloutStatus
+	History       []DeploymentRevision // newest first, only filled for details
+}
+
+// RolloutStatus summarizes the progress of a rollout like kubectl rollout status
+type RolloutStatus struct {
+	Message   string
+	Complete  bool
+	Failed    bool // progress deadline exceeded
+	Updated   int32
+	Total     int32 // all pods, old and new
+	Desired   int32
+	Available int32
+}
+
+// DeploymentRevision is one entry of a deployment's rollout history,
+// backed by the ReplicaSet of that revision
+type DeploymentRevision struct {
+	Revision    int64
+	ReplicaSet  string
+	Replicas    int32
+	Ready       int32
+	Images      []string
+	ChangeCause string
+	Age         string
+	Current     bool
+	Template    string // pod template as YAML, for diffing revisions
 }
 
 // DeploymentCondition represents a condition of a deployment
@@ -0,0 +1,36 @@
+package domain
+
+// HelmChart represents a k3s helm-controller HelmChart (helm.cattle.io/v1)
+type HelmChart struct {
+	Name            string
+	Namespace       string
+	Chart           string // chart name, or the chart file for charts given by URL or inline
+	Repo            string
+	Version         string
+	TargetNamespace string // namespace the release is installed in
+	ValuesContent   string
+	Bootstrap       bool
+	// Addon is set for charts k3s deploys from its manifests directory. k3s
+	// reverts edits to them; their values are changed with a HelmChartConfig.
+	Addon           bool
+	ResourceVersion string
+	Age             string
+	Config          *HelmChartConfig // nil when the chart has no HelmChartConfig
+	JobName         string           // the helm-install job of the chart
+	JobStatus       string           // Running, Complete, Failed, empty when there is no job
+	JobFailures     int32            // failed attempts of the job, which retries for a long time
+	Job             *Job             // install job with its pods, details only
+}
+
+// HelmChartConfig overrides the values of the HelmChart with the same name
+// and namespace
+type HelmChartConfig struct {
+	ValuesContent   string
+	ResourceVersion string
+	Age             string
+}
+
+// Failing returns true if the install job failed or keeps failing
+func (c HelmChart) Failing() bool {
+	return c.JobStatus == JobStatusFailed || (c.JobStatus == JobStatusRunning && c.JobFailures > 0)
+}
@@ -0,0 +1,36 @@
+package domain
+
+// Ingress represents a Kubernetes Ingress
+type Ingress struct {
+	Name      string
+	Namespace string
+	Class     string   // ingressClassName, or the legacy annotation
+	Hosts     []string // hosts of the rules, "*" for rules without one
+	Address   string   // load balancer IPs or hostnames
+	Age       string
+	Labels    map[string]string
+	Rules     []IngressRule // one per path, only filled in for details
+	TLS       []IngressTLS
+}
+
+// IngressRule is one path of an Ingress rule with its backend
+type IngressRule struct {
+	Host     string // empty matches every host
+	Path     string
+	PathType string
+	Backend  IngressBackend
+}
+
+// IngressBackend is the target of an Ingress path
+type IngressBackend struct {
+	Service string // empty for resource backends
+	Port    string // port name or number
+	// Resource is "Kind/name" for backends that are not services
+	Resource string
+}
+
+// IngressTLS is a TLS section of an Ingress
+type IngressTLS struct {
+	Hosts      []string
+	SecretName string
+}
@@ -0,0 +1,53 @@
+package domain
+
+// IngressRoute represents a Traefik IngressRoute
+type IngressRoute struct {
+	Name         string
+	Namespace    string
+	EntryPoints  []string
+	Routes       []IngressRouteRule
+	TLSSecret    string // tls.secretName
+	CertResolver string // tls.certResolver
+	Age          string
+	Labels       map[string]string
+	// Middlewares holds the middlewares the routes reference, looked up for
+	// details only
+	Middlewares []Middleware
+}
+
+// IngressRouteRule is one route of an IngressRoute
+type IngressRouteRule struct {
+	Match       string // e.g. Host(`example.com`) && PathPrefix(`/api`)
+	Priority    int64
+	Services    []IngressRouteService
+	Middlewares []MiddlewareRef
+}
+
+// IngressRouteService is a backend of a route
+type IngressRouteService struct {
+	Kind      string // Service or TraefikService
+	Name      string
+	Namespace string
+	Port      string // port name or number
+	Weight    int64
+}
+
+// MiddlewareRef is a middleware referenced by a route
+type MiddlewareRef struct {
+	Name      string
+	Namespace string
+}
+
+// Middleware is a Traefik Middleware
+type Middleware struct {
+	Name      string
+	Namespace string
+	Type      string // the middleware's spec key, e.g. redirectScheme
+	Missing   bool   // referenced but not found
+}
+
+// Traefik service kinds
+const (
+	IngressRouteServiceKind = "Service"
+	TraefikServiceKind      = "TraefikService"
+)
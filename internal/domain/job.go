@@ -0,0 +1,41 @@
+package domain
+
+// Job represents a Kubernetes Job
+type Job struct {
+	Name           string
+	Namespace      string
+	Completions    string // e.g., "1/1" (succeeded/desired completions)
+	Succeeded      int32
+	Failed         int32
+	Active         int32
+	Status         string // Running, Complete, Failed or Suspended
+	Duration       string // run time, still counting while the job runs
+	Owner          string // e.g., "CronJob/backup", empty when unowned
+	Age            string
+	Parallelism    int32
+	BackoffLimit   int32
+	StartTime      string
+	CompletionTime string
+	Labels         map[string]string
+	Selector       map[string]string
+	Conditions     []JobCondition
+	Images         []string
+	Pods           []Pod // only filled in for details
+}
+
+// JobCondition represents a condition of a job
+type JobCondition struct {
+	Type           string
+	Status         string
+	Reason         string
+	Message        string
+	LastTransition string
+}
+
+// JobStatus constants
+const (
+	JobStatusRunning   = "Running"
+	JobStatusComplete  = "Complete"
+	JobStatusFailed    = "Failed"
+	JobStatusSuspended = "Suspended"
+)
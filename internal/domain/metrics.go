@@ -10,6 +10,12 @@ type PodMetrics struct {
 	MemoryUsage string // e.g., "128Mi" or "1.2Gi"
 }
 
+// PodMetricsKey returns the key of a pod in a metrics map, which may hold
+// pods from several namespaces
+func PodMetricsKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
 // ContainerMetrics represents resource usage for a single container
 type ContainerMetrics struct {
 	Name        string
@@ -0,0 +1,162 @@
+package domain
+
+import "strings"
+
+// Node represents a Kubernetes Node
+type Node struct {
+	Name             string
+	Roles            []string
+	Status           string // "Ready", "NotReady" or "Unknown"
+	Unschedulable    bool   // cordoned
+	KubeletVersion   string
+	InternalIP       string
+	ExternalIP       string
+	Hostname         string
+	OSImage          string
+	KernelVersion    string
+	ContainerRuntime string
+	Age              string
+	CPU              NodeResource
+	Memory           NodeResource
+	PodCount         int
+	PodCapacity      int64
+	Taints           []NodeTaint
+	Conditions       []NodeCondition
+	Labels           map[string]string
+	Pods             []NodePod // only filled in for details
+}
+
+// NodeResource compares the allocatable amount of a resource with what the
+// pods scheduled on the node request. CPU is in millicores, memory in bytes.
+type NodeResource struct {
+	Allocatable int64
+	Requested   int64
+	Limits      int64
+}
+
+// Percent returns the requested share of the allocatable amount
+func (r NodeResource) Percent() int {
+	if r.Allocatable <= 0 {
+		return 0
+	}
+	return int(r.Requested * 100 / r.Allocatable)
+}
+
+// NodeTaint is a taint on a node
+type NodeTaint struct {
+	Key    string
+	Value  string
+	Effect string
+}
+
+// String formats the taint like kubectl, e.g. "key=value:NoSchedule"
+func (t NodeTaint) String() string {
+	if t.Value == "" {
+		return t.Key + ":" + t.Effect
+	}
+	return t.Key + "=" + t.Value + ":" + t.Effect
+}
+
+// NodeCondition represents a condition of a node
+type NodeCondition struct {
+	Type           string
+	Status         string
+	Reason         string
+	Message        string
+	LastTransition string
+}
+
+// NodePod is a pod running on a node together with its resource requests
+type NodePod struct {
+	Name       string
+	Namespace  string
+	Status     string
+	CPU        int64 // requested millicores
+	Memory     int64 // requested bytes
+	Age        string
+	DaemonSet  bool // owned by a DaemonSet, left alone by a drain that ignores DaemonSets
+	MirrorPod  bool // static pod, never evicted
+	LocalData  bool // uses emptyDir volumes
+	Controller string
+}
+
+// Pressure returns the pressure conditions that are currently true
+func (n Node) Pressure() []string {
+	var pressure []string
+	for _, c := range n.Conditions {
+		if c.Type == NodeConditionReady || c.Status != "True" {
+			continue
+		}
+		pressure = append(pressure, c.Type)
+	}
+	return pressure
+}
+
+// MatchesSSHHost reports whether h is an SSH host of this node. An explicit
+// node name on the host wins; otherwise its address is compared with the
+// node name, hostname and IPs.
+func (n Node) MatchesSSHHost(h SSHHost) bool {
+	if h.Node != "" {
+		return strings.EqualFold(h.Node, n.Name)
+	}
+	for _, addr := range []string{n.Name, n.Hostname, n.InternalIP, n.ExternalIP} {
+		if addr != "" && strings.EqualFold(addr, h.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindSSHHost returns the first SSH host matching node
+func FindSSHHost(hosts []SSHHost, node Node) *SSHHost {
+	for i := range hosts {
+		if node.MatchesSSHHost(hosts[i]) {
+			return &hosts[i]
+		}
+	}
+	return nil
+}
+
+// FindNodeForSSHHost returns the node an SSH host belongs to
+func FindNodeForSSHHost(nodes []Node, host SSHHost) *Node {
+	for i := range nodes {
+		if nodes[i].MatchesSSHHost(host) {
+			return &nodes[i]
+		}
+	}
+	return nil
+}
+
+// NodeCondition types
+const (
+	NodeConditionReady              = "Ready"
+	NodeConditionMemoryPressure     = "MemoryPressure"
+	NodeConditionDiskPressure       = "DiskPressure"
+	NodeConditionPIDPressure        = "PIDPressure"
+	NodeConditionNetworkUnavailable = "NetworkUnavailable"
+)
+
+// DrainOptions controls how a node is drained
+type DrainOptions struct {
+	IgnoreDaemonSets   bool // skip DaemonSet pods instead of refusing the drain
+	DeleteEmptyDirData bool // evict pods with emptyDir volumes, losing their data
+	Force              bool // evict pods not managed by a controller
+}
+
+// DrainEvent reports the progress of a drain for a single pod
+type DrainEvent struct {
+	Namespace string
+	Pod       string
+	Status    string
+	Message   string
+}
+
+// DrainEvent statuses
+const (
+	DrainStatusPending  = "Pending"
+	DrainStatusSkipped  = "Skipped"
+	DrainStatusEvicting = "Evicting"
+	DrainStatusBlocked  = "Blocked" // eviction refused by a PodDisruptionBudget, retrying
+	DrainStatusEvicted  = "Evicted"
+	DrainStatusFailed   = "Failed"
+)
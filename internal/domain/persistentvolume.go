@@ -0,0 +1,33 @@
+package domain
+
+// PersistentVolume represents a Kubernetes PersistentVolume
+type PersistentVolume struct {
+	Name          string
+	Capacity      string
+	AccessModes   []string // short form, e.g. RWO
+	ReclaimPolicy string   // Retain, Delete, Recycle
+	Phase         string   // Available, Bound, Released, Failed, Pending
+	Claim         string   // namespace/name of the claim, empty when unclaimed
+	StorageClass  string
+	VolumeMode    string
+	Reason        string
+	Message       string
+	Age           string
+	Labels        map[string]string
+	// Source names the volume plugin and its location, e.g.
+	// "local /var/lib/rancher/k3s/storage/pvc-..."
+	Source string
+	// NodeAffinity lists the required node selector terms. k3s local-path
+	// volumes are pinned to the node that holds their directory.
+	NodeAffinity []string
+	// Orphaned says why the PV is no longer used by a claim, empty otherwise
+	Orphaned string
+}
+
+// PersistentVolume phase constants
+const (
+	VolumePhaseAvailable = "Available"
+	VolumePhaseBound     = "Bound"
+	VolumePhaseReleased  = "Released"
+	VolumePhaseFailed    = "Failed"
+)
@@ -0,0 +1,38 @@
+package domain
+
+// PersistentVolumeClaim represents a Kubernetes PersistentVolumeClaim
+type PersistentVolumeClaim struct {
+	Name         string
+	Namespace    string
+	Phase        string   // Pending, Bound, Lost
+	Capacity     string   // provisioned size, empty until bound
+	Requested    string   // spec.resources.requests.storage
+	AccessModes  []string // short form, e.g. RWO
+	StorageClass string
+	VolumeName   string
+	VolumeMode   string
+	Age          string
+	Labels       map[string]string
+	// Resizing is the condition type while an expansion is in progress,
+	// e.g. FileSystemResizePending
+	Resizing string
+	Pods     []VolumePod // pods that mount the claim
+
+	// Filled in for details
+	VolumeNodeAffinity []string // node affinity of the bound PV, e.g. for local-path volumes
+	Events             []PodEvent
+}
+
+// VolumePod is a pod that mounts a PersistentVolumeClaim
+type VolumePod struct {
+	Name   string
+	Status string
+	Node   string
+}
+
+// PersistentVolumeClaim phase constants
+const (
+	ClaimPhaseBound   = "Bound"
+	ClaimPhasePending = "Pending"
+	ClaimPhaseLost    = "Lost"
+)
@@ -14,6 +14,7 @@ type Pod struct {
 	Annotations map[string]string
 	Containers  []Container
 	Conditions  []PodCondition
+	Volumes     []PodVolume
 }
 
 // Container represents a container within a pod
@@ -26,6 +27,14 @@ type Container struct {
 	StateReason  string
 	Started      string
 	Resources    ContainerResources
+	Ports        []ContainerPort
+}
+
+// ContainerPort represents a port declared by a container
+type ContainerPort struct {
+	Name          string
+	ContainerPort int32
+	Protocol      string
 }
 
 // ContainerResources represents resource requests and limits
@@ -45,6 +54,16 @@ type PodCondition struct {
 	LastTransition string
 }
 
+// PodVolume represents a volume of a pod
+type PodVolume struct {
+	Name   string
+	Type   string // e.g. persistentVolumeClaim, configMap, emptyDir
+	Source string // claim, configmap or secret name, or path
+	// ClaimPhase is the phase of a persistentVolumeClaim volume's claim, or
+	// "NotFound" when the claim does not exist
+	ClaimPhase string
+}
+
 // PodEvent represents an event related to a pod
 type PodEvent struct {
 	Type      string
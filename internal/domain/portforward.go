@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// PortForward represents a snapshot of an active port-forward
+type PortForward struct {
+	ID         int
+	Namespace  string
+	Kind       string // Pod or Service
+	Target     string // name of the pod or service
+	Pod        string // pod currently receiving traffic
+	LocalPort  int
+	RemotePort int
+	BytesIn    int64 // bytes received from the pod
+	BytesOut   int64 // bytes sent to the pod
+	Conns      int   // connections handled so far
+	Status     string
+	Error      string
+	Started    time.Time
+}
+
+// PortForwardStatus constants
+const (
+	PortForwardStatusActive  = "Active"
+	PortForwardStatusError   = "Error"
+	PortForwardStatusStopped = "Stopped"
+)
+
+// PortForwardKind constants
+const (
+	PortForwardKindPod     = "Pod"
+	PortForwardKindService = "Service"
+)
@@ -0,0 +1,16 @@
+package domain
+
+// ReplicaSet represents a Kubernetes ReplicaSet
+type ReplicaSet struct {
+	Name      string
+	Namespace string
+	Desired   int32
+	Current   int32
+	Ready     int32
+	Owner     string // e.g., "Deployment/web", empty when unowned
+	Revision  string // deployment revision for ReplicaSets owned by a Deployment
+	Age       string
+	Labels    map[string]string
+	Selector  map[string]string
+	Images    []string
+}
@@ -0,0 +1,33 @@
+package domain
+
+import "time"
+
+// Secret represents a Kubernetes Secret
+type Secret struct {
+	Name            string
+	Namespace       string
+	Type            string // e.g. Opaque, kubernetes.io/tls
+	Keys            int
+	Size            int // bytes of all decoded values
+	Age             string
+	Labels          map[string]string
+	ResourceVersion string
+	Data            []DataItem // decoded, keys sorted by name, only filled in for details
+	// Certificates is the parsed chain in tls.crt of kubernetes.io/tls secrets
+	Certificates []Certificate
+	CertError    string // why tls.crt could not be parsed
+}
+
+// SecretTypeTLS is the type of secrets holding a TLS certificate and key
+const SecretTypeTLS = "kubernetes.io/tls"
+
+// Certificate is an X.509 certificate stored in a Secret
+type Certificate struct {
+	Subject     string
+	Issuer      string
+	DNSNames    []string
+	IPAddresses []string
+	NotBefore   time.Time
+	NotAfter    time.Time
+	IsCA        bool
+}
@@ -0,0 +1,20 @@
+package domain
+
+// StatefulSet represents a Kubernetes StatefulSet
+type StatefulSet struct {
+	Name            string
+	Namespace       string
+	Ready           string // e.g., "2/3" (ready/desired replicas)
+	Replicas        int32
+	ReadyReplicas   int32
+	CurrentReplicas int32
+	UpdatedReplicas int32
+	ServiceName     string
+	UpdateStrategy  string
+	CurrentRevision string
+	UpdateRevision  string
+	Age             string
+	Labels          map[string]string
+	Selector        map[string]string
+	Images          []string
+}
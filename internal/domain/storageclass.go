@@ -0,0 +1,12 @@
+package domain
+
+// StorageClass represents a Kubernetes StorageClass
+type StorageClass struct {
+	Name              string
+	Provisioner       string
+	ReclaimPolicy     string
+	VolumeBindingMode string // Immediate or WaitForFirstConsumer
+	AllowExpansion    bool
+	Default           bool
+	Age               string
+}
@@ -0,0 +1,7 @@
+package domain
+
+// TerminalSize represents the dimensions of an interactive terminal
+type TerminalSize struct {
+	Width  uint16
+	Height uint16
+}
@@ -0,0 +1,20 @@
+// Package pathutil holds file path helpers shared by the adapters
+package pathutil
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ExpandHome expands a leading ~/ to the user's home directory
+func ExpandHome(path string) (string, error) {
+	if !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("get home directory: %w", err)
+	}
+	return home + path[1:], nil
+}